@@ -19,7 +19,9 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,13 +41,35 @@ type EndpointPickerConfig struct {
 	// SchedulingProfiles is the list of named SchedulingProfiles
 	// that will be created.
 	SchedulingProfiles []SchedulingProfile `json:"schedulingProfiles"`
+
+	// +optional
+	// DataLayer configures the data sources that collect data from the model
+	// servers, and the extractors that turn the collected data into endpoint
+	// attributes. When omitted, the data layer is configured by command line flags.
+	DataLayer *DataLayerConfig `json:"dataLayer,omitempty"`
+
+	// +optional
+	// FlowControl configures the experimental flow control layer, which
+	// queues the requests while the model servers are saturated. When
+	// omitted, the flow control layer has a single priority band.
+	FlowControl *FlowControlConfig `json:"flowControl,omitempty"`
 }
 
 func (cfg EndpointPickerConfig) String() string {
+	var dataLayer string
+	if cfg.DataLayer != nil {
+		dataLayer = fmt.Sprintf(", DataLayer: %v", *cfg.DataLayer)
+	}
+	var flowControl string
+	if cfg.FlowControl != nil {
+		flowControl = fmt.Sprintf(", FlowControl: %v", *cfg.FlowControl)
+	}
 	return fmt.Sprintf(
-		"{Plugins: %v, SchedulingProfiles: %v}",
+		"{Plugins: %v, SchedulingProfiles: %v%s%s}",
 		cfg.Plugins,
 		cfg.SchedulingProfiles,
+		dataLayer,
+		flowControl,
 	)
 }
 
@@ -109,6 +133,12 @@ type SchedulingPlugin struct {
 	// +optional
 	// Weight is the weight fo be used if this plugin is a Scorer.
 	Weight *int `json:"weight"`
+
+	// +optional
+	// Fallback marks this plugin as a soft Filter. When the filter filters out
+	// all of the candidate pods, the fallback is applied instead of failing
+	// the SchedulingProfile.
+	Fallback *FilterFallback `json:"fallback,omitempty"`
 }
 
 func (sp SchedulingPlugin) String() string {
@@ -116,5 +146,279 @@ func (sp SchedulingPlugin) String() string {
 	if sp.Weight != nil {
 		weight = fmt.Sprintf(", Weight: %d", *sp.Weight)
 	}
-	return fmt.Sprintf("{PluginRef: %s%s}", sp.PluginRef, weight)
+	var fallback string
+	if sp.Fallback != nil {
+		fallback = fmt.Sprintf(", Fallback: %v", *sp.Fallback)
+	}
+	return fmt.Sprintf("{PluginRef: %s%s%s}", sp.PluginRef, weight, fallback)
+}
+
+// FilterFallback describes the behavior of a soft Filter when it filters
+// out all of the candidate pods.
+type FilterFallback struct {
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Skip;Relax;Secondary
+	// Policy specifies the fallback behavior. Skip ignores the filter,
+	// Relax runs the relaxed variant of the filter and Secondary runs the
+	// filters referenced in PluginRefs instead.
+	Policy string `json:"policy"`
+
+	// +optional
+	// PluginRefs is the list of Filter plugins that are run, in order, when
+	// the Policy is Secondary. The references are to the names of entries
+	// of the Plugins defined in the configuration's Plugins section.
+	PluginRefs []string `json:"pluginRefs,omitempty"`
+}
+
+func (ff FilterFallback) String() string {
+	var pluginRefs string
+	if len(ff.PluginRefs) > 0 {
+		pluginRefs = fmt.Sprintf(", PluginRefs: %v", ff.PluginRefs)
+	}
+	return fmt.Sprintf("{Policy: %s%s}", ff.Policy, pluginRefs)
+}
+
+// DataLayerConfig contains the configuration of the data layer.
+type DataLayerConfig struct {
+	// +required
+	// +kubebuilder:validation:Required
+	// Sources is the list of data sources that will be instantiated.
+	Sources []DataSourceSpec `json:"sources"`
+}
+
+func (dlc DataLayerConfig) String() string {
+	return fmt.Sprintf("{Sources: %v}", dlc.Sources)
+}
+
+// DataSourceSpec contains the information that describes a data source that
+// will be instantiated, along with its extractors.
+type DataSourceSpec struct {
+	// +optional
+	// Name provides a name for the data source. If omitted, the value of
+	// the data source's Type field will be used.
+	Name string `json:"name"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	// Type specifies the data source type to be instantiated.
+	Type string `json:"type"`
+
+	// +optional
+	// Parameters are the set of parameters to be passed to the data source's
+	// factory function. The factory function is responsible
+	// to parse the parameters.
+	Parameters json.RawMessage `json:"parameters"`
+
+	// +optional
+	// RefreshInterval is the interval at which the data source collects data
+	// from each endpoint. If omitted, the refresh interval set by the
+	// command line is used.
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// +optional
+	// Extractors is the list of extractors that will be instantiated and
+	// attached to the data source.
+	Extractors []ExtractorSpec `json:"extractors,omitempty"`
+}
+
+func (dss DataSourceSpec) String() string {
+	var parameters string
+	if dss.Parameters != nil {
+		parameters = fmt.Sprintf(", Parameters: %s", dss.Parameters)
+	}
+	var refreshInterval string
+	if dss.RefreshInterval != nil {
+		refreshInterval = fmt.Sprintf(", RefreshInterval: %s", dss.RefreshInterval.Duration)
+	}
+	return fmt.Sprintf("{%s/%s%s%s, Extractors: %v}", dss.Name, dss.Type, parameters, refreshInterval, dss.Extractors)
+}
+
+// ExtractorSpec contains the information that describes an extractor that
+// will be instantiated and attached to a data source.
+type ExtractorSpec struct {
+	// +optional
+	// Name provides a name for the extractor. If omitted, the value of
+	// the extractor's Type field will be used.
+	Name string `json:"name"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	// Type specifies the extractor type to be instantiated.
+	Type string `json:"type"`
+
+	// +optional
+	// Parameters are the set of parameters to be passed to the extractor's
+	// factory function. The factory function is responsible
+	// to parse the parameters.
+	Parameters json.RawMessage `json:"parameters"`
+}
+
+func (es ExtractorSpec) String() string {
+	var parameters string
+	if es.Parameters != nil {
+		parameters = fmt.Sprintf(", Parameters: %s", es.Parameters)
+	}
+	return fmt.Sprintf("{%s/%s%s}", es.Name, es.Type, parameters)
+}
+
+// FlowControlConfig contains the configuration of the flow control layer.
+type FlowControlConfig struct {
+	// +optional
+	// PriorityBands is the list of priority bands. Requests are dispatched
+	// from the highest priority band with queued requests first. At least
+	// one band is required, unless a PriorityBandTemplate is set.
+	PriorityBands []PriorityBandConfig `json:"priorityBands,omitempty"`
+
+	// +optional
+	// PriorityBandTemplate is the template of the priority bands created
+	// for the priorities of the InferenceObjectives that have no band in
+	// PriorityBands. These bands are removed once empty and no longer used
+	// by any InferenceObjective. When omitted, requests with a priority that
+	// has no band are rejected.
+	PriorityBandTemplate *PriorityBandTemplateConfig `json:"priorityBandTemplate,omitempty"`
+
+	// +optional
+	// MaxBytes is the maximum total byte size of the queued requests, across
+	// all the priority bands. When omitted, only the limits of the priority
+	// bands apply.
+	MaxBytes *resource.Quantity `json:"maxBytes,omitempty"`
+
+	// +optional
+	// ShardCount is the number of shards the queues are partitioned into,
+	// each processed in parallel. Defaults to 1.
+	ShardCount *int `json:"shardCount,omitempty"`
+
+	// +optional
+	// FlowGCTimeout is the duration of inactivity after which a flow is
+	// garbage collected. Defaults to 5m.
+	FlowGCTimeout *metav1.Duration `json:"flowGCTimeout,omitempty"`
+
+	// +optional
+	// DefaultRequestTTL is the time to live of the queued requests that
+	// don't specify their own. When omitted, requests are queued until they
+	// are dispatched or cancelled.
+	DefaultRequestTTL *metav1.Duration `json:"defaultRequestTTL,omitempty"`
+
+	// +optional
+	// FairnessWeights maps fairness IDs to their relative share of dispatch
+	// within a priority band, as applied by the weighted inter-flow policies.
+	// Weights must be positive and take precedence over the weight set on
+	// the InferenceObjective of a request. Flows with no weight have a
+	// weight of 1.
+	FairnessWeights map[string]int `json:"fairnessWeights,omitempty"`
+}
+
+func (fcc FlowControlConfig) String() string {
+	var maxBytes string
+	if fcc.MaxBytes != nil {
+		maxBytes = fmt.Sprintf(", MaxBytes: %s", fcc.MaxBytes)
+	}
+	var shardCount string
+	if fcc.ShardCount != nil {
+		shardCount = fmt.Sprintf(", ShardCount: %d", *fcc.ShardCount)
+	}
+	var flowGCTimeout string
+	if fcc.FlowGCTimeout != nil {
+		flowGCTimeout = fmt.Sprintf(", FlowGCTimeout: %s", fcc.FlowGCTimeout.Duration)
+	}
+	var defaultRequestTTL string
+	if fcc.DefaultRequestTTL != nil {
+		defaultRequestTTL = fmt.Sprintf(", DefaultRequestTTL: %s", fcc.DefaultRequestTTL.Duration)
+	}
+	var priorityBandTemplate string
+	if fcc.PriorityBandTemplate != nil {
+		priorityBandTemplate = fmt.Sprintf(", PriorityBandTemplate: %v", *fcc.PriorityBandTemplate)
+	}
+	var fairnessWeights string
+	if len(fcc.FairnessWeights) > 0 {
+		fairnessWeights = fmt.Sprintf(", FairnessWeights: %v", fcc.FairnessWeights)
+	}
+	return fmt.Sprintf("{PriorityBands: %v%s%s%s%s%s%s}", fcc.PriorityBands, priorityBandTemplate, maxBytes, shardCount,
+		flowGCTimeout, defaultRequestTTL, fairnessWeights)
+}
+
+// PriorityBandConfig contains the configuration of a priority band of the
+// flow control layer.
+type PriorityBandConfig struct {
+	// +required
+	// +kubebuilder:validation:Required
+	// Priority is the priority level of the band, which must be unique. The
+	// higher the value, the higher the priority. Requests are assigned the
+	// priority of their InferenceObjective.
+	Priority int `json:"priority"`
+
+	// +required
+	// +kubebuilder:validation:Required
+	// Name is the name of the band, which must be unique.
+	Name string `json:"name"`
+
+	// +optional
+	// Queue is the name of the queue implementation of the flows of the
+	// band. Defaults to ListQueue.
+	Queue string `json:"queue,omitempty"`
+
+	// +optional
+	// IntraFlowPolicy is the name of the policy selecting the next request
+	// from the queue of a flow. Defaults to FCFS.
+	IntraFlowPolicy string `json:"intraFlowPolicy,omitempty"`
+
+	// +optional
+	// InterFlowPolicy is the name of the policy selecting the next flow to
+	// dispatch a request from. Defaults to BestHead.
+	InterFlowPolicy string `json:"interFlowPolicy,omitempty"`
+
+	// +optional
+	// MaxBytes is the maximum total byte size of the queued requests of the
+	// band. Defaults to 1G.
+	MaxBytes *resource.Quantity `json:"maxBytes,omitempty"`
+}
+
+func (pbc PriorityBandConfig) String() string {
+	var policies string
+	if pbc.Queue != "" || pbc.IntraFlowPolicy != "" || pbc.InterFlowPolicy != "" {
+		policies = fmt.Sprintf(", Queue: %s, IntraFlowPolicy: %s, InterFlowPolicy: %s", pbc.Queue, pbc.IntraFlowPolicy, pbc.InterFlowPolicy)
+	}
+	var maxBytes string
+	if pbc.MaxBytes != nil {
+		maxBytes = fmt.Sprintf(", MaxBytes: %s", pbc.MaxBytes)
+	}
+	return fmt.Sprintf("{%d/%s%s%s}", pbc.Priority, pbc.Name, policies, maxBytes)
+}
+
+// PriorityBandTemplateConfig contains the configuration of the priority
+// bands of the flow control layer created for the priorities of the
+// InferenceObjectives.
+type PriorityBandTemplateConfig struct {
+	// +optional
+	// Queue is the name of the queue implementation of the flows of the
+	// bands. Defaults to ListQueue.
+	Queue string `json:"queue,omitempty"`
+
+	// +optional
+	// IntraFlowPolicy is the name of the policy selecting the next request
+	// from the queue of a flow. Defaults to FCFS.
+	IntraFlowPolicy string `json:"intraFlowPolicy,omitempty"`
+
+	// +optional
+	// InterFlowPolicy is the name of the policy selecting the next flow to
+	// dispatch a request from. Defaults to BestHead.
+	InterFlowPolicy string `json:"interFlowPolicy,omitempty"`
+
+	// +optional
+	// MaxBytes is the maximum total byte size of the queued requests of each
+	// band. Defaults to 1G.
+	MaxBytes *resource.Quantity `json:"maxBytes,omitempty"`
+}
+
+func (pbtc PriorityBandTemplateConfig) String() string {
+	var fields []string
+	if pbtc.Queue != "" || pbtc.IntraFlowPolicy != "" || pbtc.InterFlowPolicy != "" {
+		fields = append(fields, fmt.Sprintf("Queue: %s, IntraFlowPolicy: %s, InterFlowPolicy: %s", pbtc.Queue,
+			pbtc.IntraFlowPolicy, pbtc.InterFlowPolicy))
+	}
+	if pbtc.MaxBytes != nil {
+		fields = append(fields, fmt.Sprintf("MaxBytes: %s", pbtc.MaxBytes))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
 }
@@ -22,9 +22,64 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLayerConfig) DeepCopyInto(out *DataLayerConfig) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]DataSourceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLayerConfig.
+func (in *DataLayerConfig) DeepCopy() *DataLayerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLayerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSourceSpec) DeepCopyInto(out *DataSourceSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Extractors != nil {
+		in, out := &in.Extractors, &out.Extractors
+		*out = make([]ExtractorSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceSpec.
+func (in *DataSourceSpec) DeepCopy() *DataSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointPickerConfig) DeepCopyInto(out *EndpointPickerConfig) {
 	*out = *in
@@ -43,6 +98,16 @@ func (in *EndpointPickerConfig) DeepCopyInto(out *EndpointPickerConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DataLayer != nil {
+		in, out := &in.DataLayer, &out.DataLayer
+		*out = new(DataLayerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowControl != nil {
+		in, out := &in.FlowControl, &out.FlowControl
+		*out = new(FlowControlConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPickerConfig.
@@ -63,6 +128,100 @@ func (in *EndpointPickerConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtractorSpec) DeepCopyInto(out *ExtractorSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtractorSpec.
+func (in *ExtractorSpec) DeepCopy() *ExtractorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtractorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterFallback) DeepCopyInto(out *FilterFallback) {
+	*out = *in
+	if in.PluginRefs != nil {
+		in, out := &in.PluginRefs, &out.PluginRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterFallback.
+func (in *FilterFallback) DeepCopy() *FilterFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowControlConfig) DeepCopyInto(out *FlowControlConfig) {
+	*out = *in
+	if in.PriorityBands != nil {
+		in, out := &in.PriorityBands, &out.PriorityBands
+		*out = make([]PriorityBandConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityBandTemplate != nil {
+		in, out := &in.PriorityBandTemplate, &out.PriorityBandTemplate
+		*out = new(PriorityBandTemplateConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ShardCount != nil {
+		in, out := &in.ShardCount, &out.ShardCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowGCTimeout != nil {
+		in, out := &in.FlowGCTimeout, &out.FlowGCTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DefaultRequestTTL != nil {
+		in, out := &in.DefaultRequestTTL, &out.DefaultRequestTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FairnessWeights != nil {
+		in, out := &in.FairnessWeights, &out.FairnessWeights
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowControlConfig.
+func (in *FlowControlConfig) DeepCopy() *FlowControlConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowControlConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginSpec) DeepCopyInto(out *PluginSpec) {
 	*out = *in
@@ -83,6 +242,46 @@ func (in *PluginSpec) DeepCopy() *PluginSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityBandConfig) DeepCopyInto(out *PriorityBandConfig) {
+	*out = *in
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityBandConfig.
+func (in *PriorityBandConfig) DeepCopy() *PriorityBandConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityBandConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityBandTemplateConfig) DeepCopyInto(out *PriorityBandTemplateConfig) {
+	*out = *in
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityBandTemplateConfig.
+func (in *PriorityBandTemplateConfig) DeepCopy() *PriorityBandTemplateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityBandTemplateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingPlugin) DeepCopyInto(out *SchedulingPlugin) {
 	*out = *in
@@ -91,6 +290,11 @@ func (in *SchedulingPlugin) DeepCopyInto(out *SchedulingPlugin) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(FilterFallback)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPlugin.
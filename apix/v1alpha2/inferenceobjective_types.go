@@ -106,6 +106,7 @@ type InferenceObjectiveStatus struct {
 	// Known condition types are:
 	//
 	// * "Accepted"
+	// * "ResolvedRefs"
 	//
 	// +optional
 	// +listType=map
@@ -140,3 +141,23 @@ const (
 	// ObjectiveReasonPending is the initial state, and indicates that the controller has not yet reconciled the InferenceObjective.
 	ObjectiveReasonPending InferenceObjectiveConditionReason = "Pending"
 )
+
+const (
+	// ObjectiveConditionResolvedRefs indicates whether the InferencePool referenced by the objective was resolved.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "ResolvedRefs"
+	//
+	// Possible reasons for this condition to be False are:
+	//
+	// * "PoolNotFound"
+	//
+	ObjectiveConditionResolvedRefs InferenceObjectiveConditionType = "ResolvedRefs"
+
+	// ObjectiveReasonResolvedRefs is used with the "ResolvedRefs" condition when the referenced pool was resolved.
+	ObjectiveReasonResolvedRefs InferenceObjectiveConditionReason = "ResolvedRefs"
+
+	// ObjectiveReasonPoolNotFound is used with the "ResolvedRefs" condition when the referenced pool does not exist.
+	ObjectiveReasonPoolNotFound InferenceObjectiveConditionReason = "PoolNotFound"
+)
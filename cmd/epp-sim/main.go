@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// epp-sim replays a trace of OpenAI requests against simulated model servers, using the scheduler and
+// plugins configured by an EndpointPickerConfig. It reports the routing distribution, the prefix cache
+// hit ratio and the simulated latency percentiles, which helps to evaluate a configuration offline.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	uberzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"sigs.k8s.io/gateway-api-inference-extension/cmd/epp-sim/simulator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins/intree"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+var (
+	configFile = flag.String(
+		"config-file",
+		"",
+		"The path to the configuration file")
+	configText = flag.String(
+		"config-text",
+		"",
+		"The configuration specified as text, in lieu of a file")
+	traceFile = flag.String(
+		"trace",
+		"",
+		"The path to a JSONL trace of requests to replay. Each line holds a timestamp in seconds, "+
+			"optional request_id, headers and output_tokens, and an OpenAI request body")
+	pods = flag.Int(
+		"pods", 4, "The number of simulated model servers")
+	maxRunningRequests = flag.Int(
+		"max-running-requests", simulator.DefaultMaxRunningRequests, "The maximum number of requests a model server processes concurrently")
+	kvCacheTokens = flag.Int(
+		"kv-cache-tokens", simulator.DefaultKVCacheTokens, "The number of tokens that fit in the KV cache of a model server")
+	blockSize = flag.Int(
+		"block-size", simulator.DefaultBlockSize, "The number of tokens in a KV cache block")
+	prefillTokensPerSecond = flag.Float64(
+		"prefill-tokens-per-second", simulator.DefaultPrefillTokensPerSecond, "The rate at which a model server processes prompt tokens that are not cached")
+	decodeTimePerToken = flag.Duration(
+		"decode-time-per-token", simulator.DefaultDecodeTimePerToken, "The time it takes a model server to generate a single output token")
+	defaultOutputTokens = flag.Int(
+		"default-output-tokens", simulator.DefaultOutputTokens, "The number of generated tokens of a request that specifies neither output_tokens nor max_tokens")
+	refreshMetricsInterval = flag.Duration(
+		"refresh-metrics-interval", 50*time.Millisecond, "The simulated interval at which the scheduler observes new pod metrics")
+	outputFormat = flag.String(
+		"output", "text", "The format of the report, either text or json")
+	logVerbosity = flag.Int("v", logging.DEFAULT, "number for the log level verbosity")
+
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func main() {
+	if err := run(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	opts := zap.Options{Development: true}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+	initLogging(&opts)
+
+	if err := validateFlags(); err != nil {
+		setupLog.Error(err, "Failed to validate flags")
+		return err
+	}
+
+	configBytes := []byte(*configText)
+	if *configFile != "" {
+		var err error
+		if configBytes, err = os.ReadFile(*configFile); err != nil {
+			setupLog.Error(err, "Failed to read the configuration file", "path", *configFile)
+			return err
+		}
+	}
+
+	traceReader, err := os.Open(*traceFile)
+	if err != nil {
+		setupLog.Error(err, "Failed to open the trace file", "path", *traceFile)
+		return err
+	}
+	defer traceReader.Close()
+	trace, err := simulator.ReadTrace(traceReader)
+	if err != nil {
+		setupLog.Error(err, "Failed to read the trace")
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(log.IntoContext(context.Background(), ctrl.Log))
+	defer cancel()
+
+	sim := simulator.New(simulator.Config{
+		Pods: *pods,
+		Pod: simulator.PodConfig{
+			MaxRunningRequests:     *maxRunningRequests,
+			KVCacheTokens:          *kvCacheTokens,
+			BlockSize:              *blockSize,
+			PrefillTokensPerSecond: *prefillTokensPerSecond,
+			DecodeTimePerToken:     *decodeTimePerToken,
+		},
+		MetricsRefreshInterval: *refreshMetricsInterval,
+		DefaultOutputTokens:    *defaultOutputTokens,
+	})
+
+	intree.Register()
+	handle := plugins.NewEppHandle(ctx, sim.PodList)
+	config, err := loader.LoadConfig(configBytes, handle, setupLog)
+	if err != nil {
+		setupLog.Error(err, "Failed to load the configuration")
+		return err
+	}
+
+	report, err := sim.Run(ctx, scheduling.NewSchedulerWithConfig(config.SchedulerConfig), handle.GetAllPlugins(), trace)
+	if err != nil {
+		setupLog.Error(err, "Failed to run the simulation")
+		return err
+	}
+
+	if *outputFormat == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+	return report.Print(os.Stdout)
+}
+
+func validateFlags() error {
+	if *configText == "" && *configFile == "" {
+		return errors.New("either --config-text or --config-file must be set")
+	}
+	if *configText != "" && *configFile != "" {
+		return errors.New("both --config-text and --config-file cannot be set at the same time")
+	}
+	if *traceFile == "" {
+		return errors.New("--trace must be set")
+	}
+	if *outputFormat != "text" && *outputFormat != "json" {
+		return fmt.Errorf("unsupported output format %q, must be text or json", *outputFormat)
+	}
+	return nil
+}
+
+func initLogging(opts *zap.Options) {
+	// Unless -zap-log-level is explicitly set, use -v
+	useV := true
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "zap-log-level" {
+			useV = false
+		}
+	})
+	if useV {
+		// See https://pkg.go.dev/sigs.k8s.io/controller-runtime/pkg/log/zap#Options.Level
+		lvl := -1 * (*logVerbosity)
+		opts.Level = uberzap.NewAtomicLevelAt(zapcore.Level(int8(lvl)))
+	}
+
+	logger := zap.New(zap.UseFlagOptions(opts), zap.RawZapOpts(uberzap.AddCaller()), zap.WriteTo(os.Stderr))
+	ctrl.SetLogger(logger)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+const (
+	DefaultMaxRunningRequests     = 16
+	DefaultKVCacheTokens          = 64 * 1024
+	DefaultBlockSize              = 16
+	DefaultPrefillTokensPerSecond = 8000
+	DefaultDecodeTimePerToken     = 20 * time.Millisecond
+	DefaultOutputTokens           = 256
+)
+
+// PodConfig configures the queueing and KV cache model of a simulated model server.
+type PodConfig struct {
+	// MaxRunningRequests is the maximum number of requests the model server processes concurrently.
+	MaxRunningRequests int
+	// KVCacheTokens is the number of tokens that fit in the KV cache.
+	KVCacheTokens int
+	// BlockSize is the number of tokens in a KV cache block.
+	BlockSize int
+	// PrefillTokensPerSecond is the rate at which prompt tokens that are not cached are processed.
+	PrefillTokensPerSecond float64
+	// DecodeTimePerToken is the time it takes to generate a single output token.
+	DecodeTimePerToken time.Duration
+}
+
+func (c PodConfig) withDefaults() PodConfig {
+	if c.MaxRunningRequests <= 0 {
+		c.MaxRunningRequests = DefaultMaxRunningRequests
+	}
+	if c.KVCacheTokens <= 0 {
+		c.KVCacheTokens = DefaultKVCacheTokens
+	}
+	if c.BlockSize <= 0 {
+		c.BlockSize = DefaultBlockSize
+	}
+	if c.PrefillTokensPerSecond <= 0 {
+		c.PrefillTokensPerSecond = DefaultPrefillTokensPerSecond
+	}
+	if c.DecodeTimePerToken <= 0 {
+		c.DecodeTimePerToken = DefaultDecodeTimePerToken
+	}
+	return c
+}
+
+// simRequest is a request in flight in the simulation.
+type simRequest struct {
+	llmRequest   *types.LLMRequest
+	pod          *simPod
+	blockHashes  []uint64
+	promptTokens int
+	outputTokens int
+	cachedTokens int
+
+	arrival    time.Duration
+	firstToken time.Duration
+	end        time.Duration
+}
+
+// simPod is a simulated model server. Requests are admitted in FIFO order as long as there are free
+// request slots and KV cache space. Each admitted request spends time on prefilling the prompt tokens
+// that are not cached, followed by a fixed time per generated token.
+type simPod struct {
+	config   PodConfig
+	endpoint *datalayer.ModelServer
+
+	waiting      []*simRequest
+	running      int
+	kvCacheUsed  int
+	cachedBlocks *lru.Cache[uint64, struct{}]
+	served       int
+}
+
+func newSimPod(name k8stypes.NamespacedName, address string, config PodConfig) *simPod {
+	endpoint := datalayer.NewEndpoint()
+	endpoint.UpdatePod(&datalayer.PodInfo{
+		NamespacedName: name,
+		PodName:        name.Name,
+		Address:        address,
+		Port:           "8000",
+		MetricsHost:    address + ":8000",
+		Labels:         map[string]string{},
+	})
+	cachedBlocks, _ := lru.New[uint64, struct{}](max(1, config.KVCacheTokens/config.BlockSize))
+	pod := &simPod{
+		config:       config,
+		endpoint:     endpoint,
+		cachedBlocks: cachedBlocks,
+	}
+	pod.refreshMetrics(time.Time{})
+	return pod
+}
+
+// enqueue adds the request to the waiting queue of the pod.
+func (p *simPod) enqueue(request *simRequest) {
+	request.pod = p
+	p.waiting = append(p.waiting, request)
+}
+
+// admit starts the waiting requests that fit and returns them.
+func (p *simPod) admit(now time.Duration) []*simRequest {
+	var started []*simRequest
+	for len(p.waiting) > 0 && p.running < p.config.MaxRunningRequests {
+		request := p.waiting[0]
+		required := request.promptTokens + request.outputTokens
+		// a request that doesn't fit into an empty KV cache still runs, otherwise it would block the queue forever.
+		if p.kvCacheUsed+required > p.config.KVCacheTokens && p.running > 0 {
+			break
+		}
+		p.waiting = p.waiting[1:]
+		p.start(request, now)
+		started = append(started, request)
+	}
+	return started
+}
+
+func (p *simPod) start(request *simRequest, now time.Duration) {
+	p.running++
+	p.served++
+	p.kvCacheUsed += request.promptTokens + request.outputTokens
+
+	cachedBlocks := 0
+	for _, hash := range request.blockHashes {
+		if _, ok := p.cachedBlocks.Get(hash); !ok {
+			break
+		}
+		cachedBlocks++
+	}
+	for _, hash := range request.blockHashes {
+		p.cachedBlocks.Add(hash, struct{}{})
+	}
+	request.cachedTokens = min(cachedBlocks*p.config.BlockSize, request.promptTokens)
+
+	prefill := time.Duration(float64(request.promptTokens-request.cachedTokens) / p.config.PrefillTokensPerSecond * float64(time.Second))
+	request.firstToken = now + prefill
+	request.end = request.firstToken + time.Duration(request.outputTokens)*p.config.DecodeTimePerToken
+}
+
+// complete releases the resources of a finished request.
+func (p *simPod) complete(request *simRequest) {
+	p.running--
+	p.kvCacheUsed -= request.promptTokens + request.outputTokens
+}
+
+// refreshMetrics publishes the current state of the pod as the metrics of its endpoint.
+func (p *simPod) refreshMetrics(updateTime time.Time) {
+	metrics := datalayer.NewMetrics()
+	metrics.RunningQueueSize = p.running
+	metrics.WaitingQueueSize = len(p.waiting)
+	metrics.KVCacheUsagePercent = min(1, float64(p.kvCacheUsed)/float64(p.config.KVCacheTokens))
+	metrics.KvCacheMaxTokenCapacity = p.config.KVCacheTokens
+	metrics.CacheBlockSize = p.config.BlockSize
+	metrics.UpdateTime = updateTime
+	p.endpoint.UpdateMetrics(metrics)
+}
+
+// promptText returns the text of the request prompt, as it is seen by the model server.
+func promptText(body *types.LLMRequestBody) string {
+	if body.Completions != nil {
+		return body.Completions.Prompt
+	}
+	text := ""
+	if body.ChatCompletions != nil {
+		for _, msg := range body.ChatCompletions.Messages {
+			text += msg.Role + ": " + msg.Content.PlainText() + "\n"
+		}
+	}
+	return text
+}
+
+// hashPromptBlocks splits the prompt into full KV cache blocks and returns their chained hashes,
+// such that a block hash identifies the block along with all the blocks before it.
+func hashPromptBlocks(prompt string, blockSize int) []uint64 {
+	blockChars := blockSize * requtil.DefaultCharactersPerToken
+	hashes := make([]uint64, 0, len(prompt)/blockChars)
+	var prev uint64
+	for start := 0; start+blockChars <= len(prompt); start += blockChars {
+		h := fnv.New64a()
+		_ = binary.Write(h, binary.LittleEndian, prev)
+		_, _ = h.Write([]byte(prompt[start : start+blockChars]))
+		prev = h.Sum64()
+		hashes = append(hashes, prev)
+	}
+	return hashes
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+// Report summarizes the outcome of a simulation.
+type Report struct {
+	// Requests is the number of requests that were served.
+	Requests int `json:"requests"`
+	// Failed is the number of requests that could not be parsed or scheduled.
+	Failed int `json:"failed"`
+	// Makespan is the simulated time from the first arrival until the last request completed.
+	Makespan time.Duration `json:"makespan"`
+	// Pods is the routing distribution of the requests across the pods.
+	Pods []PodReport `json:"pods"`
+	// PrefixHitRatio is the fraction of prompt tokens that were found in the KV cache of the serving pod.
+	PrefixHitRatio float64 `json:"prefixHitRatio"`
+	// Latency holds the percentiles of the end-to-end request latency, including queueing.
+	Latency Percentiles `json:"latency"`
+	// TTFT holds the percentiles of the time to first token, including queueing.
+	TTFT Percentiles `json:"ttft"`
+
+	promptTokens int
+	cachedTokens int
+	latencies    []time.Duration
+	ttfts        []time.Duration
+}
+
+// PodReport holds the number of requests a pod served.
+type PodReport struct {
+	Name     string  `json:"name"`
+	Requests int     `json:"requests"`
+	Share    float64 `json:"share"`
+}
+
+// Percentiles holds latency percentiles.
+type Percentiles struct {
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+}
+
+func newReport(pods []*simPod) *Report {
+	return &Report{Pods: make([]PodReport, 0, len(pods))}
+}
+
+// record adds a completed request to the report.
+func (r *Report) record(request *simRequest) {
+	r.Requests++
+	r.promptTokens += request.promptTokens
+	r.cachedTokens += request.cachedTokens
+	r.latencies = append(r.latencies, request.end-request.arrival)
+	r.ttfts = append(r.ttfts, request.firstToken-request.arrival)
+	r.Makespan = max(r.Makespan, request.end)
+}
+
+// finalize computes the summary of the recorded requests.
+func (r *Report) finalize(pods []*simPod) {
+	for _, pod := range pods {
+		podReport := PodReport{Name: pod.endpoint.GetPod().NamespacedName.String(), Requests: pod.served}
+		if r.Requests > 0 {
+			podReport.Share = float64(pod.served) / float64(r.Requests)
+		}
+		r.Pods = append(r.Pods, podReport)
+	}
+	if r.promptTokens > 0 {
+		r.PrefixHitRatio = float64(r.cachedTokens) / float64(r.promptTokens)
+	}
+	r.Latency = percentiles(r.latencies)
+	r.TTFT = percentiles(r.ttfts)
+}
+
+// Print writes a human readable form of the report.
+func (r *Report) Print(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Requests served:\t%d\n", r.Requests)
+	fmt.Fprintf(w, "Requests failed:\t%d\n", r.Failed)
+	fmt.Fprintf(w, "Makespan:\t%v\n", r.Makespan)
+	fmt.Fprintf(w, "Prefix hit ratio:\t%.3f\n", r.PrefixHitRatio)
+	fmt.Fprintf(w, "Latency p50/p90/p99:\t%v / %v / %v\n", r.Latency.P50, r.Latency.P90, r.Latency.P99)
+	fmt.Fprintf(w, "TTFT p50/p90/p99:\t%v / %v / %v\n", r.TTFT.P50, r.TTFT.P90, r.TTFT.P99)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "POD\tREQUESTS\tSHARE")
+	for _, pod := range r.Pods {
+		fmt.Fprintf(w, "%s\t%d\t%.3f\n", pod.Name, pod.Requests, pod.Share)
+	}
+	return w.Flush()
+}
+
+// percentiles returns the nearest-rank percentiles of the given durations.
+func percentiles(durations []time.Duration) Percentiles {
+	if len(durations) == 0 {
+		return Percentiles{}
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	rank := func(p float64) time.Duration {
+		idx := int(p*float64(len(sorted))+0.5) - 1
+		return sorted[min(max(idx, 0), len(sorted)-1)]
+	}
+	return Percentiles{P50: rank(0.5), P90: rank(0.9), P99: rank(0.99)}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator replays a trace of OpenAI requests against simulated model servers, using the
+// real scheduler and plugins of the endpoint picker. The simulation runs in virtual time, driven
+// by the arrival times of the requests in the trace.
+package simulator
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+// Scheduler defines the interface required by the Simulator for scheduling.
+type Scheduler interface {
+	Schedule(ctx context.Context, request *types.LLMRequest, candidatePods []types.Pod) (result *types.SchedulingResult, err error)
+}
+
+// Config configures the simulated model servers.
+type Config struct {
+	// Pods is the number of simulated model servers.
+	Pods int
+	// Namespace is the namespace of the simulated pods.
+	Namespace string
+	// Pod configures the queueing and KV cache model of each simulated model server.
+	Pod PodConfig
+	// MetricsRefreshInterval is the (virtual) interval at which the scheduler observes new pod metrics.
+	// Zero means the scheduler always observes the up-to-date state of the pods.
+	MetricsRefreshInterval time.Duration
+	// DefaultOutputTokens is the number of generated tokens of a request that doesn't specify any.
+	DefaultOutputTokens int
+}
+
+// Simulator simulates a pool of model servers that serves a trace of requests routed by a Scheduler.
+type Simulator struct {
+	config Config
+	pods   []*simPod
+	byName map[k8stypes.NamespacedName]*simPod
+
+	preRequestPlugins       []requestcontrol.PreRequest
+	responseCompletePlugins []requestcontrol.ResponseComplete
+
+	startTime   time.Time
+	lastRefresh time.Duration
+	inFlight    completionQueue
+}
+
+// New initializes a new Simulator with the given number of simulated pods and returns its pointer.
+func New(config Config) *Simulator {
+	if config.Pods <= 0 {
+		config.Pods = 1
+	}
+	if config.Namespace == "" {
+		config.Namespace = "default"
+	}
+	if config.DefaultOutputTokens <= 0 {
+		config.DefaultOutputTokens = DefaultOutputTokens
+	}
+	config.Pod = config.Pod.withDefaults()
+
+	sim := &Simulator{
+		config: config,
+		byName: make(map[k8stypes.NamespacedName]*simPod, config.Pods),
+	}
+	for i := range config.Pods {
+		name := k8stypes.NamespacedName{Namespace: config.Namespace, Name: fmt.Sprintf("sim-pod-%d", i)}
+		pod := newSimPod(name, fmt.Sprintf("10.0.%d.%d", i/250, i%250+1), config.Pod)
+		sim.pods = append(sim.pods, pod)
+		sim.byName[name] = pod
+	}
+	return sim
+}
+
+// PodList returns the simulated pods that match the given predicate. It can be used as the
+// plugins.PodListFunc of the plugins handle.
+func (s *Simulator) PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics {
+	res := []backendmetrics.PodMetrics{}
+	for _, pod := range s.pods {
+		if predicate(pod.endpoint) {
+			res = append(res, pod.endpoint)
+		}
+	}
+	return res
+}
+
+// Run replays the trace. Each request is scheduled by the given scheduler, after which the PreRequest plugins
+// among the given plugins run. The ResponseComplete plugins run when the simulated pod finishes the request.
+func (s *Simulator) Run(ctx context.Context, scheduler Scheduler, allPlugins []plugins.Plugin, trace []TraceRecord) (*Report, error) {
+	if len(trace) == 0 {
+		return nil, errors.New("the trace is empty")
+	}
+	for _, plugin := range allPlugins {
+		if preRequestPlugin, ok := plugin.(requestcontrol.PreRequest); ok {
+			s.preRequestPlugins = append(s.preRequestPlugins, preRequestPlugin)
+		}
+		if responseCompletePlugin, ok := plugin.(requestcontrol.ResponseComplete); ok {
+			s.responseCompletePlugins = append(s.responseCompletePlugins, responseCompletePlugin)
+		}
+	}
+
+	s.startTime = time.Now()
+	report := newReport(s.pods)
+	origin := trace[0].Timestamp
+	for _, record := range trace {
+		arrival := time.Duration((record.Timestamp - origin) * float64(time.Second))
+		s.advance(ctx, arrival, report)
+		s.refreshMetrics(arrival)
+		if err := s.dispatch(ctx, scheduler, record, arrival); err != nil {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Failed to dispatch request", "requestID", record.RequestID, "error", err)
+			report.Failed++
+		}
+	}
+	s.advance(ctx, time.Duration(math.MaxInt64), report) // drain the in-flight requests
+
+	report.finalize(s.pods)
+	return report, nil
+}
+
+// dispatch schedules the request and enqueues it on the selected pod.
+func (s *Simulator) dispatch(ctx context.Context, scheduler Scheduler, record TraceRecord, arrival time.Duration) error {
+	body, err := requtil.ExtractRequestBody(record.Body)
+	if err != nil {
+		return fmt.Errorf("invalid request body - %w", err)
+	}
+	model, _ := record.Body["model"].(string)
+	headers := record.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	llmRequest := &types.LLMRequest{
+		RequestId:   record.RequestID,
+		TargetModel: model,
+		Body:        body,
+		Headers:     headers,
+	}
+
+	candidatePods := make([]types.Pod, len(s.pods))
+	for i, pod := range s.pods {
+		candidatePods[i] = &types.PodMetrics{Pod: pod.endpoint.GetPod().Clone(), MetricsState: pod.endpoint.GetMetrics().Clone()}
+	}
+	result, err := scheduler.Schedule(ctx, llmRequest, candidatePods)
+	if err != nil {
+		return fmt.Errorf("failed to find target pod - %w", err)
+	}
+	primaryProfileResult := result.ProfileResults[result.PrimaryProfileName]
+	if primaryProfileResult == nil || len(primaryProfileResult.TargetPods) == 0 {
+		return errors.New("the scheduler returned no target pod")
+	}
+	targetPod, ok := s.byName[primaryProfileResult.TargetPods[0].GetPod().NamespacedName]
+	if !ok {
+		return fmt.Errorf("the scheduler returned an unknown pod %s", primaryProfileResult.TargetPods[0].GetPod().NamespacedName)
+	}
+	for _, plugin := range s.preRequestPlugins {
+		plugin.PreRequest(ctx, llmRequest, result)
+	}
+
+	outputTokens := record.OutputTokens
+	if outputTokens <= 0 {
+		outputTokens = body.MaxTokens()
+	}
+	if outputTokens <= 0 {
+		outputTokens = s.config.DefaultOutputTokens
+	}
+	targetPod.enqueue(&simRequest{
+		llmRequest:   llmRequest,
+		blockHashes:  hashPromptBlocks(promptText(body), s.config.Pod.BlockSize),
+		promptTokens: (body.PromptLength() + requtil.DefaultCharactersPerToken - 1) / requtil.DefaultCharactersPerToken,
+		outputTokens: outputTokens,
+		arrival:      arrival,
+	})
+	s.start(targetPod, arrival)
+	return nil
+}
+
+// advance completes the in-flight requests that end until the given time, in the order they end.
+func (s *Simulator) advance(ctx context.Context, until time.Duration, report *Report) {
+	for s.inFlight.Len() > 0 && s.inFlight[0].end <= until {
+		request := heap.Pop(&s.inFlight).(*simRequest)
+		request.pod.complete(request)
+		report.record(request)
+
+		response := &requestcontrol.Response{RequestId: request.llmRequest.RequestId, Headers: map[string]string{}, EndOfStream: true}
+		for _, plugin := range s.responseCompletePlugins {
+			plugin.ResponseComplete(ctx, request.llmRequest, response, request.pod.endpoint.GetPod())
+		}
+		s.start(request.pod, request.end)
+	}
+}
+
+// start admits the waiting requests of the pod that fit at the given time.
+func (s *Simulator) start(pod *simPod, now time.Duration) {
+	for _, request := range pod.admit(now) {
+		heap.Push(&s.inFlight, request)
+	}
+}
+
+// refreshMetrics publishes the state of the pods as their metrics, once per refresh interval.
+func (s *Simulator) refreshMetrics(now time.Duration) {
+	if s.config.MetricsRefreshInterval > 0 && now-s.lastRefresh < s.config.MetricsRefreshInterval {
+		return
+	}
+	s.lastRefresh = now
+	for _, pod := range s.pods {
+		pod.refreshMetrics(s.startTime.Add(now))
+	}
+}
+
+// completionQueue is a min-heap of in-flight requests ordered by their end time.
+type completionQueue []*simRequest
+
+func (q completionQueue) Len() int           { return len(q) }
+func (q completionQueue) Less(i, j int) bool { return q[i].end < q[j].end }
+func (q completionQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *completionQueue) Push(x any) {
+	*q = append(*q, x.(*simRequest))
+}
+
+func (q *completionQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return item
+}
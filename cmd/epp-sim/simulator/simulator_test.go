@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+// roundRobinScheduler picks the candidate pods in turn.
+type roundRobinScheduler struct {
+	next int
+}
+
+func (s *roundRobinScheduler) Schedule(_ context.Context, _ *types.LLMRequest, candidatePods []types.Pod) (*types.SchedulingResult, error) {
+	pod := candidatePods[s.next%len(candidatePods)]
+	s.next++
+	return &types.SchedulingResult{
+		ProfileResults:     map[string]*types.ProfileRunResult{"default": {TargetPods: []types.Pod{pod}}},
+		PrimaryProfileName: "default",
+	}, nil
+}
+
+func TestReadTrace(t *testing.T) {
+	trace := `{"timestamp": 2.5, "body": {"model": "m", "prompt": "b"}}
+
+{"timestamp": 1, "request_id": "first", "body": {"model": "m", "prompt": "a"}, "output_tokens": 3}
+`
+	records, err := ReadTrace(strings.NewReader(trace))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := []string{}
+	for _, record := range records {
+		got = append(got, record.RequestID)
+	}
+	if diff := cmp.Diff([]string{"first", "sim-1"}, got); diff != "" {
+		t.Errorf("Unexpected request IDs (-want +got): %s", diff)
+	}
+
+	if _, err := ReadTrace(strings.NewReader(`{"timestamp": 1}`)); err == nil {
+		t.Error("Expected an error for a record without a body")
+	}
+}
+
+func TestRun(t *testing.T) {
+	// a prompt of exactly 2 blocks of 16 tokens, 4 characters per token.
+	prompt := strings.Repeat("x", 2*16*4)
+	request := func(timestamp float64) TraceRecord {
+		return TraceRecord{Timestamp: timestamp, Body: map[string]any{"model": "m", "prompt": prompt}, OutputTokens: 10}
+	}
+	trace := []TraceRecord{request(10), request(10), request(11), request(11), {Timestamp: 12, Body: map[string]any{}}}
+
+	sim := New(Config{
+		Pods: 2,
+		Pod: PodConfig{
+			MaxRunningRequests:     1,
+			PrefillTokensPerSecond: 32,
+			DecodeTimePerToken:     100 * time.Millisecond,
+		},
+	})
+	report, err := sim.Run(context.Background(), &roundRobinScheduler{}, nil, trace)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The first request on each pod takes 1s of prefill and 1s of decode. The second request on each pod
+	// arrives after 1s, waits 1s for the first one to complete and hits the prefix cache, skipping prefill.
+	want := &Report{
+		Requests: 4,
+		Failed:   1,
+		Makespan: 3 * time.Second,
+		Pods: []PodReport{
+			{Name: "default/sim-pod-0", Requests: 2, Share: 0.5},
+			{Name: "default/sim-pod-1", Requests: 2, Share: 0.5},
+		},
+		PrefixHitRatio: 0.5,
+		Latency:        Percentiles{P50: 2 * time.Second, P90: 2 * time.Second, P99: 2 * time.Second},
+		TTFT:           Percentiles{P50: time.Second, P90: time.Second, P99: time.Second},
+	}
+	if diff := cmp.Diff(want, report, cmpopts.IgnoreUnexported(Report{})); diff != "" {
+		t.Errorf("Unexpected report (-want +got): %s", diff)
+	}
+}
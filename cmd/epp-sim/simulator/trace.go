@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// TraceRecord is a single request of a replayed trace.
+type TraceRecord struct {
+	// Timestamp is the arrival time of the request in seconds, relative to any fixed point in time.
+	Timestamp float64 `json:"timestamp"`
+	// RequestID is an optional identifier of the request. A unique identifier is generated if empty.
+	RequestID string `json:"request_id,omitempty"`
+	// Headers are the request headers, e.g., headers that scheduling plugins act upon.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the OpenAI completions or chat-completions request body.
+	Body map[string]any `json:"body"`
+	// OutputTokens is the number of tokens the model server generates for the request.
+	// If not set, the max_tokens of the request is used, or the simulator default.
+	OutputTokens int `json:"output_tokens,omitempty"`
+}
+
+// ReadTrace reads a JSONL trace, one TraceRecord per line, and returns the records ordered by timestamp.
+// Empty lines are ignored.
+func ReadTrace(reader io.Reader) ([]TraceRecord, error) {
+	var records []TraceRecord
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024) // requests with inline media can be large
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record TraceRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("failed to parse trace line %d - %w", line, err)
+		}
+		if record.Body == nil {
+			return nil, fmt.Errorf("trace line %d has no request body", line)
+		}
+		if record.RequestID == "" {
+			record.RequestID = fmt.Sprintf("sim-%d", line)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read trace - %w", err)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp < records[j].Timestamp
+	})
+	return records, nil
+}
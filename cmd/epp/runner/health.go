@@ -27,13 +27,17 @@ import (
 	healthPb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 
-	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
 
+// poolSyncer reports whether the served pools have synced.
+type poolSyncer interface {
+	PoolHasSynced() bool
+}
+
 type healthServer struct {
 	logger                logr.Logger
-	datastore             datastore.Datastore
+	datastore             poolSyncer
 	isLeader              *atomic.Bool
 	leaderElectionEnabled bool
 }
@@ -22,11 +22,15 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"hash/fnv"
+	"net"
 	"net/http"
 	"net/http/pprof"
 	"os"
 	"runtime"
+	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,8 +38,10 @@ import (
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	healthPb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -45,27 +51,32 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"sigs.k8s.io/gateway-api-inference-extension/internal/runnable"
+	tlsutil "sigs.k8s.io/gateway-api-inference-extension/internal/tls"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/controller"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/push"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
 	fccontroller "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/controller"
 	fcregistry "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/registry"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics/collectors"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/multicluster"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins/intree"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/poolset"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
-	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/multi/prefix"
-	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/picker"
-	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/profile"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/scorer"
-	testfilter "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/test/filter"
 	runserver "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/server"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/standalone"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/env"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/version"
@@ -77,17 +88,24 @@ const (
 	// enableExperimentalFlowControlLayer defines the environment variable used as a feature flag for the pluggable flow
 	// control layer.
 	enableExperimentalFlowControlLayer = "ENABLE_EXPERIMENTAL_FLOW_CONTROL_LAYER"
+
+	// endpointDiscoveryPods discovers the endpoints of a pool from the pods matching its selector.
+	endpointDiscoveryPods = "pods"
+	// endpointDiscoveryEndpointSlices discovers the endpoints of a pool from the EndpointSlices of its Service.
+	endpointDiscoveryEndpointSlices = "endpointslices"
 )
 
-// TODO: this is hardcoded for POC only. This needs to be hooked up to our text-based config story.
-var flowControlConfig = flowcontrol.Config{
+// defaultFlowControlConfig is the flow control configuration used when the configuration file or text has no
+// flow control section.
+var defaultFlowControlConfig = flowcontrol.Config{
 	Controller: fccontroller.Config{}, // Use all defaults.
 	Registry: fcregistry.Config{
 		// Define domain of accepted priority levels as this field is required. Use defaults for all optional fields.
-		// TODO: this should not be hardcoded.
 		PriorityBands: []fcregistry.PriorityBandConfig{
 			{Priority: 0, PriorityName: "Default"},
 		},
+		// Provision a band with default settings for each other priority of the InferenceObjectives.
+		PriorityBandTemplate: &fcregistry.PriorityBandConfig{},
 	},
 }
 
@@ -96,10 +114,13 @@ var (
 	grpcHealthPort      = flag.Int("grpc-health-port", runserver.DefaultGrpcHealthPort, "The port used for gRPC liveness and readiness probes")
 	metricsPort         = flag.Int("metrics-port", runserver.DefaultMetricsPort, "The metrics port")
 	metricsEndpointAuth = flag.Bool("metrics-endpoint-auth", true, "Enables authentication and authorization of the metrics endpoint")
+	loadReportsAuth     = flag.Bool("load-reports-auth", true, "Enables authentication and authorization of the load reports posted to the push data sources. Requires Kubernetes.")
 	enablePprof         = flag.Bool("enable-pprof", runserver.DefaultEnablePprof, "Enables pprof handlers. Defaults to true. Set to false to disable pprof handlers.")
 	poolName            = flag.String("pool-name", runserver.DefaultPoolName, "Name of the InferencePool this Endpoint Picker is associated with.")
 	poolGroup           = flag.String("pool-group", runserver.DefaultPoolGroup, "group of the InferencePool this Endpoint Picker is associated with.")
 	poolNamespace       = flag.String("pool-namespace", "", "Namespace of the InferencePool this Endpoint Picker is associated with.")
+	poolNames           = flag.String("pool-names", "", "Comma-separated names of the InferencePools served by this Endpoint Picker, in lieu of --pool-name. Requests select their pool with the '"+metadata.InferencePoolKey+"' header or request metadata.")
+	poolSelector        = flag.String("pool-selector", "", "Label selector of the InferencePools served by this Endpoint Picker, in lieu of --pool-name. Requests select their pool with the '"+metadata.InferencePoolKey+"' header or request metadata.")
 	logVerbosity        = flag.Int("v", logging.DEFAULT, "number for the log level verbosity")
 	secureServing       = flag.Bool("secure-serving", runserver.DefaultSecureServing, "Enables secure serving. Defaults to true.")
 	healthChecking      = flag.Bool("health-checking", runserver.DefaultHealthChecking, "Enables health checking")
@@ -126,8 +147,19 @@ var (
 	modelServerMetricsPath                    = flag.String("model-server-metrics-path", "/metrics", "Path to scrape metrics from pods")
 	modelServerMetricsScheme                  = flag.String("model-server-metrics-scheme", "http", "Scheme to scrape metrics from pods")
 	modelServerMetricsHttpsInsecureSkipVerify = flag.Bool("model-server-metrics-https-insecure-skip-verify", true, "When using 'https' scheme for 'model-server-metrics-scheme', configure 'InsecureSkipVerify' (default to true)")
+	modelServerMetricsFormat                  = flag.String("model-server-metrics-format", string(dlmetrics.FormatText), "Exposition format requested when scraping metrics from pods, 'text' or 'protobuf'. Pods not supporting protobuf respond with the text format.")
+	metricsCollectionWorkers                  = flag.Int("metrics-collection-workers", datalayer.DefaultWorkerPoolSize, "Maximal number of concurrent metrics collections, shared by all pods.")
 	haEnableLeaderElection                    = flag.Bool("ha-enable-leader-election", false, "Enables leader election for high availability. When enabled, readiness probes will only pass on the leader.")
 	tracing                                   = flag.Bool("tracing", true, "Enables emitting traces")
+	endpointDiscovery                         = flag.String("endpoint-discovery", endpointDiscoveryPods, "Source of the endpoints of the InferencePool, 'pods' for the pods matching the pool's selector, or 'endpointslices' for the EndpointSlices of the pool's Service.")
+	endpointSliceService                      = flag.String("endpoint-slice-service", "", "Name of the Service whose EndpointSlices list the endpoints of the InferencePool, when --endpoint-discovery is 'endpointslices'. Defaults to the name of the pool.")
+	standalonePoolFile                        = flag.String("standalone-pool-file", "", "Path of a YAML or JSON file defining the pool, its endpoints and its objectives, reloaded when it changes. Runs the Endpoint Picker without Kubernetes.")
+	standalonePoolSRV                         = flag.String("standalone-pool-srv", "", "DNS name of the SRV records listing the endpoints of the pool. Runs the Endpoint Picker without Kubernetes.")
+	standaloneRefreshInterval                 = flag.Duration("standalone-refresh-interval", runserver.DefaultStandaloneRefreshInterval, "Interval between two reloads of --standalone-pool-file and resolutions of --standalone-pool-srv.")
+	endpointDrainTimeout                      = flag.Duration("endpoint-drain-timeout", runserver.DefaultEndpointDrainTimeout, "Maximal duration a pod leaving the pool keeps serving its in-flight requests, without receiving new ones. Set to 0 to remove pods right away.")
+	statusUpdateInterval                      = flag.Duration("status-update-interval", runserver.DefaultStatusUpdateInterval, "Interval between two writes of the InferencePool and InferenceObjective status by the leader. Set to 0 to disable status writes.")
+	clusterName                               = flag.String("cluster-name", "", "Name of the local cluster. It is skipped when listed as an exporting cluster by the InferencePoolImport of a pool.")
+	remoteStatusInterval                      = flag.Duration("remote-status-interval", runserver.DefaultRemoteStatusInterval, "Interval between two syncs of the remote endpoints of the pools in the clusters exporting them, when a remote status source is set.")
 
 	setupLog = ctrl.Log.WithName("setup")
 )
@@ -143,6 +175,9 @@ func NewRunner() *Runner {
 type Runner struct {
 	requestControlConfig *requestcontrol.Config
 	schedulerConfig      *scheduling.SchedulerConfig
+	dataLayerConfig      *datalayer.Config
+	flowControlConfig    *flowcontrol.Config
+	remoteStatusSource   multicluster.StatusSource
 }
 
 func (r *Runner) WithRequestControlConfig(requestControlConfig *requestcontrol.Config) *Runner {
@@ -155,6 +190,13 @@ func (r *Runner) WithSchedulerConfig(schedulerConfig *scheduling.SchedulerConfig
 	return r
 }
 
+// WithRemoteStatusSource routes requests to the clusters exporting the served pools, as listed by their
+// InferencePoolImports, using the status of the pools in these clusters provided by the given source.
+func (r *Runner) WithRemoteStatusSource(source multicluster.StatusSource) *Runner {
+	r.remoteStatusSource = source
+	return r
+}
+
 func (r *Runner) Run(ctx context.Context) error {
 	opts := zap.Options{
 		Development: true,
@@ -188,10 +230,34 @@ func (r *Runner) Run(ctx context.Context) error {
 	// --- Load Configurations from Environment Variables ---
 	sdConfig := saturationdetector.LoadConfigFromEnv()
 
+	// A standalone Endpoint Picker runs without Kubernetes, its pool being defined by a file or DNS SRV records.
+	standaloneMode := isStandalone()
+	if standaloneMode && r.remoteStatusSource != nil {
+		err := errors.New("remote status source can not be set in standalone mode")
+		setupLog.Error(err, "Failed to set up multi-cluster routing")
+		return err
+	}
+
 	// --- Get Kubernetes Config ---
-	cfg, err := ctrl.GetConfig()
+	var cfg *rest.Config
+	var err error
+	if !standaloneMode {
+		cfg, err = ctrl.GetConfig()
+		if err != nil {
+			setupLog.Error(err, "Failed to get Kubernetes rest config")
+			return err
+		}
+	}
+
+	// --- Load Configuration ---
+	// The configuration is loaded before the datastore is created, since it may configure the data
+	// sources of the datastore's endpoints. The plugins list no pods until the datastore is set.
+	// An Endpoint Picker serving several pools has a datastore per pool, created when the pool is reconciled.
+	multiPool := *poolNames != "" || *poolSelector != ""
+	podLister := &datastorePodLister{}
+	err = r.parsePluginsConfiguration(ctx, podLister.PodList, multiPool)
 	if err != nil {
-		setupLog.Error(err, "Failed to get Kubernetes rest config")
+		setupLog.Error(err, "Failed to parse plugins configuration")
 		return err
 	}
 
@@ -201,10 +267,22 @@ func (r *Runner) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	datastore := datastore.NewDatastore(ctx, epf, int32(*modelServerMetricsPort))
+	var pools *poolset.Registry
+	var ds datastore.Datastore
+	if !multiPool {
+		ds = datastore.NewDatastore(ctx, epf, int32(*modelServerMetricsPort), datastoreOptions(*endpointSliceService)...)
+		podLister.set(ds)
+	}
 
 	// --- Setup Metrics Server ---
-	customCollectors := []prometheus.Collector{collectors.NewInferencePoolMetricsCollector(datastore)}
+	var customCollectors []prometheus.Collector
+	if multiPool {
+		customCollectors = append(customCollectors, collectors.NewInferencePoolSetMetricsCollector(func() []datastore.Datastore {
+			return pools.Datastores()
+		}))
+	} else {
+		customCollectors = append(customCollectors, collectors.NewInferencePoolMetricsCollector(ds))
+	}
 	metrics.Register(customCollectors...)
 	metrics.RecordInferenceExtensionInfo(version.CommitSHA, version.BuildRef)
 	// Register metrics handler.
@@ -250,10 +328,38 @@ func (r *Runner) Run(ctx context.Context) error {
 	isLeader := &atomic.Bool{}
 	isLeader.Store(false)
 
-	mgr, err := runserver.NewDefaultManager(poolGKNN, cfg, metricsServerOptions, *haEnableLeaderElection)
-	if err != nil {
-		setupLog.Error(err, "Failed to create controller manager")
-		return err
+	// The runnables are started by the controller manager or, in standalone mode, by a runnable group.
+	var mgr ctrl.Manager
+	var runnables runnableAdder
+	var metricsHandlers metricsHandlerAdder
+	var start func(context.Context) error
+	switch {
+	case standaloneMode:
+		group := &runnable.Group{}
+		metricsMux := newMetricsMux()
+		if err := group.Add(metricsMux.server(*metricsPort)); err != nil {
+			return err
+		}
+		runnables, metricsHandlers, start = group, metricsMux, group.Start
+	case multiPool:
+		poolLabels, poolSetID, err := poolSelection()
+		if err != nil {
+			return err
+		}
+		mgr, err = runserver.NewMultiPoolManager(poolGKNN, poolLabels, poolSetID, cfg, metricsServerOptions, *haEnableLeaderElection)
+		if err != nil {
+			setupLog.Error(err, "Failed to create controller manager")
+			return err
+		}
+	default:
+		mgr, err = runserver.NewDefaultManager(poolGKNN, cfg, metricsServerOptions, *haEnableLeaderElection)
+		if err != nil {
+			setupLog.Error(err, "Failed to create controller manager")
+			return err
+		}
+	}
+	if mgr != nil {
+		runnables, metricsHandlers, start = mgr, mgr, mgr.Start
 	}
 
 	if *haEnableLeaderElection {
@@ -270,7 +376,7 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	if *enablePprof {
 		setupLog.Info("Enabling pprof handlers")
-		err = setupPprofHandlers(mgr)
+		err = setupPprofHandlers(metricsHandlers)
 		if err != nil {
 			setupLog.Error(err, "Failed to setup pprof handlers")
 			return err
@@ -279,12 +385,6 @@ func (r *Runner) Run(ctx context.Context) error {
 		runtime.SetBlockProfileRate(1)
 	}
 
-	err = r.parsePluginsConfiguration(ctx, datastore)
-	if err != nil {
-		setupLog.Error(err, "Failed to parse plugins configuration")
-		return err
-	}
-
 	// --- Initialize Core EPP Components ---
 	if r.schedulerConfig == nil {
 		err := errors.New("scheduler config must be set either by config api or through code")
@@ -294,54 +394,22 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	setupLog.Info("parsed config", "scheduler-config", r.schedulerConfig)
 
-	scheduler := scheduling.NewSchedulerWithConfig(r.schedulerConfig)
-
-	saturationDetector := saturationdetector.NewDetector(sdConfig, setupLog)
-
-	// --- Admission Control Initialization ---
-	enableFlowControl := env.GetEnvBool(enableExperimentalFlowControlLayer, false, setupLog)
-	var admissionController requestcontrol.AdmissionController
-	if enableFlowControl {
-		setupLog.Info("Initializing experimental Flow Control layer")
-		fcCfg, err := flowControlConfig.ValidateAndApplyDefaults()
+	var director *requestcontrol.Director
+	var saturationDetector *saturationdetector.Detector
+	var priorityBands controller.PriorityBandSyncer
+	if !multiPool {
+		director, saturationDetector, priorityBands, err = newDirector(ctx, ds, r.schedulerConfig, r.requestControlConfig, r.flowControlConfig, sdConfig)
 		if err != nil {
-			setupLog.Error(err, "failed to initialize Flow Control layer")
-			return fmt.Errorf("invalid Flow Control config: %w", err)
-		}
-
-		registry, err := fcregistry.NewFlowRegistry(fcCfg.Registry, setupLog)
-		if err != nil {
-			return fmt.Errorf("failed to initialize Flow Registry: %w", err)
-		}
-		fc, err := fccontroller.NewFlowController(
-			ctx,
-			fcCfg.Controller,
-			registry,
-			saturationDetector,
-			setupLog,
-		)
-		if err != nil {
-			return fmt.Errorf("failed to initialize Flow Controller: %w", err)
+			return err
 		}
-		go registry.Run(ctx)
-		admissionController = requestcontrol.NewFlowControlAdmissionController(saturationDetector, fc)
-	} else {
-		setupLog.Info("Experimental Flow Control layer is disabled, using legacy admission control")
-		admissionController = requestcontrol.NewLegacyAdmissionController(saturationDetector)
 	}
 
-	director := requestcontrol.NewDirectorWithConfig(
-		datastore,
-		scheduler,
-		admissionController,
-		r.requestControlConfig)
-
 	// --- Setup ExtProc Server Runner ---
 	serverRunner := &runserver.ExtProcServerRunner{
 		GrpcPort:                         *grpcPort,
 		PoolNamespacedName:               poolNamespacedName,
 		PoolGKNN:                         poolGKNN,
-		Datastore:                        datastore,
+		Datastore:                        ds,
 		SecureServing:                    *secureServing,
 		HealthChecking:                   *healthChecking,
 		CertPath:                         *certPath,
@@ -349,28 +417,63 @@ func (r *Runner) Run(ctx context.Context) error {
 		MetricsStalenessThreshold:        *metricsStalenessThreshold,
 		Director:                         director,
 		SaturationDetector:               saturationDetector,
+		PriorityBands:                    priorityBands,
 		UseExperimentalDatalayerV2:       useDatalayerV2, // pluggable data layer feature flag
-	}
-	if err := serverRunner.SetupWithManager(ctx, mgr); err != nil {
+		UseEndpointSlices:                *endpointDiscovery == endpointDiscoveryEndpointSlices,
+		StatusUpdateInterval:             *statusUpdateInterval,
+		RemoteStatusSource:               r.remoteStatusSource,
+		RemoteStatusInterval:             *remoteStatusInterval,
+		ClusterName:                      *clusterName,
+	}
+	if multiPool {
+		pools = poolset.NewRegistry(ctx, resolvedPoolNamespace, poolSetSelector(),
+			r.newPoolStackFactory(epf, sdConfig, serverRunner))
+		serverRunner.Pools = pools
+	}
+	if standaloneMode {
+		// The standalone source feeds the datastore in lieu of the controllers.
+		if err := runnables.Add(&standalone.Source{
+			Datastore:       ds,
+			Pool:            poolNamespacedName,
+			ConfigFile:      *standalonePoolFile,
+			SRVName:         *standalonePoolSRV,
+			RefreshInterval: *standaloneRefreshInterval,
+			PriorityBands:   priorityBands,
+		}); err != nil {
+			setupLog.Error(err, "Failed to register standalone pool source")
+			return err
+		}
+	} else if err := serverRunner.SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "Failed to setup EPP controllers")
 		return err
 	}
 
 	// --- Add Runnables to Manager ---
 	// Register health server.
-	if err := registerHealthServer(mgr, ctrl.Log.WithName("health"), datastore, *grpcHealthPort, isLeader, *haEnableLeaderElection); err != nil {
+	var synced poolSyncer = ds
+	if multiPool {
+		synced = pools
+	}
+	if err := registerHealthServer(runnables, ctrl.Log.WithName("health"), synced, *grpcHealthPort, isLeader, *haEnableLeaderElection); err != nil {
 		return err
 	}
 
 	// Register ext-proc server.
-	if err := registerExtProcServer(mgr, serverRunner, ctrl.Log.WithName("ext-proc")); err != nil {
+	if err := registerExtProcServer(runnables, serverRunner, ctrl.Log.WithName("ext-proc")); err != nil {
 		return err
 	}
 
+	// Register load reports servers of the push data sources.
+	if useDatalayerV2 {
+		if err := registerPushServers(runnables, r.dataLayerConfig, cfg); err != nil {
+			return err
+		}
+	}
+
 	// --- Start Manager ---
 	// This blocks until a signal is received.
-	setupLog.Info("Controller manager starting")
-	if err := mgr.Start(ctx); err != nil {
+	setupLog.Info("Controller manager starting", "standalone", standaloneMode)
+	if err := start(ctx); err != nil {
 		setupLog.Error(err, "Error starting controller manager")
 		return err
 	}
@@ -378,23 +481,57 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
-// registerInTreePlugins registers the factory functions of all known plugins
-func (r *Runner) registerInTreePlugins() {
-	plugins.Register(prefix.PrefixCachePluginType, prefix.PrefixCachePluginFactory)
-	plugins.Register(picker.MaxScorePickerType, picker.MaxScorePickerFactory)
-	plugins.Register(picker.RandomPickerType, picker.RandomPickerFactory)
-	plugins.Register(picker.WeightedRandomPickerType, picker.WeightedRandomPickerFactory)
-	plugins.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
-	plugins.Register(scorer.KvCacheUtilizationScorerType, scorer.KvCacheUtilizationScorerFactory)
-	plugins.Register(scorer.QueueScorerType, scorer.QueueScorerFactory)
-	plugins.Register(scorer.LoraAffinityScorerType, scorer.LoraAffinityScorerFactory)
-	// register filter for test purpose only (used in conformance tests)
-	plugins.Register(testfilter.HeaderBasedTestingFilterType, testfilter.HeaderBasedTestingFilterFactory)
+// parsePluginsConfiguration loads the configuration file or text. When serving several pools, each pool instantiates
+// its own plugins, so the plugins instantiated here are stopped once the configuration is loaded.
+func (r *Runner) parsePluginsConfiguration(ctx context.Context, podList plugins.PodListFunc, multiPool bool) error {
+	if multiPool {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+	}
+	config, handle, err := loadPluginsConfiguration(ctx, podList)
+	if err != nil || config == nil {
+		return err
+	}
+
+	r.schedulerConfig = config.SchedulerConfig
+	r.dataLayerConfig = config.DataLayerConfig
+	r.flowControlConfig = config.FlowControlConfig
+
+	// Add requestControl plugins
+	if !multiPool {
+		r.requestControlConfig.AddPlugins(handle.GetAllPlugins()...)
+	}
+
+	log.FromContext(ctx).Info("loaded configuration from file/text successfully")
+	return nil
+}
+
+// datastorePodLister lists the pods of a datastore that is created after the plugins listing them are instantiated.
+// It lists no pods until the datastore is set.
+type datastorePodLister struct {
+	ds atomic.Pointer[datastore.Datastore]
 }
 
-func (r *Runner) parsePluginsConfiguration(ctx context.Context, ds datastore.Datastore) error {
+// set sets the datastore whose pods are listed.
+func (l *datastorePodLister) set(ds datastore.Datastore) {
+	l.ds.Store(&ds)
+}
+
+// PodList lists the pods of the datastore matching the predicate, if the datastore is set.
+func (l *datastorePodLister) PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics {
+	ds := l.ds.Load()
+	if ds == nil {
+		return nil
+	}
+	return (*ds).PodList(predicate)
+}
+
+// loadPluginsConfiguration loads the configuration file or text, instantiating its plugins. It returns a nil
+// configuration when configuring through code.
+func loadPluginsConfiguration(ctx context.Context, podList plugins.PodListFunc) (*config.Config, plugins.Handle, error) {
 	if *configText == "" && *configFile == "" {
-		return nil // configuring through code, not through file
+		return nil, nil, nil // configuring through code, not through file
 	}
 
 	logger := log.FromContext(ctx)
@@ -406,30 +543,161 @@ func (r *Runner) parsePluginsConfiguration(ctx context.Context, ds datastore.Dat
 		var err error
 		configBytes, err = os.ReadFile(*configFile)
 		if err != nil {
-			return fmt.Errorf("failed to load config from a file '%s' - %w", *configFile, err)
+			return nil, nil, fmt.Errorf("failed to load config from a file '%s' - %w", *configFile, err)
 		}
 	}
 
-	r.registerInTreePlugins()
-	handle := plugins.NewEppHandle(ctx, ds.PodList)
-	config, err := loader.LoadConfig(configBytes, handle, logger)
+	intree.Register()
+	handle := plugins.NewEppHandle(ctx, podList)
+	useDatalayerV2 := env.GetEnvBool(enableExperimentalDatalayerV2, false, logger)
+	enableFlowControl := env.GetEnvBool(enableExperimentalFlowControlLayer, false, logger)
+	config, err := loader.LoadConfig(configBytes, handle, logger,
+		loader.WithDataLayer(useDatalayerV2), loader.WithFlowControl(enableFlowControl))
 
 	if err != nil {
-		return fmt.Errorf("failed to load the configuration - %w", err)
+		return nil, nil, fmt.Errorf("failed to load the configuration - %w", err)
 	}
+	return config, handle, nil
+}
 
-	r.schedulerConfig = config.SchedulerConfig
+// newDirector creates the director of a pool, with its scheduler, saturation detector and admission controller.
+// A nil flow control configuration stands for the default one. The returned priority bands, if any, are to be
+// synchronized with the priorities of the InferenceObjectives of the pool.
+func newDirector(ctx context.Context, ds datastore.Datastore, schedulerConfig *scheduling.SchedulerConfig,
+	requestControlConfig *requestcontrol.Config, flowControlConfig *flowcontrol.Config, sdConfig *saturationdetector.Config) (*requestcontrol.Director, *saturationdetector.Detector, controller.PriorityBandSyncer, error) {
+	scheduler := scheduling.NewSchedulerWithConfig(schedulerConfig)
 
-	// Add requestControl plugins
-	r.requestControlConfig.AddPlugins(handle.GetAllPlugins()...)
+	saturationDetector := saturationdetector.NewDetector(sdConfig, setupLog)
 
-	logger.Info("loaded configuration from file/text successfully")
-	return nil
+	// --- Admission Control Initialization ---
+	enableFlowControl := env.GetEnvBool(enableExperimentalFlowControlLayer, false, setupLog)
+	var admissionController requestcontrol.AdmissionController
+	var priorityBands controller.PriorityBandSyncer
+	if enableFlowControl {
+		setupLog.Info("Initializing experimental Flow Control layer")
+		if flowControlConfig == nil {
+			flowControlConfig = &defaultFlowControlConfig
+		}
+		fcCfg, err := flowControlConfig.ValidateAndApplyDefaults()
+		if err != nil {
+			setupLog.Error(err, "failed to initialize Flow Control layer")
+			return nil, nil, nil, fmt.Errorf("invalid Flow Control config: %w", err)
+		}
+
+		registry, err := fcregistry.NewFlowRegistry(fcCfg.Registry, setupLog)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to initialize Flow Registry: %w", err)
+		}
+		fc, err := fccontroller.NewFlowController(
+			ctx,
+			fcCfg.Controller,
+			registry,
+			saturationDetector,
+			setupLog,
+		)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to initialize Flow Controller: %w", err)
+		}
+		go registry.Run(ctx)
+		// The bands are provisioned as the InferenceObjectives are reconciled, and released by the registry's GC.
+		if fcCfg.Registry.PriorityBandTemplate != nil {
+			if err := controller.SyncPriorityBands(ds, registry); err != nil {
+				return nil, nil, nil, err
+			}
+			priorityBands = registry
+		}
+		admissionController = requestcontrol.NewFlowControlAdmissionController(saturationDetector, fc).WithFairnessWeights(fcCfg.FairnessWeights)
+	} else {
+		setupLog.Info("Experimental Flow Control layer is disabled, using legacy admission control")
+		admissionController = requestcontrol.NewLegacyAdmissionController(saturationDetector)
+	}
+
+	// The remote endpoints of the pool are only listed when a scheduling profile prefers the local ones, and the
+	// scheduler keeps them out of the other profiles.
+	requestControlConfig.WithRemoteEndpoints(schedulerConfig.HasScorer(scorer.ClusterLocalityScorerType))
+	director := requestcontrol.NewDirectorWithConfig(
+		ds,
+		scheduler,
+		admissionController,
+		requestControlConfig)
+	return director, saturationDetector, priorityBands, nil
+}
+
+// newPoolStackFactory returns the factory of the stacks of the pools served by an Endpoint Picker serving
+// several pools. The plugins of the configuration file or text are instantiated for each pool, so that pools
+// do not share plugin state, while a configuration set through code is shared by all pools.
+func (r *Runner) newPoolStackFactory(epf datalayer.EndpointFactory, sdConfig *saturationdetector.Config,
+	serverRunner *runserver.ExtProcServerRunner) poolset.StackFactory {
+	return func(ctx context.Context, pool types.NamespacedName) (*poolset.Stack, error) {
+		logger := setupLog.WithValues("pool", pool)
+		ctx = log.IntoContext(ctx, logger)
+
+		// The endpoint slices of each pool are those of the Service named after the pool.
+		ds := datastore.NewDatastore(ctx, epf, int32(*modelServerMetricsPort), datastoreOptions("")...)
+		schedulerConfig, requestControlConfig, flowControlConfig := r.schedulerConfig, r.requestControlConfig, r.flowControlConfig
+		config, handle, err := loadPluginsConfiguration(ctx, func(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics {
+			return ds.PodList(predicate)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			schedulerConfig = config.SchedulerConfig
+			requestControlConfig = requestcontrol.NewConfig()
+			requestControlConfig.AddPlugins(handle.GetAllPlugins()...)
+			flowControlConfig = config.FlowControlConfig
+		}
+
+		director, _, priorityBands, err := newDirector(ctx, ds, schedulerConfig, requestControlConfig, flowControlConfig, sdConfig)
+		if err != nil {
+			return nil, err
+		}
+		serverRunner.StartMetricsLogger(ctx, ds)
+		logger.Info("Created the stack of the InferencePool")
+		return &poolset.Stack{Datastore: ds, Director: director, PriorityBands: priorityBands}, nil
+	}
+}
+
+// datastoreOptions returns the options of the datastores, draining the removed pods and discovering the endpoints
+// from the EndpointSlices of the given service when enabled.
+func datastoreOptions(service string) []datastore.Option {
+	opts := []datastore.Option{datastore.WithDrainTimeout(*endpointDrainTimeout)}
+	if *endpointDiscovery == endpointDiscoveryEndpointSlices {
+		opts = append(opts, datastore.WithEndpointSlices(service))
+	}
+	return opts
+}
+
+// poolSetSelector returns the selector of the pools served by an Endpoint Picker serving several pools.
+func poolSetSelector() poolset.Selector {
+	// The label selector was validated with the flags.
+	poolLabels, _, _ := poolSelection()
+	return poolset.Selector{
+		Names:  sets.New(strings.Split(*poolNames, ",")...),
+		Labels: poolLabels,
+	}
+}
+
+// poolSelection parses the pool selection flags, returning the label selector of the served pools, nil when
+// they are selected by name, and an identifier of the selection naming the leader election lease.
+func poolSelection() (labels.Selector, string, error) {
+	selection := "names=" + *poolNames
+	var poolLabels labels.Selector
+	if *poolSelector != "" {
+		var err error
+		if poolLabels, err = labels.Parse(*poolSelector); err != nil {
+			return nil, "", fmt.Errorf("invalid %q flag - %w", "pool-selector", err)
+		}
+		selection = "selector=" + poolLabels.String()
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(selection))
+	return poolLabels, fmt.Sprintf("pools-%08x", hash.Sum32()), nil
 }
 
 func (r *Runner) setupMetricsCollection(setupLog logr.Logger, useExperimentalDatalayer bool) (datalayer.EndpointFactory, error) {
 	if useExperimentalDatalayer {
-		return setupDatalayer()
+		return setupDatalayer(r.dataLayerConfig)
 	}
 
 	if len(datalayer.GetSources()) != 0 {
@@ -450,39 +718,40 @@ func setupMetricsV1(setupLog logr.Logger) (datalayer.EndpointFactory, error) {
 		return nil, err
 	}
 	verifyMetricMapping(*mapping, setupLog)
-
-	var metricsHttpClient *http.Client
-	if *modelServerMetricsScheme == "https" {
-		metricsHttpClient = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: *modelServerMetricsHttpsInsecureSkipVerify,
-				},
-			},
-		}
-	} else {
-		metricsHttpClient = http.DefaultClient
+	engineMappings, err := backendmetrics.NewEngineMetricMappings()
+	if err != nil {
+		setupLog.Error(err, "Failed to create the engine metric mappings.")
+		return nil, err
 	}
 
 	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.PodMetricsClientImpl{
 		MetricMapping:            mapping,
+		EngineMappings:           engineMappings,
 		ModelServerMetricsPath:   *modelServerMetricsPath,
 		ModelServerMetricsScheme: *modelServerMetricsScheme,
-		Client:                   metricsHttpClient,
+		ModelServerMetricsFormat: dlmetrics.Format(*modelServerMetricsFormat),
+		Client:                   dlmetrics.NewHTTPClient(*modelServerMetricsScheme, *modelServerMetricsHttpsInsecureSkipVerify),
 	},
-		*refreshMetricsInterval)
+		*refreshMetricsInterval).WithWorkerPool(datalayer.NewWorkerPool(*metricsCollectionWorkers))
 	return pmf, nil
 }
 
-func setupDatalayer() (datalayer.EndpointFactory, error) {
-	// create and register a metrics data source and extractor. In the future,
-	// data sources and extractors might be configured via a file. Once done,
-	// this (and registering the sources with the endpoint factory) should
-	// be moved accordingly.
+func setupDatalayer(dataLayerConfig *datalayer.Config) (datalayer.EndpointFactory, error) {
+	if dataLayerConfig != nil { // data sources and extractors were configured via the configuration file
+		for _, sourceConfig := range dataLayerConfig.Sources {
+			if err := datalayer.RegisterSource(sourceConfig.Source); err != nil {
+				return nil, err
+			}
+		}
+		return datalayer.NewEndpointFactoryFromConfig(dataLayerConfig, *refreshMetricsInterval).
+			WithWorkerPool(datalayer.NewWorkerPool(*metricsCollectionWorkers)), nil
+	}
+
+	// otherwise, create and register a metrics data source and extractor from the command line flags.
 	source := dlmetrics.NewDataSource(*modelServerMetricsScheme,
 		*modelServerMetricsPath,
 		*modelServerMetricsHttpsInsecureSkipVerify,
-		nil)
+		nil).WithFormat(dlmetrics.Format(*modelServerMetricsFormat))
 	extractor, err := dlmetrics.NewExtractor(*totalQueuedRequestsMetric,
 		*kvCacheUsagePercentageMetric,
 		*loraInfoMetric, *cacheInfoMetric)
@@ -497,7 +766,8 @@ func setupDatalayer() (datalayer.EndpointFactory, error) {
 		return nil, err
 	}
 
-	factory := datalayer.NewEndpointFactory(datalayer.GetSources(), *refreshMetricsInterval)
+	factory := datalayer.NewEndpointFactory(datalayer.GetSources(), *refreshMetricsInterval).
+		WithWorkerPool(datalayer.NewWorkerPool(*metricsCollectionWorkers))
 	return factory, nil
 }
 
@@ -520,7 +790,7 @@ func initLogging(opts *zap.Options) {
 }
 
 // registerExtProcServer adds the ExtProcServerRunner as a Runnable to the manager.
-func registerExtProcServer(mgr manager.Manager, runner *runserver.ExtProcServerRunner, logger logr.Logger) error {
+func registerExtProcServer(mgr runnableAdder, runner *runserver.ExtProcServerRunner, logger logr.Logger) error {
 	if err := mgr.Add(runner.AsRunnable(logger)); err != nil {
 		setupLog.Error(err, "Failed to register ext-proc gRPC server runnable")
 		return err
@@ -530,7 +800,7 @@ func registerExtProcServer(mgr manager.Manager, runner *runserver.ExtProcServerR
 }
 
 // registerHealthServer adds the Health gRPC server as a Runnable to the given manager.
-func registerHealthServer(mgr manager.Manager, logger logr.Logger, ds datastore.Datastore, port int, isLeader *atomic.Bool, leaderElectionEnabled bool) error {
+func registerHealthServer(mgr runnableAdder, logger logr.Logger, ds poolSyncer, port int, isLeader *atomic.Bool, leaderElectionEnabled bool) error {
 	srv := grpc.NewServer()
 	healthPb.RegisterHealthServer(srv, &healthServer{
 		logger:                logger,
@@ -546,16 +816,152 @@ func registerHealthServer(mgr manager.Manager, logger logr.Logger, ds datastore.
 	return nil
 }
 
+// registerPushServers adds an HTTP server accepting load reports for each configured push data source.
+func registerPushServers(mgr runnableAdder, dataLayerConfig *datalayer.Config, cfg *rest.Config) error {
+	if dataLayerConfig == nil {
+		return nil
+	}
+	var pushSources []*push.DataSource
+	for _, sourceConfig := range dataLayerConfig.Sources {
+		if source, ok := sourceConfig.Source.(*push.DataSource); ok {
+			pushSources = append(pushSources, source)
+		}
+	}
+	if len(pushSources) == 0 {
+		return nil
+	}
+
+	// Load reports steer routing, so the model servers posting them are authenticated, and authorized to post
+	// to the reports path, through the Kubernetes API server, like the clients of the metrics endpoint.
+	var authFilter metricsserver.Filter
+	if *loadReportsAuth {
+		if cfg == nil {
+			err := errors.New("authentication of the load reports requires Kubernetes, disable it with --load-reports-auth=false")
+			setupLog.Error(err, "Failed to register load reports servers")
+			return err
+		}
+		httpClient, err := rest.HTTPClientFor(cfg)
+		if err != nil {
+			setupLog.Error(err, "Failed to create the HTTP client authenticating load reports")
+			return err
+		}
+		if authFilter, err = filters.WithAuthenticationAndAuthorization(cfg, httpClient); err != nil {
+			setupLog.Error(err, "Failed to create the filter authenticating load reports")
+			return err
+		}
+	}
+	var tlsConfig *tls.Config
+	if *secureServing {
+		cert, err := servingCertificate()
+		if err != nil {
+			setupLog.Error(err, "Failed to load the certificate of the load reports servers")
+			return err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+
+	for _, source := range pushSources {
+		handler := source.Handler()
+		if authFilter != nil {
+			var err error
+			if handler, err = authFilter(ctrl.Log.WithName(source.Name()), handler); err != nil {
+				setupLog.Error(err, "Failed to authenticate load reports", "source", source.Name())
+				return err
+			}
+		}
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", source.Port()))
+		if err != nil {
+			setupLog.Error(err, "Failed to listen for load reports", "source", source.Name())
+			return err
+		}
+		if tlsConfig != nil {
+			listener = tls.NewListener(listener, tlsConfig)
+		}
+		srv := &manager.Server{
+			Name: source.Name(),
+			Server: &http.Server{
+				Handler:           handler,
+				ReadHeaderTimeout: 10 * time.Second,
+			},
+			Listener: listener,
+		}
+		if err := mgr.Add(srv); err != nil {
+			setupLog.Error(err, "Failed to register load reports server", "source", source.Name())
+			return err
+		}
+		setupLog.Info("Load reports server added to manager", "source", source.Name(), "port", source.Port(),
+			"tls", tlsConfig != nil, "auth", authFilter != nil)
+	}
+	return nil
+}
+
+// servingCertificate returns the certificate of the cert-path flag, or a self-signed certificate when it is not set.
+func servingCertificate() (tls.Certificate, error) {
+	if *certPath != "" {
+		return tls.LoadX509KeyPair(*certPath+"/tls.crt", *certPath+"/tls.key")
+	}
+	return tlsutil.CreateSelfSignedTLSCertificate(setupLog)
+}
+
 func validateFlags() error {
-	if *poolName == "" {
+	poolSelections := 0
+	for _, selection := range []string{*poolName, *poolNames, *poolSelector} {
+		if selection != "" {
+			poolSelections++
+		}
+	}
+	if poolSelections == 0 && *standalonePoolFile == "" {
 		return fmt.Errorf("required %q flag not set", "poolName")
 	}
+	if poolSelections > 1 {
+		return fmt.Errorf("only one of the %q, %q and %q flags can be set", "pool-name", "pool-names", "pool-selector")
+	}
+	if _, _, err := poolSelection(); err != nil {
+		return err
+	}
+	if *endpointDiscovery != endpointDiscoveryPods && *endpointDiscovery != endpointDiscoveryEndpointSlices {
+		return fmt.Errorf("unexpected %q value for %q flag, it can only be set to '%s' or '%s'", *endpointDiscovery, "endpoint-discovery",
+			endpointDiscoveryPods, endpointDiscoveryEndpointSlices)
+	}
+	if *endpointSliceService != "" && (*endpointDiscovery != endpointDiscoveryEndpointSlices || *poolName == "") {
+		return fmt.Errorf("the %q flag can only be set with a single pool and the %q endpoint discovery", "endpoint-slice-service",
+			endpointDiscoveryEndpointSlices)
+	}
+	if isStandalone() {
+		if *poolNames != "" || *poolSelector != "" {
+			return fmt.Errorf("the %q and %q flags can not be set in standalone mode", "pool-names", "pool-selector")
+		}
+		if *endpointDiscovery != endpointDiscoveryPods {
+			return fmt.Errorf("the %q flag can not be set in standalone mode", "endpoint-discovery")
+		}
+		if *haEnableLeaderElection {
+			return fmt.Errorf("the %q flag can not be set in standalone mode", "ha-enable-leader-election")
+		}
+		if *standaloneRefreshInterval <= 0 {
+			return fmt.Errorf("the %q flag must be positive", "standalone-refresh-interval")
+		}
+	}
+	if *endpointDrainTimeout < 0 {
+		return fmt.Errorf("the %q flag can not be negative", "endpoint-drain-timeout")
+	}
+	if *statusUpdateInterval < 0 {
+		return fmt.Errorf("the %q flag can not be negative", "status-update-interval")
+	}
+	if *remoteStatusInterval <= 0 {
+		return fmt.Errorf("the %q flag must be positive", "remote-status-interval")
+	}
 	if *configText != "" && *configFile != "" {
 		return fmt.Errorf("both the %q and %q flags can not be set at the same time", "configText", "configFile")
 	}
 	if *modelServerMetricsScheme != "http" && *modelServerMetricsScheme != "https" {
 		return fmt.Errorf("unexpected %q value for %q flag, it can only be set to 'http' or 'https'", *modelServerMetricsScheme, "model-server-metrics-scheme")
 	}
+	if _, err := dlmetrics.ParseFormat(*modelServerMetricsFormat); err != nil {
+		return fmt.Errorf("invalid %q flag - %w", "model-server-metrics-format", err)
+	}
+	if *metricsCollectionWorkers <= 0 {
+		return fmt.Errorf("the %q flag must be positive", "metrics-collection-workers")
+	}
 
 	return nil
 }
@@ -577,7 +983,7 @@ func verifyMetricMapping(mapping backendmetrics.MetricMapping, logger logr.Logge
 
 // setupPprofHandlers only implements the pre-defined profiles:
 // https://cs.opensource.google/go/go/+/refs/tags/go1.24.4:src/runtime/pprof/pprof.go;l=108
-func setupPprofHandlers(mgr ctrl.Manager) error {
+func setupPprofHandlers(mgr metricsHandlerAdder) error {
 	var err error
 	profiles := []string{
 		"heap",
@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/internal/runnable"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/push"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+)
+
+const multiPoolConfigText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: prefix-cache-scorer
+- type: test-handle-probe
+`
+
+// handleProbe is a plugin recording the handle it is instantiated with.
+type handleProbe struct {
+	handle plugins.Handle
+}
+
+func (p *handleProbe) TypedName() plugins.TypedName {
+	return plugins.TypedName{Type: "test-handle-probe", Name: "test-handle-probe"}
+}
+
+func TestDatastorePodLister(t *testing.T) {
+	all := func(backendmetrics.PodMetrics) bool { return true }
+	lister := &datastorePodLister{}
+	if pods := lister.PodList(all); len(pods) != 0 {
+		t.Errorf("Expected no pods before the datastore is set, got %v", pods)
+	}
+
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(t.Context(), pmf, 0)
+	pool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec: v1.InferencePoolSpec{
+			TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+		},
+	}
+	if err := ds.PoolSet(context.Background(), fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(), pool); err != nil {
+		t.Fatalf("Unexpected error setting the pool: %v", err)
+	}
+	ds.PodUpdateOrAddIfNotExist(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}})
+	lister.set(ds)
+
+	if pods := lister.PodList(all); len(pods) != 1 {
+		t.Errorf("Expected the pod of the datastore, got %v", pods)
+	}
+}
+
+func TestParsePluginsConfigurationMultiPool(t *testing.T) {
+	probe := &handleProbe{}
+	plugins.Register("test-handle-probe", func(_ string, _ json.RawMessage, handle plugins.Handle) (plugins.Plugin, error) {
+		probe.handle = handle
+		return probe, nil
+	})
+	*configText = multiPoolConfigText
+	defer func() { *configText = "" }()
+
+	r := NewRunner()
+	if err := r.parsePluginsConfiguration(t.Context(), (&datastorePodLister{}).PodList, true); err != nil {
+		t.Fatalf("Unexpected error parsing the configuration: %v", err)
+	}
+	if r.schedulerConfig == nil {
+		t.Error("Expected the scheduler configuration to be loaded")
+	}
+	if probe.handle == nil {
+		t.Fatal("Expected the plugins to be instantiated")
+	}
+	// each pool instantiates its own plugins, those of the configuration are stopped and list no pods
+	if probe.handle.Context().Err() == nil {
+		t.Error("Expected the context of the configuration plugins to be done")
+	}
+	if pods := probe.handle.PodList(func(backendmetrics.PodMetrics) bool { return true }); len(pods) != 0 {
+		t.Errorf("Expected no pods, got %v", pods)
+	}
+}
+
+func TestRegisterPushServers(t *testing.T) {
+	// reserve a free port for the load reports server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error reserving a port: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+	dataLayerConfig := &datalayer.Config{
+		Sources: []datalayer.SourceConfig{{Source: push.NewDataSource(port, time.Second, nil)}},
+	}
+
+	// authentication requires Kubernetes
+	if err := registerPushServers(&runnable.Group{}, dataLayerConfig, nil); err == nil {
+		t.Fatal("Expected an error authenticating load reports without Kubernetes")
+	}
+
+	*loadReportsAuth = false
+	defer func() { *loadReportsAuth = true }()
+	group := &runnable.Group{}
+	if err := registerPushServers(group, dataLayerConfig, nil); err != nil {
+		t.Fatalf("Unexpected error registering the load reports server: %v", err)
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() { _ = group.Start(ctx) }()
+
+	// the load reports are served over TLS
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}} //nolint:gosec
+	url := fmt.Sprintf("https://127.0.0.1:%d%s", port, push.ReportsPath)
+	var resp *http.Response
+	for range 50 {
+		if resp, err = client.Post(url, "application/json", strings.NewReader(`{"namespace":"default","pod":"pod1"}`)); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error posting a load report: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	resp, err = http.Post(strings.Replace(url, "https", "http", 1), "application/json", strings.NewReader(`{"namespace":"default","pod":"pod1"}`))
+	if err != nil {
+		t.Fatalf("Unexpected error posting a plain HTTP load report: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected plain HTTP load reports to be rejected with status %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+}
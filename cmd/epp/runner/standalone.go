@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// runnableAdder registers runnables, with the controller manager or, in standalone mode, a runnable group.
+type runnableAdder interface {
+	Add(manager.Runnable) error
+}
+
+// metricsHandlerAdder registers extra handlers on the metrics server.
+type metricsHandlerAdder interface {
+	AddMetricsServerExtraHandler(path string, handler http.Handler) error
+}
+
+// isStandalone returns whether the Endpoint Picker runs without Kubernetes, its pool being defined by a file or
+// DNS SRV records.
+func isStandalone() bool {
+	return *standalonePoolFile != "" || *standalonePoolSRV != ""
+}
+
+// metricsMux serves the metrics of a standalone Endpoint Picker, in lieu of the controller manager's metrics
+// server. The metrics endpoint is served without authentication.
+type metricsMux struct {
+	*http.ServeMux
+}
+
+func newMetricsMux() *metricsMux {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{}))
+	return &metricsMux{ServeMux: mux}
+}
+
+func (m *metricsMux) AddMetricsServerExtraHandler(path string, handler http.Handler) error {
+	m.Handle(path, handler)
+	return nil
+}
+
+// server returns the runnable serving the metrics on the given port.
+func (m *metricsMux) server(port int) manager.Runnable {
+	return &manager.Server{
+		Name: "metrics",
+		Server: &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           m,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runnable
+
+import (
+	"context"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Group runs runnables without a controller manager, e.g. when the Endpoint Picker runs outside Kubernetes.
+type Group struct {
+	runnables []manager.Runnable
+}
+
+// Add adds the given runnable to the group. It must be called before Start.
+func (g *Group) Add(runnable manager.Runnable) error {
+	g.runnables = append(g.runnables, runnable)
+	return nil
+}
+
+// Start starts all the runnables and blocks until ctx is done or a runnable fails. The other runnables are then
+// stopped, and the first error is returned once they all returned.
+func (g *Group) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	for _, runnable := range g.runnables {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := runnable.Start(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+	<-ctx.Done()
+	wg.Wait()
+	return firstErr
+}
@@ -34,6 +34,7 @@ import (
 type FakePodMetrics struct {
 	Pod     *backend.Pod
 	Metrics *MetricsState
+	Health  *datalayer.Health
 }
 
 func (fpm *FakePodMetrics) String() string {
@@ -56,6 +57,14 @@ func (*FakePodMetrics) Put(string, datalayer.Cloneable)        {}
 func (*FakePodMetrics) Get(string) (datalayer.Cloneable, bool) { return nil, false }
 func (*FakePodMetrics) Keys() []string                         { return nil }
 
+func (fpm *FakePodMetrics) GetHealth() *datalayer.Health {
+	return fpm.Health
+}
+
+func (fpm *FakePodMetrics) UpdateHealth(update func(current *datalayer.Health) *datalayer.Health) {
+	fpm.Health = update(fpm.Health)
+}
+
 func (fpm *FakePodMetrics) UpdateMetrics(updated *MetricsState) {
 	updated.UpdateTime = time.Now()
 	fpm.Metrics = updated
@@ -20,15 +20,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
 	dto "github.com/prometheus/client_model/go"
-	"github.com/prometheus/common/expfmt"
-	"github.com/prometheus/common/model"
 	"go.uber.org/multierr"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
 )
 
 const (
@@ -38,45 +38,53 @@ const (
 	LoraInfoMaxAdaptersMetricName     = "max_lora"
 
 	CacheConfigBlockSizeInfoMetricName = "block_size"
+	CacheConfigNumGPUBlocksMetricName  = "num_gpu_blocks"
 )
 
 type PodMetricsClientImpl struct {
-	MetricMapping            *MetricMapping
+	MetricMapping *MetricMapping
+	// EngineMappings are used instead of MetricMapping for pods that name their engine
+	// through the engine label or annotation. The key is the engine name.
+	EngineMappings map[string]*MetricMapping
+
 	ModelServerMetricsPath   string
 	ModelServerMetricsScheme string
+	// ModelServerMetricsFormat is the requested exposition format, the text format if empty.
+	ModelServerMetricsFormat dlmetrics.Format
 
 	Client *http.Client
 }
 
 // FetchMetrics fetches metrics from a given pod, clones the existing metrics object and returns an updated one.
 func (p *PodMetricsClientImpl) FetchMetrics(ctx context.Context, pod *backend.Pod, existing *MetricsState) (*MetricsState, error) {
-	url := p.getMetricEndpoint(pod)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
-	}
-	resp, err := p.Client.Do(req)
+	client := p.forPod(pod)
+	metricFamilies, err := dlmetrics.Scrape(ctx, p.Client, p.getMetricEndpoint(pod), pod, dlmetrics.ScrapeOptions{
+		Format:   p.ModelServerMetricsFormat,
+		Families: client.MetricMapping.Families(), // only parse the mapped metrics
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch metrics from %s: %w", pod.NamespacedName, err)
-	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code from %s: %v", pod.NamespacedName, resp.StatusCode)
+		return nil, err
 	}
+	return client.promToPodMetrics(metricFamilies, existing)
+}
 
-	parser := expfmt.NewTextParser(model.LegacyValidation)
-	metricFamilies, err := parser.TextToMetricFamilies(resp.Body)
-	if err != nil {
-		return nil, err
+// forPod returns a client that uses the metric mapping of the pod's engine, if the pod names a known engine.
+func (p *PodMetricsClientImpl) forPod(pod *backend.Pod) *PodMetricsClientImpl {
+	mapping, ok := p.EngineMappings[dlmetrics.EngineOf(pod)]
+	if !ok {
+		return p
 	}
-	return p.promToPodMetrics(metricFamilies, existing)
+	client := *p
+	client.MetricMapping = mapping
+	return &client
 }
 
-func (p *PodMetricsClientImpl) getMetricEndpoint(pod *backend.Pod) string {
-	return p.ModelServerMetricsScheme + "://" + pod.GetMetricsHost() + p.ModelServerMetricsPath
+func (p *PodMetricsClientImpl) getMetricEndpoint(pod *backend.Pod) *url.URL {
+	return &url.URL{
+		Scheme: p.ModelServerMetricsScheme,
+		Host:   pod.GetMetricsHost(),
+		Path:   p.ModelServerMetricsPath,
+	}
 }
 
 // promToPodMetrics updates internal pod metrics with scraped Prometheus metrics.
@@ -147,16 +155,24 @@ func (p *PodMetricsClientImpl) promToPodMetrics(
 		if err != nil {
 			errs = multierr.Append(errs, err)
 		} else {
+			numGPUBlocks := 0
 			for _, v := range cacheMetrics.GetLabel() {
-				if v.GetName() == CacheConfigBlockSizeInfoMetricName {
+				switch v.GetName() {
+				case CacheConfigBlockSizeInfoMetricName:
 					updated.CacheBlockSize, err = strconv.Atoi(v.GetValue())
 					if err != nil {
 						errs = multierr.Append(errs, err)
-					} else {
-						break
+					}
+				case CacheConfigNumGPUBlocksMetricName:
+					if v.GetValue() != "" {
+						numGPUBlocks, err = strconv.Atoi(v.GetValue())
+						if err != nil {
+							errs = multierr.Append(errs, err)
+						}
 					}
 				}
 			}
+			updated.KvCacheMaxTokenCapacity = updated.CacheBlockSize * numGPUBlocks
 		}
 	}
 
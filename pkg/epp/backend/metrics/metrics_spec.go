@@ -19,6 +19,10 @@ package metrics
 import (
 	"fmt"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
 )
 
 // MetricSpec represents a single metric's specification.
@@ -35,6 +39,20 @@ type MetricMapping struct {
 	CacheConfigInfo     *MetricSpec
 }
 
+// Families returns the names of the metric families read by the MetricMapping, nil if there is no mapping.
+func (m *MetricMapping) Families() sets.Set[string] {
+	if m == nil {
+		return nil
+	}
+	families := sets.New[string]()
+	for _, spec := range []*MetricSpec{m.TotalQueuedRequests, m.KVCacheUtilization, m.LoraRequestInfo, m.CacheConfigInfo} {
+		if spec != nil {
+			families.Insert(spec.MetricName)
+		}
+	}
+	return families
+}
+
 // stringToMetricSpec converts a string to a MetricSpec.
 // Example inputs:
 //
@@ -122,3 +140,16 @@ func NewMetricMapping(queuedStr, kvUsageStr, loraReqInfoStr, cacheInfoMetric str
 
 	return mapping, nil
 }
+
+// NewEngineMetricMappings creates a MetricMapping for each of the built-in model server engine profiles.
+func NewEngineMetricMappings() (map[string]*MetricMapping, error) {
+	mappings := make(map[string]*MetricMapping, len(dlmetrics.EngineProfiles))
+	for engine, profile := range dlmetrics.EngineProfiles {
+		mapping, err := NewMetricMapping(profile.TotalQueuedRequests, profile.KVCacheUtilization, profile.LoraRequestInfo, profile.CacheInfo)
+		if err != nil {
+			return nil, fmt.Errorf("error creating the metric mapping of engine %q: %w", engine, err)
+		}
+		mappings[engine] = mapping
+	}
+	return mappings, nil
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
 
@@ -515,3 +516,30 @@ func TestFetchMetrics(t *testing.T) {
 		t.Errorf("FetchMetrics() error = %v, want error containing %q", err, expectedSubstr)
 	}
 }
+
+func TestPromToPodMetricsEngineMapping(t *testing.T) {
+	engineMappings, err := NewEngineMetricMappings()
+	if err != nil {
+		t.Fatalf("NewEngineMetricMappings() unexpected error: %v", err)
+	}
+	p := &PodMetricsClientImpl{
+		MetricMapping:  &MetricMapping{TotalQueuedRequests: &MetricSpec{MetricName: "vllm_waiting"}},
+		EngineMappings: engineMappings,
+	}
+	metricFamilies := map[string]*dto.MetricFamily{
+		"vllm_waiting":          makeMetricFamily("vllm_waiting", makeMetric(nil, 3.0, 1000)),
+		"sglang:num_queue_reqs": makeMetricFamily("sglang:num_queue_reqs", makeMetric(nil, 7.0, 1000)),
+		"sglang:token_usage":    makeMetricFamily("sglang:token_usage", makeMetric(nil, 0.5, 1000)),
+	}
+
+	pod := &backend.Pod{Labels: map[string]string{}}
+	updated, err := p.forPod(pod).promToPodMetrics(metricFamilies, &MetricsState{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, updated.WaitingQueueSize)
+
+	pod = &backend.Pod{Annotations: map[string]string{dlmetrics.EngineKey: dlmetrics.EngineSGLang}}
+	updated, err = p.forPod(pod).promToPodMetrics(metricFamilies, &MetricsState{})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, updated.WaitingQueueSize)
+	assert.Equal(t, 0.5, updated.KVCacheUsagePercent)
+}
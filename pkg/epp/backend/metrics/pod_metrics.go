@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -27,23 +28,31 @@ import (
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
 
 const (
 	fetchMetricsTimeout = 5 * time.Second
+
+	// metricsSourceName is the data source name the collection health and metrics are reported under.
+	metricsSourceName = dlmetrics.DataSourceName
 )
 
 type podMetrics struct {
 	pod      atomic.Pointer[backend.Pod]
 	metrics  atomic.Pointer[MetricsState]
+	health   atomic.Pointer[datalayer.Health]
+	policy   datalayer.HealthPolicy
 	pmc      PodMetricsClient
 	ds       datalayer.PoolInfo
 	interval time.Duration
+	pool     *datalayer.WorkerPool
 
-	startOnce sync.Once // ensures the refresh loop goroutine is started only once
-	stopOnce  sync.Once // ensures the done channel is closed only once
-	done      chan struct{}
+	startOnce  sync.Once // ensures the refresh loop is scheduled only once
+	stopOnce   sync.Once // ensures the refresh loop is cancelled only once
+	unschedule func()    // cancels the refresh loop
 
 	logger logr.Logger
 }
@@ -68,37 +77,33 @@ func (pm *podMetrics) UpdatePod(pod *datalayer.PodInfo) {
 	pm.pod.Store(pod)
 }
 
-// start starts a goroutine exactly once to periodically update metrics. The goroutine will be
-// stopped either when stop() is called, or the given ctx is cancelled.
+// startRefreshLoop schedules the periodic metrics update exactly once on the shared worker pool.
+// The updates stop either when stopRefreshLoop() is called, or the given ctx is cancelled.
 func (pm *podMetrics) startRefreshLoop(ctx context.Context) {
 	pm.startOnce.Do(func() {
-		go func() {
-			pm.logger.V(logutil.DEFAULT).Info("Starting refresher", "pod", pm.GetPod())
-			ticker := time.NewTicker(pm.interval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-pm.done:
-					return
-				case <-ctx.Done():
-					return
-				case <-ticker.C: // refresh metrics periodically
-					if err := pm.refreshMetrics(); err != nil {
-						pm.logger.V(logutil.TRACE).Error(err, "Failed to refresh metrics", "pod", pm.GetPod())
-					}
-				}
+		pm.logger.V(logutil.DEFAULT).Info("Starting refresher", "pod", pm.GetPod())
+		pm.unschedule = pm.pool.Schedule(ctx, pm.interval, func(context.Context) {
+			if err := pm.refreshMetrics(); err != nil {
+				pm.logger.V(logutil.TRACE).Error(err, "Failed to refresh metrics", "pod", pm.GetPod())
 			}
-		}()
+		})
 	})
 }
 
 func (pm *podMetrics) refreshMetrics() error {
+	start := time.Now()
+	if !pm.policy.ShouldCollect(pm.GetHealth(), metricsSourceName, start) {
+		return nil // backing off after failures
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), fetchMetricsTimeout)
 	defer cancel()
 	updated, err := pm.pmc.FetchMetrics(ctx, pm.GetPod(), pm.GetMetrics())
+	metrics.RecordDataLayerCollectionLatency(metricsSourceName, time.Since(start))
 	if err != nil {
 		pm.logger.V(logutil.TRACE).Info("Failed to refreshed metrics:", "err", err)
 	}
+	pm.recordHealth(updated, err, start)
 	// Optimistically update metrics even if there was an error.
 	// The FetchMetrics can return an error for the following reasons:
 	// 1. As refresher is running in the background, it's possible that the pod is deleted but
@@ -114,10 +119,35 @@ func (pm *podMetrics) refreshMetrics() error {
 	return nil
 }
 
+// recordHealth updates the pod health with the result of a refresh. Only refreshes that
+// did not return any metrics count as failures, partial updates show the pod is reporting.
+func (pm *podMetrics) recordHealth(updated *MetricsState, err error, now time.Time) {
+	if updated != nil {
+		err = nil
+	} else if err == nil {
+		err = errors.New("no metrics returned")
+	}
+	if err != nil {
+		metrics.RecordDataLayerCollectionError(metricsSourceName)
+	}
+
+	var previous, health *datalayer.Health
+	pm.UpdateHealth(func(current *datalayer.Health) *datalayer.Health {
+		previous, health = current, pm.policy.Record(current, metricsSourceName, err, now)
+		return health
+	})
+	if previous.IsHealthy() != health.IsHealthy() {
+		pm.logger.V(logutil.DEFAULT).Info("Pod health changed", "pod", pm.GetPod(), "state", health.State,
+			"consecutiveFailures", health.Sources[metricsSourceName].ConsecutiveFailures, "err", err)
+	}
+}
+
 func (pm *podMetrics) stopRefreshLoop() {
 	pm.logger.V(logutil.DEFAULT).Info("Stopping refresher", "pod", pm.GetPod())
 	pm.stopOnce.Do(func() {
-		close(pm.done)
+		if pm.unschedule != nil {
+			pm.unschedule()
+		}
 	})
 }
 
@@ -127,6 +157,19 @@ func (*podMetrics) Put(string, datalayer.Cloneable)        {}
 func (*podMetrics) Get(string) (datalayer.Cloneable, bool) { return nil, false }
 func (*podMetrics) Keys() []string                         { return nil }
 
+func (pm *podMetrics) GetHealth() *datalayer.Health {
+	return pm.health.Load()
+}
+
+func (pm *podMetrics) UpdateHealth(update func(current *datalayer.Health) *datalayer.Health) {
+	for {
+		current := pm.health.Load()
+		if pm.health.CompareAndSwap(current, update(current)) {
+			return
+		}
+	}
+}
+
 func (pm *podMetrics) UpdateMetrics(updated *MetricsState) {
 	updated.UpdateTime = time.Now()
 	pm.logger.V(logutil.TRACE).Info("Refreshed metrics", "updated", updated)
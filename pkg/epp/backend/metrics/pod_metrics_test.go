@@ -94,3 +94,21 @@ func (f *fakeDataStore) PodList(func(PodMetrics) bool) []PodMetrics {
 	// Not implemented.
 	return nil
 }
+
+func TestMetricsRefreshTracksHealth(t *testing.T) {
+	ctx := context.Background()
+	pmc := &FakePodMetricsClient{}
+	pmf := NewPodMetricsFactory(pmc, time.Millisecond)
+
+	// No metrics are returned for the pod, so every refresh fails.
+	pm := pmf.NewEndpoint(ctx, pod1Info, &fakeDataStore{})
+	defer pmf.ReleaseEndpoint(pm)
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, datalayer.Unhealthy, pm.GetHealth().GetState())
+	}, 2*time.Second, time.Millisecond)
+
+	pmc.SetRes(map[types.NamespacedName]*MetricsState{pod1Info.NamespacedName: initial})
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, datalayer.Healthy, pm.GetHealth().GetState())
+	}, 5*time.Second, time.Millisecond) // allow for the backoff after failures
+}
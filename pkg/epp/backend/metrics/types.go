@@ -29,6 +29,8 @@ import (
 
 var (
 	AllPodsPredicate = func(PodMetrics) bool { return true }
+	// ServingPodsPredicate selects the pods accepting new requests, i.e. the pods that are not draining.
+	ServingPodsPredicate = func(pm PodMetrics) bool { return !pm.GetPod().Draining }
 )
 
 func PodsWithFreshMetrics(stalenessThreshold time.Duration) func(PodMetrics) bool {
@@ -44,12 +46,20 @@ func NewPodMetricsFactory(pmc PodMetricsClient, refreshMetricsInterval time.Dura
 	return &PodMetricsFactory{
 		pmc:                    pmc,
 		refreshMetricsInterval: refreshMetricsInterval,
+		pool:                   datalayer.NewWorkerPool(datalayer.DefaultWorkerPoolSize),
 	}
 }
 
 type PodMetricsFactory struct {
 	pmc                    PodMetricsClient
 	refreshMetricsInterval time.Duration
+	pool                   *datalayer.WorkerPool // runs the metrics refresh of all pods
+}
+
+// WithWorkerPool sets the worker pool running the metrics refresh of the pods.
+func (f *PodMetricsFactory) WithWorkerPool(pool *datalayer.WorkerPool) *PodMetricsFactory {
+	f.pool = pool
+	return f
 }
 
 func (f *PodMetricsFactory) NewEndpoint(parentCtx context.Context, pod *datalayer.PodInfo, ds datalayer.PoolInfo) PodMetrics {
@@ -57,9 +67,10 @@ func (f *PodMetricsFactory) NewEndpoint(parentCtx context.Context, pod *datalaye
 		pmc:       f.pmc,
 		ds:        ds,
 		interval:  f.refreshMetricsInterval,
+		pool:      f.pool,
+		policy:    datalayer.DefaultHealthPolicy(),
 		startOnce: sync.Once{},
 		stopOnce:  sync.Once{},
-		done:      make(chan struct{}),
 		logger:    log.FromContext(parentCtx).WithValues("pod", pod.NamespacedName),
 	}
 	pm.pod.Store(pod)
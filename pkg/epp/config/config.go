@@ -16,9 +16,18 @@ limitations under the License.
 
 package config
 
-import "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+import (
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+)
 
 // Config is the configuration loaded from the text based configuration
 type Config struct {
 	SchedulerConfig *scheduling.SchedulerConfig
+	// DataLayerConfig is nil when the configuration has no data layer section.
+	DataLayerConfig *datalayer.Config
+	// FlowControlConfig is validated, with defaults applied. It is nil when the configuration has no flow control
+	// section.
+	FlowControlConfig *flowcontrol.Config
 }
@@ -19,8 +19,13 @@ package loader
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -28,10 +33,24 @@ import (
 
 	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
+	inter "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/interflow/dispatch"
+	intra "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/intraflow/dispatch"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/queue"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/registry"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/profile"
+
+	// Register the built-in flow control policies and queues, so that the configuration can select them.
+	_ "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/interflow/dispatch/drr"
+	_ "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/interflow/dispatch/roundrobin"
+	_ "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/interflow/dispatch/wfq"
+	_ "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/intraflow/dispatch/edf"
+	_ "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/intraflow/dispatch/sjf"
+	_ "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/queue/maxminheap"
 )
 
 var scheme = runtime.NewScheme()
@@ -40,12 +59,49 @@ func init() {
 	utilruntime.Must(configapi.Install(scheme))
 }
 
+// LoadOption customizes the loading of the configuration.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	dataLayerEnabled   bool
+	flowControlEnabled bool
+}
+
+// WithDataLayer sets whether the pluggable data layer is enabled. When it is not, a configuration with a dataLayer
+// section is rejected rather than having the section ignored. The pluggable data layer is enabled by default.
+func WithDataLayer(enabled bool) LoadOption {
+	return func(o *loadOptions) {
+		o.dataLayerEnabled = enabled
+	}
+}
+
+// WithFlowControl sets whether the flow control layer is enabled. When it is not, a configuration with a flowControl
+// section is rejected rather than having the section ignored. The flow control layer is enabled by default.
+func WithFlowControl(enabled bool) LoadOption {
+	return func(o *loadOptions) {
+		o.flowControlEnabled = enabled
+	}
+}
+
 // Load config from supplied text that was converted to []byte
-func LoadConfig(configBytes []byte, handle plugins.Handle, logger logr.Logger) (*config.Config, error) {
+func LoadConfig(configBytes []byte, handle plugins.Handle, logger logr.Logger, opts ...LoadOption) (*config.Config, error) {
+	options := loadOptions{dataLayerEnabled: true, flowControlEnabled: true}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	rawConfig, err := loadRawConfig(configBytes)
 	if err != nil {
 		return nil, err
 	}
+	if rawConfig.DataLayer != nil && !options.dataLayerEnabled {
+		return nil, errors.New("the dataLayer section requires the pluggable data layer, which is disabled " +
+			"(set ENABLE_EXPERIMENTAL_DATALAYER_V2=true to enable it)")
+	}
+	if rawConfig.FlowControl != nil && !options.flowControlEnabled {
+		return nil, errors.New("the flowControl section requires the flow control layer, which is disabled " +
+			"(set ENABLE_EXPERIMENTAL_FLOW_CONTROL_LAYER=true to enable it)")
+	}
 
 	logger.Info("Loaded configuration", "config", rawConfig)
 
@@ -71,6 +127,23 @@ func LoadConfig(configBytes []byte, handle plugins.Handle, logger logr.Logger) (
 		return nil, err
 	}
 
+	if rawConfig.DataLayer != nil {
+		config.DataLayerConfig, err = loadDataLayerConfig(rawConfig.DataLayer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load data layer config - %w", err)
+		}
+		if err = validateConsumedData(config.DataLayerConfig, handle); err != nil {
+			return nil, fmt.Errorf("failed to validate data layer config - %w", err)
+		}
+	}
+
+	if rawConfig.FlowControl != nil {
+		config.FlowControlConfig, err = loadFlowControlConfig(rawConfig.FlowControl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load flow control config - %w", err)
+		}
+	}
+
 	return config, nil
 }
 
@@ -91,7 +164,13 @@ func loadSchedulerConfig(configProfiles []configapi.SchedulingProfile, handle pl
 		profile := framework.NewSchedulerProfile()
 		for _, plugin := range namedProfile.Plugins {
 			referencedPlugin := handle.Plugin(plugin.PluginRef)
-			if scorer, ok := referencedPlugin.(framework.Scorer); ok {
+			if plugin.Fallback != nil {
+				softFilter, err := loadSoftFilter(plugin.PluginRef, referencedPlugin, plugin.Fallback, handle)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load scheduler config - %w", err)
+				}
+				referencedPlugin = softFilter
+			} else if scorer, ok := referencedPlugin.(framework.Scorer); ok {
 				referencedPlugin = framework.NewWeightedScorer(scorer, *plugin.Weight)
 			}
 			if err := profile.AddPlugins(referencedPlugin); err != nil {
@@ -118,9 +197,45 @@ func loadSchedulerConfig(configProfiles []configapi.SchedulingProfile, handle pl
 		return nil, errors.New("single profile handler is intended to be used with a single profile, but multiple profiles were specified")
 	}
 
+	if fallbackProfileHandler, ok := profileHandler.(*profile.FallbackProfileHandler); ok {
+		for _, profileName := range fallbackProfileHandler.Profiles() {
+			if _, ok := profiles[profileName]; !ok {
+				return nil, fmt.Errorf("fallback profile handler references an undefined SchedulingProfile '%s'", profileName)
+			}
+		}
+	}
+
 	return scheduling.NewSchedulerConfig(profileHandler, profiles), nil
 }
 
+func loadSoftFilter(pluginRef string, plugin plugins.Plugin, fallback *configapi.FilterFallback, handle plugins.Handle) (*framework.SoftFilter, error) {
+	filter, ok := plugin.(framework.Filter)
+	if !ok {
+		return nil, fmt.Errorf("fallback was specified for '%s', which is not a filter", pluginRef)
+	}
+	if _, ok := plugin.(framework.Scorer); ok {
+		return nil, fmt.Errorf("fallback was specified for '%s', which is also a scorer", pluginRef)
+	}
+
+	policy := framework.FallbackPolicy(fallback.Policy)
+	if policy == framework.FallbackRelax {
+		if _, ok := filter.(framework.RelaxableFilter); !ok {
+			return nil, fmt.Errorf("fallback policy '%s' was specified for '%s', which doesn't support relaxed filtering", policy, pluginRef)
+		}
+	}
+
+	secondary := make([]framework.Filter, len(fallback.PluginRefs))
+	for i, secondaryRef := range fallback.PluginRefs {
+		secondaryFilter, ok := handle.Plugin(secondaryRef).(framework.Filter)
+		if !ok {
+			return nil, fmt.Errorf("fallback of '%s' references '%s', which is not a filter", pluginRef, secondaryRef)
+		}
+		secondary[i] = secondaryFilter
+	}
+
+	return framework.NewSoftFilter(filter, policy, secondary...), nil
+}
+
 func instantiatePlugins(configuredPlugins []configapi.PluginSpec, handle plugins.Handle) error {
 	pluginNames := sets.New[string]() // set of plugin names, a name must be unique
 
@@ -177,6 +292,214 @@ func validateSchedulingProfiles(config *configapi.EndpointPickerConfig) error {
 			if notFound {
 				return errors.New(plugin.PluginRef + " is a reference to an undefined Plugin")
 			}
+
+			if plugin.Fallback != nil {
+				if err := validateFilterFallback(plugin.PluginRef, plugin.Fallback, config.Plugins); err != nil {
+					return fmt.Errorf("SchedulingProfile '%s' - %w", profile.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateFilterFallback(pluginRef string, fallback *configapi.FilterFallback, configuredPlugins []configapi.PluginSpec) error {
+	switch framework.FallbackPolicy(fallback.Policy) {
+	case framework.FallbackSkip, framework.FallbackRelax:
+		if len(fallback.PluginRefs) > 0 {
+			return fmt.Errorf("fallback of '%s' with policy '%s' must not have plugin references", pluginRef, fallback.Policy)
+		}
+	case framework.FallbackSecondary:
+		if len(fallback.PluginRefs) == 0 {
+			return fmt.Errorf("fallback of '%s' with policy '%s' must have at least one plugin reference", pluginRef, fallback.Policy)
+		}
+	default:
+		return fmt.Errorf("fallback of '%s' has an unknown policy '%s'", pluginRef, fallback.Policy)
+	}
+
+	for _, fallbackRef := range fallback.PluginRefs {
+		if fallbackRef == pluginRef {
+			return fmt.Errorf("fallback of '%s' must not reference the plugin itself", pluginRef)
+		}
+		notFound := true
+		for _, pluginConfig := range configuredPlugins {
+			if fallbackRef == pluginConfig.Name {
+				notFound = false
+				break
+			}
+		}
+		if notFound {
+			return errors.New(fallbackRef + " is a reference to an undefined Plugin")
+		}
+	}
+	return nil
+}
+
+func loadDataLayerConfig(configDataLayer *configapi.DataLayerConfig) (*datalayer.Config, error) {
+	sourceNames := sets.New[string]() // set of data source names, a name must be unique
+	config := &datalayer.Config{}
+
+	for _, sourceConfig := range configDataLayer.Sources {
+		if sourceConfig.Type == "" {
+			return nil, fmt.Errorf("data source definition for '%s' is missing a type", sourceConfig.Name)
+		}
+		if sourceNames.Has(sourceConfig.Name) {
+			return nil, fmt.Errorf("data source name '%s' used more than once", sourceConfig.Name)
+		}
+		sourceNames.Insert(sourceConfig.Name)
+
+		factory, ok := datalayer.SourceFactories[sourceConfig.Type]
+		if !ok {
+			return nil, fmt.Errorf("data source type '%s' is not found in registry", sourceConfig.Type)
+		}
+		source, err := factory(sourceConfig.Name, sourceConfig.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to instantiate the data source type '%s' - %w", sourceConfig.Type, err)
+		}
+
+		var refreshInterval time.Duration
+		if sourceConfig.RefreshInterval != nil {
+			refreshInterval = sourceConfig.RefreshInterval.Duration
+			if refreshInterval <= 0 {
+				return nil, fmt.Errorf("data source '%s' must have a positive refresh interval", sourceConfig.Name)
+			}
+		}
+
+		extractors := make([]datalayer.Extractor, 0, len(sourceConfig.Extractors))
+		for _, extractorConfig := range sourceConfig.Extractors {
+			if extractorConfig.Type == "" {
+				return nil, fmt.Errorf("extractor definition for '%s' of data source '%s' is missing a type", extractorConfig.Name, sourceConfig.Name)
+			}
+			extractorFactory, ok := datalayer.ExtractorFactories[extractorConfig.Type]
+			if !ok {
+				return nil, fmt.Errorf("extractor type '%s' is not found in registry", extractorConfig.Type)
+			}
+			extractor, err := extractorFactory(extractorConfig.Name, extractorConfig.Parameters)
+			if err != nil {
+				return nil, fmt.Errorf("failed to instantiate the extractor type '%s' - %w", extractorConfig.Type, err)
+			}
+			if err := source.AddExtractor(extractor); err != nil {
+				return nil, fmt.Errorf("failed to add extractor '%s' to data source '%s' - %w", extractorConfig.Name, sourceConfig.Name, err)
+			}
+			extractors = append(extractors, extractor)
+		}
+
+		config.Sources = append(config.Sources, datalayer.SourceConfig{Source: source, Extractors: extractors, RefreshInterval: refreshInterval})
+	}
+
+	return config, nil
+}
+
+// loadFlowControlConfig converts the flow control section of the configuration, then validates it and applies the
+// defaults of the flow controller and registry.
+func loadFlowControlConfig(configFlowControl *configapi.FlowControlConfig) (*flowcontrol.Config, error) {
+	config := &flowcontrol.Config{}
+	var err error
+	if config.Registry.MaxBytes, err = quantityToBytes("maxBytes", configFlowControl.MaxBytes); err != nil {
+		return nil, err
+	}
+	if configFlowControl.ShardCount != nil {
+		if *configFlowControl.ShardCount <= 0 {
+			return nil, fmt.Errorf("shardCount must be positive, got %d", *configFlowControl.ShardCount)
+		}
+		config.Registry.InitialShardCount = *configFlowControl.ShardCount
+	}
+	if configFlowControl.FlowGCTimeout != nil {
+		if configFlowControl.FlowGCTimeout.Duration <= 0 {
+			return nil, fmt.Errorf("flowGCTimeout must be positive, got %s", configFlowControl.FlowGCTimeout.Duration)
+		}
+		config.Registry.FlowGCTimeout = configFlowControl.FlowGCTimeout.Duration
+	}
+	if configFlowControl.DefaultRequestTTL != nil {
+		config.Controller.DefaultRequestTTL = configFlowControl.DefaultRequestTTL.Duration
+	}
+	config.FairnessWeights = configFlowControl.FairnessWeights
+
+	for _, bandConfig := range configFlowControl.PriorityBands {
+		band, err := loadPriorityBand(fmt.Sprintf("priority band '%s'", bandConfig.Name), bandConfig.Queue,
+			bandConfig.IntraFlowPolicy, bandConfig.InterFlowPolicy, bandConfig.MaxBytes)
+		if err != nil {
+			return nil, err
+		}
+		band.Priority = bandConfig.Priority
+		band.PriorityName = bandConfig.Name
+		config.Registry.PriorityBands = append(config.Registry.PriorityBands, band)
+	}
+	if templateConfig := configFlowControl.PriorityBandTemplate; templateConfig != nil {
+		template, err := loadPriorityBand("priority band template", templateConfig.Queue, templateConfig.IntraFlowPolicy,
+			templateConfig.InterFlowPolicy, templateConfig.MaxBytes)
+		if err != nil {
+			return nil, err
+		}
+		config.Registry.PriorityBandTemplate = &template
+	}
+
+	return config.ValidateAndApplyDefaults()
+}
+
+// loadPriorityBand converts the queue, policies and byte limit of a priority band or of the priority band template.
+func loadPriorityBand(band string, queueName string, intraFlowPolicy string, interFlowPolicy string,
+	maxBytes *resource.Quantity) (registry.PriorityBandConfig, error) {
+	config := registry.PriorityBandConfig{
+		IntraFlowDispatchPolicy: intra.RegisteredPolicyName(intraFlowPolicy),
+		InterFlowDispatchPolicy: inter.RegisteredPolicyName(interFlowPolicy),
+		Queue:                   queue.RegisteredQueueName(queueName),
+	}
+	// The registry validates the intra-flow policy and queue of each band, but resolves the inter-flow policy only
+	// when creating its shards.
+	if config.InterFlowDispatchPolicy != "" {
+		if _, err := inter.NewPolicyFromName(config.InterFlowDispatchPolicy); err != nil {
+			return config, fmt.Errorf("invalid interFlowPolicy of %s - %w", band, err)
+		}
+	}
+	var err error
+	config.MaxBytes, err = quantityToBytes("maxBytes of "+band, maxBytes)
+	return config, err
+}
+
+// quantityToBytes converts an optional byte size to a number of bytes, 0 standing for an omitted size.
+func quantityToBytes(field string, quantity *resource.Quantity) (uint64, error) {
+	if quantity == nil {
+		return 0, nil
+	}
+	bytes, ok := quantity.AsInt64()
+	if !ok || bytes <= 0 {
+		return 0, fmt.Errorf("%s must be a positive number of bytes, got %s", field, quantity)
+	}
+	return uint64(bytes), nil
+}
+
+// validateConsumedData checks that every piece of data consumed by a plugin is produced by a configured extractor
+// with the same type.
+func validateConsumedData(dataLayerConfig *datalayer.Config, handle plugins.Handle) error {
+	produced := map[string]any{}
+	for _, sourceConfig := range dataLayerConfig.Sources {
+		// sources that update endpoints directly (e.g., push ingestion) declare the data they produce as well.
+		if producer, ok := sourceConfig.Source.(interface{ Produces() map[string]any }); ok {
+			maps.Copy(produced, producer.Produces())
+		}
+		for _, extractor := range sourceConfig.Extractors {
+			if producer, ok := extractor.(interface{ Produces() map[string]any }); ok {
+				maps.Copy(produced, producer.Produces())
+			}
+		}
+	}
+
+	allPlugins := handle.GetAllPluginsWithNames()
+	for _, pluginName := range slices.Sorted(maps.Keys(allPlugins)) {
+		consumer, ok := allPlugins[pluginName].(plugins.ConsumerPlugin)
+		if !ok {
+			continue
+		}
+		consumed := consumer.Consumes()
+		for _, key := range slices.Sorted(maps.Keys(consumed)) {
+			producedValue, ok := produced[key]
+			if !ok {
+				return fmt.Errorf("plugin '%s' consumes '%s', which is not produced by any configured data source or extractor", pluginName, key)
+			}
+			if reflect.TypeOf(producedValue) != reflect.TypeOf(consumed[key]) {
+				return fmt.Errorf("plugin '%s' consumes '%s' of type %T, but it is produced as type %T", pluginName, key, consumed[key], producedValue)
+			}
 		}
 	}
 	return nil
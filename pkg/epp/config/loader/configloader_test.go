@@ -20,7 +20,9 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,11 +30,16 @@ import (
 
 	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/push"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/filter"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/multi/prefix"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/picker"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/profile"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/scorer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/test/utils"
@@ -425,9 +432,130 @@ func TestLoadConfig(t *testing.T) {
 			configText: errorMultiProfilesUseSingleProfileHandlerText,
 			wantErr:    true,
 		},
+		{
+			name:       "successWithFallbackProfileHandler",
+			configText: successWithFallbackProfileHandlerText,
+			wantErr:    false,
+		},
+		{
+			name:       "errorFallbackProfileHandlerUndefinedProfile",
+			configText: errorFallbackProfileHandlerUndefinedProfileText,
+			wantErr:    true,
+		},
+		{
+			name:       "successWithSoftFilters",
+			configText: successWithSoftFiltersText,
+			wantErr:    false,
+		},
+		{
+			name:       "errorFallbackOnScorer",
+			configText: errorFallbackOnScorerText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorUnknownFallbackPolicy",
+			configText: errorUnknownFallbackPolicyText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorRelaxFallbackNotSupported",
+			configText: errorRelaxFallbackNotSupportedText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorSecondaryFallbackNoPluginRefs",
+			configText: errorSecondaryFallbackNoPluginRefsText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorSecondaryFallbackBadPluginRef",
+			configText: errorSecondaryFallbackBadPluginRefText,
+			wantErr:    true,
+		},
+		{
+			name:       "successWithDataLayer",
+			configText: successWithDataLayerText,
+			wantErr:    false,
+		},
+		{
+			name:       "errorDataLayerDataNotProduced",
+			configText: errorDataLayerDataNotProducedText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorDataLayerUnknownSourceType",
+			configText: errorDataLayerUnknownSourceTypeText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorDataLayerDuplicateSource",
+			configText: errorDataLayerDuplicateSourceText,
+			wantErr:    true,
+		},
+		{
+			name:       "successWithPushDataSource",
+			configText: successWithPushDataSourceText,
+			wantErr:    false,
+		},
+		{
+			name:       "errorPushDataSourceExtractorWithoutFallback",
+			configText: errorPushDataSourceExtractorWithoutFallbackText,
+			wantErr:    true,
+		},
+		{
+			name:       "successWithFlowControl",
+			configText: successWithFlowControlText,
+			wantErr:    false,
+		},
+		{
+			name:       "errorFlowControlNoPriorityBands",
+			configText: errorFlowControlNoPriorityBandsText,
+			wantErr:    true,
+		},
+		{
+			name:       "successWithFlowControlTemplateOnly",
+			configText: successWithFlowControlTemplateOnlyText,
+			wantErr:    false,
+		},
+		{
+			name:       "errorFlowControlTemplateUnknownPolicy",
+			configText: errorFlowControlTemplateUnknownPolicyText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorFlowControlDuplicatePriority",
+			configText: errorFlowControlDuplicatePriorityText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorFlowControlUnknownPolicy",
+			configText: errorFlowControlUnknownPolicyText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorFlowControlNegativeMaxBytes",
+			configText: errorFlowControlNegativeMaxBytesText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorFlowControlZeroShardCount",
+			configText: errorFlowControlZeroShardCountText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorFlowControlZeroFairnessWeight",
+			configText: errorFlowControlZeroFairnessWeightText,
+			wantErr:    true,
+		},
+		{
+			name:       "errorFlowControlIncompatibleQueue",
+			configText: errorFlowControlIncompatibleQueueText,
+			wantErr:    true,
+		},
 	}
 
 	registerNeededPlgugins()
+	registerTestPlugins()
 
 	logger := logging.NewTestLogger()
 	for _, test := range tests {
@@ -444,12 +572,149 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestLoadConfigWithDataLayerDisabled(t *testing.T) {
+	registerNeededPlgugins()
+	registerTestPlugins()
+
+	tests := []struct {
+		name             string
+		configText       string
+		dataLayerEnabled bool
+		wantErr          bool
+	}{
+		{
+			name:             "data layer section with the data layer enabled",
+			configText:       successWithDataLayerText,
+			dataLayerEnabled: true,
+		},
+		{
+			name:       "data layer section with the data layer disabled",
+			configText: successWithDataLayerText,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid data layer section with the data layer disabled",
+			configText: errorDataLayerDataNotProducedText,
+			wantErr:    true,
+		},
+		{
+			name:       "no data layer section with the data layer disabled",
+			configText: successConfigText,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handle := utils.NewTestHandle(context.Background())
+			_, err := LoadConfig([]byte(test.configText), handle, logging.NewTestLogger(), WithDataLayer(test.dataLayerEnabled))
+			if test.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "requires the pluggable data layer") {
+					t.Fatalf("LoadConfig should have rejected the data layer section, got error %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("LoadConfig returned an unexpected error. error %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadConfigWithFlowControlDisabled(t *testing.T) {
+	registerNeededPlgugins()
+	registerTestPlugins()
+
+	handle := utils.NewTestHandle(context.Background())
+	_, err := LoadConfig([]byte(successWithFlowControlText), handle, logging.NewTestLogger(), WithFlowControl(false))
+	if err == nil || !strings.Contains(err.Error(), "requires the flow control layer") {
+		t.Fatalf("LoadConfig should have rejected the flow control section, got error %v", err)
+	}
+
+	handle = utils.NewTestHandle(context.Background())
+	if _, err := LoadConfig([]byte(successConfigText), handle, logging.NewTestLogger(), WithFlowControl(false)); err != nil {
+		t.Fatalf("LoadConfig returned an unexpected error. error %v", err)
+	}
+}
+
+func TestLoadFlowControlConfig(t *testing.T) {
+	registerNeededPlgugins()
+
+	handle := utils.NewTestHandle(context.Background())
+	got, err := LoadConfig([]byte(successWithFlowControlText), handle, logging.NewTestLogger())
+	if err != nil {
+		t.Fatalf("LoadConfig returned an unexpected error. error %v", err)
+	}
+	if got.FlowControlConfig == nil {
+		t.Fatal("LoadConfig did not return a flow control config")
+	}
+
+	registryConfig := got.FlowControlConfig.Registry
+	if registryConfig.MaxBytes != 2_000_000_000 {
+		t.Errorf("unexpected maxBytes: got %d, want %d", registryConfig.MaxBytes, 2_000_000_000)
+	}
+	if registryConfig.InitialShardCount != 2 {
+		t.Errorf("unexpected shardCount: got %d, want %d", registryConfig.InitialShardCount, 2)
+	}
+	if registryConfig.FlowGCTimeout != 10*time.Minute {
+		t.Errorf("unexpected flowGCTimeout: got %s, want %s", registryConfig.FlowGCTimeout, 10*time.Minute)
+	}
+	if got.FlowControlConfig.Controller.DefaultRequestTTL != 30*time.Second {
+		t.Errorf("unexpected defaultRequestTTL: got %s, want %s", got.FlowControlConfig.Controller.DefaultRequestTTL, 30*time.Second)
+	}
+
+	type band struct {
+		Priority        int
+		Name            string
+		Queue           string
+		IntraFlowPolicy string
+		InterFlowPolicy string
+		MaxBytes        uint64
+	}
+	want := []band{
+		{Priority: 100, Name: "Critical", Queue: "MaxMinHeap", IntraFlowPolicy: "FCFS", InterFlowPolicy: "RoundRobin", MaxBytes: 500_000_000},
+		// The omitted fields of a band are defaulted by the registry.
+		{Priority: 0, Name: "Standard", Queue: "ListQueue", IntraFlowPolicy: "FCFS", InterFlowPolicy: "BestHead", MaxBytes: 1_000_000_000},
+	}
+	bands := make([]band, 0, len(registryConfig.PriorityBands))
+	for _, b := range registryConfig.PriorityBands {
+		bands = append(bands, band{
+			Priority:        b.Priority,
+			Name:            b.PriorityName,
+			Queue:           string(b.Queue),
+			IntraFlowPolicy: string(b.IntraFlowDispatchPolicy),
+			InterFlowPolicy: string(b.InterFlowDispatchPolicy),
+			MaxBytes:        b.MaxBytes,
+		})
+	}
+	if diff := cmp.Diff(want, bands); diff != "" {
+		t.Errorf("unexpected priority bands (-want +got): %s", diff)
+	}
+
+	template := registryConfig.PriorityBandTemplate
+	if template == nil {
+		t.Fatal("LoadConfig did not return a priority band template")
+	}
+	if template.Queue != "MaxMinHeap" || template.IntraFlowDispatchPolicy != "FCFS" ||
+		template.InterFlowDispatchPolicy != "WeightedFairQueuing" || template.MaxBytes != 100_000_000 {
+		t.Errorf("unexpected priority band template: %+v", *template)
+	}
+
+	wantWeights := map[string]int{"tenant-a": 3, "tenant-b": 1}
+	if diff := cmp.Diff(wantWeights, got.FlowControlConfig.FairnessWeights); diff != "" {
+		t.Errorf("unexpected fairness weights (-want +got): %s", diff)
+	}
+}
+
 func registerNeededPlgugins() {
 	plugins.Register(prefix.PrefixCachePluginType, prefix.PrefixCachePluginFactory)
 	plugins.Register(picker.MaxScorePickerType, picker.MaxScorePickerFactory)
 	plugins.Register(picker.RandomPickerType, picker.RandomPickerFactory)
 	plugins.Register(picker.WeightedRandomPickerType, picker.WeightedRandomPickerFactory)
 	plugins.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
+	plugins.Register(profile.FallbackProfileHandlerType, profile.FallbackProfileHandlerFactory)
+	plugins.Register(scorer.QueueScorerType, scorer.QueueScorerFactory)
+	plugins.Register(filter.KvCacheHeadroomFilterType, filter.KvCacheHeadroomFilterFactory)
+	datalayer.RegisterSourceFactory(dlmetrics.DataSourceType, dlmetrics.DataSourceFactory)
+	datalayer.RegisterExtractorFactory(dlmetrics.ExtractorType, dlmetrics.ExtractorFactory)
+	datalayer.RegisterSourceFactory(push.DataSourceType, push.DataSourceFactory)
 }
 
 // The following multi-line string constants, cause false positive lint errors (dupword)
@@ -915,3 +1180,527 @@ schedulingProfiles:
   plugins:
   - pluginRef: maxScore
 `
+
+// valid configuration with soft filters
+//
+//nolint:dupword
+const successWithSoftFiltersText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: single-profile-handler
+- name: strictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+- name: otherStrictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+- name: secondaryFilter
+  type: test-one
+  parameters:
+    threshold: 10
+- name: headroomFilter
+  type: kv-cache-headroom-filter
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: strictFilter
+    fallback:
+      policy: Skip
+  - pluginRef: otherStrictFilter
+    fallback:
+      policy: Secondary
+      pluginRefs:
+      - secondaryFilter
+  - pluginRef: headroomFilter
+    fallback:
+      policy: Relax
+  - pluginRef: maxScore
+`
+
+// fallback specified for a scorer
+//
+//nolint:dupword
+const errorFallbackOnScorerText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: single-profile-handler
+- name: prefixCacheScorer
+  type: prefix-cache-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: prefixCacheScorer
+    fallback:
+      policy: Skip
+`
+
+// unknown fallback policy
+//
+//nolint:dupword
+const errorUnknownFallbackPolicyText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: single-profile-handler
+- name: strictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: strictFilter
+    fallback:
+      policy: Ignore
+`
+
+// relax fallback specified for a filter that doesn't support relaxed filtering
+//
+//nolint:dupword
+const errorRelaxFallbackNotSupportedText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: single-profile-handler
+- name: strictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: strictFilter
+    fallback:
+      policy: Relax
+`
+
+// secondary fallback without secondary filters
+//
+//nolint:dupword
+const errorSecondaryFallbackNoPluginRefsText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: single-profile-handler
+- name: strictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: strictFilter
+    fallback:
+      policy: Secondary
+`
+
+// secondary fallback referencing a plugin that isn't a filter
+//
+//nolint:dupword
+const errorSecondaryFallbackBadPluginRefText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: single-profile-handler
+- name: strictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: strictFilter
+    fallback:
+      policy: Secondary
+      pluginRefs:
+      - maxScore
+`
+
+// valid configuration with the fallback profile handler
+//
+//nolint:dupword
+const successWithFallbackProfileHandlerText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: fallback-profile-handler
+  parameters:
+    profiles:
+    - strict
+    - any-pod
+- name: strictFilter
+  type: test-one
+  parameters:
+    threshold: 10
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: strict
+  plugins:
+  - pluginRef: strictFilter
+  - pluginRef: maxScore
+- name: any-pod
+  plugins:
+  - pluginRef: maxScore
+`
+
+// fallback profile handler referencing an undefined profile
+//
+//nolint:dupword
+const errorFallbackProfileHandlerUndefinedProfileText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: profileHandler
+  type: fallback-profile-handler
+  parameters:
+    profiles:
+    - strict
+    - any-pod
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: strict
+  plugins:
+  - pluginRef: maxScore
+`
+
+// valid configuration with a data layer section
+//
+//nolint:dupword
+const successWithDataLayerText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+dataLayer:
+  sources:
+  - type: metrics-data-source
+    refreshInterval: 100ms
+    parameters:
+      path: /metrics
+    extractors:
+    - type: model-server-protocol-metrics
+      parameters:
+        totalQueuedRequestsMetric: "sglang:num_queue_reqs"
+`
+
+// data layer section without an extractor producing the data consumed by the queue scorer
+//
+//nolint:dupword
+const errorDataLayerDataNotProducedText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+dataLayer:
+  sources:
+  - type: metrics-data-source
+`
+
+// data layer section with an unknown data source type
+//
+//nolint:dupword
+const errorDataLayerUnknownSourceTypeText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+dataLayer:
+  sources:
+  - type: unknown-data-source
+    extractors:
+    - type: model-server-protocol-metrics
+`
+
+// data layer section with two data sources with the same name
+//
+//nolint:dupword
+const errorDataLayerDuplicateSourceText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+dataLayer:
+  sources:
+  - type: metrics-data-source
+    extractors:
+    - type: model-server-protocol-metrics
+  - type: metrics-data-source
+`
+
+// push data source with a scraping fallback, producing the data consumed by the queue scorer
+//
+//nolint:dupword
+const successWithPushDataSourceText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+dataLayer:
+  sources:
+  - type: push-data-source
+    parameters:
+      port: 9010
+      stalenessThreshold: 1s
+      fallback:
+        type: metrics-data-source
+    extractors:
+    - type: model-server-protocol-metrics
+`
+
+// push data source with an extractor but without a fallback data source
+//
+//nolint:dupword
+const errorPushDataSourceExtractorWithoutFallbackText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+dataLayer:
+  sources:
+  - type: push-data-source
+    extractors:
+    - type: model-server-protocol-metrics
+`
+
+// flow control section with two priority bands, one of them using the defaults
+//
+//nolint:dupword
+const successWithFlowControlText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  maxBytes: 2G
+  shardCount: 2
+  flowGCTimeout: 10m
+  defaultRequestTTL: 30s
+  priorityBands:
+  - priority: 100
+    name: Critical
+    queue: MaxMinHeap
+    intraFlowPolicy: FCFS
+    interFlowPolicy: RoundRobin
+    maxBytes: 500M
+  - priority: 0
+    name: Standard
+  priorityBandTemplate:
+    queue: MaxMinHeap
+    interFlowPolicy: WeightedFairQueuing
+    maxBytes: 100M
+  fairnessWeights:
+    tenant-a: 3
+    tenant-b: 1
+`
+
+// flow control section without priority bands
+//
+//nolint:dupword
+const errorFlowControlNoPriorityBandsText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  maxBytes: 2G
+`
+
+// flow control section with two priority bands of the same priority
+//
+//nolint:dupword
+const errorFlowControlDuplicatePriorityText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  priorityBands:
+  - priority: 0
+    name: Standard
+  - priority: 0
+    name: Sheddable
+`
+
+// flow control section with an unknown inter-flow policy
+//
+//nolint:dupword
+const errorFlowControlUnknownPolicyText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  priorityBands:
+  - priority: 0
+    name: Standard
+    interFlowPolicy: Unknown
+`
+
+// flow control section with a negative byte limit
+//
+//nolint:dupword
+const errorFlowControlNegativeMaxBytesText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  maxBytes: -1G
+  priorityBands:
+  - priority: 0
+    name: Standard
+`
+
+// flow control section with a zero shard count
+//
+//nolint:dupword
+const errorFlowControlZeroShardCountText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  shardCount: 0
+  priorityBands:
+  - priority: 0
+    name: Standard
+`
+
+// flow control section with a zero fairness weight
+//
+//nolint:dupword
+const errorFlowControlZeroFairnessWeightText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  priorityBands:
+  - priority: 0
+    name: Standard
+    interFlowPolicy: WeightedFairQueuing
+  fairnessWeights:
+    tenant-a: 0
+`
+
+// flow control section pairing a deadline ordered policy with a FIFO queue
+//
+//nolint:dupword
+const errorFlowControlIncompatibleQueueText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  priorityBands:
+  - priority: 0
+    name: Standard
+    queue: ListQueue
+    intraFlowPolicy: EDF
+`
+
+// flow control section with only a priority band template
+//
+//nolint:dupword
+const successWithFlowControlTemplateOnlyText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  priorityBandTemplate: {}
+`
+
+// flow control section with a priority band template using an unknown inter-flow policy
+//
+//nolint:dupword
+const errorFlowControlTemplateUnknownPolicyText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: queue-scorer
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: queue-scorer
+flowControl:
+  priorityBandTemplate:
+    interFlowPolicy: Unknown
+`
@@ -41,6 +41,7 @@ var defaultScorerWeight = DefaultScorerWeight
 // setDefaultsPhaseOne Performs the first phase of setting configuration defaults.
 // In particuylar it:
 //  1. Sets the name of plugins, for which one wasn't specified
+//  2. Sets the name of data sources and extractors, for which one wasn't specified
 func setDefaultsPhaseOne(cfg *configapi.EndpointPickerConfig) {
 	// If no name was given for the plugin, use it's type as the name
 	for idx, pluginConfig := range cfg.Plugins {
@@ -48,6 +49,20 @@ func setDefaultsPhaseOne(cfg *configapi.EndpointPickerConfig) {
 			cfg.Plugins[idx].Name = pluginConfig.Type
 		}
 	}
+
+	if cfg.DataLayer == nil {
+		return
+	}
+	for idx, sourceConfig := range cfg.DataLayer.Sources {
+		if sourceConfig.Name == "" {
+			cfg.DataLayer.Sources[idx].Name = sourceConfig.Type
+		}
+		for extIdx, extractorConfig := range sourceConfig.Extractors {
+			if extractorConfig.Name == "" {
+				cfg.DataLayer.Sources[idx].Extractors[extIdx].Name = extractorConfig.Type
+			}
+		}
+	}
 }
 
 // setDefaultsPhaseTwo Performs the second phase of setting configuration defaults.
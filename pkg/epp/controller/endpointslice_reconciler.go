@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+// EndpointSliceReconciler maintains the pods of the datastores whose endpoints are discovered from EndpointSlices.
+// Any change to the EndpointSlices of a pool's Service resyncs the pods of the pool from all its slices.
+type EndpointSliceReconciler struct {
+	client.Reader
+	// Datastores returns the datastores of the served pools.
+	Datastores func() []datastore.Datastore
+}
+
+func (c *EndpointSliceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(logutil.VERBOSE).Info("EndpointSlice being reconciled")
+
+	// The service of a deleted slice is unknown, so all the pools are resynced.
+	service := ""
+	slice := &discoveryv1.EndpointSlice{}
+	if err := c.Get(ctx, req.NamespacedName, slice); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("unable to get endpoint slice - %w", err)
+		}
+	} else {
+		service = slice.Labels[discoveryv1.LabelServiceName]
+	}
+
+	for _, ds := range c.Datastores() {
+		// When a pool is synced it lists the slices of its service and populates its datastore.
+		if !ds.PoolHasSynced() || ds.EndpointSliceService() == "" {
+			continue
+		}
+		if service != "" && ds.EndpointSliceService() != service {
+			continue
+		}
+		if err := ds.PodResyncAll(ctx, c.Reader); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to resync pods from endpoint slices - %w", err)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (c *EndpointSliceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	filter := predicate.Funcs{
+		CreateFunc: func(ce event.CreateEvent) bool {
+			return c.serviceMatch(ce.Object)
+		},
+		UpdateFunc: func(ue event.UpdateEvent) bool {
+			return c.serviceMatch(ue.ObjectOld) || c.serviceMatch(ue.ObjectNew)
+		},
+		DeleteFunc: func(de event.DeleteEvent) bool {
+			return c.serviceMatch(de.Object)
+		},
+		GenericFunc: func(ge event.GenericEvent) bool {
+			return c.serviceMatch(ge.Object)
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&discoveryv1.EndpointSlice{}).
+		WithEventFilter(filter).
+		Complete(c)
+}
+
+// serviceMatch returns whether the slice belongs to the service of a served pool.
+func (c *EndpointSliceReconciler) serviceMatch(slice client.Object) bool {
+	service := slice.GetLabels()[discoveryv1.LabelServiceName]
+	if service == "" {
+		return false
+	}
+	for _, ds := range c.Datastores() {
+		if ds.EndpointSliceService() == service {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+func TestEndpointSliceReconciler(t *testing.T) {
+	pool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: v1.InferencePoolSpec{
+			TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+		},
+	}
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool-abc",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "pool"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "pod1", Namespace: "default"},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(t.Context(), pmf, 0, datastore.WithEndpointSlices(""))
+	if err := ds.PoolSet(ctx, fakeClient, pool); err != nil {
+		t.Fatalf("Unexpected error setting pool: %v", err)
+	}
+	podNames := func() []string {
+		var names []string
+		for _, pm := range ds.PodList(backendmetrics.AllPodsPredicate) {
+			names = append(names, pm.GetPod().PodName)
+		}
+		return names
+	}
+	if got := podNames(); len(got) != 0 {
+		t.Fatalf("Expected no pods before the slice is created, got %v", got)
+	}
+
+	reconciler := &EndpointSliceReconciler{
+		Reader:     fakeClient,
+		Datastores: func() []datastore.Datastore { return []datastore.Datastore{ds} },
+	}
+	if !reconciler.serviceMatch(slice) {
+		t.Errorf("Expected slice of service %q to match", "pool")
+	}
+	other := slice.DeepCopy()
+	other.Labels[discoveryv1.LabelServiceName] = "other"
+	if reconciler.serviceMatch(other) {
+		t.Errorf("Expected slice of service %q not to match", "other")
+	}
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: slice.Name, Namespace: slice.Namespace}}
+	if err := fakeClient.Create(ctx, slice); err != nil {
+		t.Fatalf("Unexpected error creating slice: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Unexpected error reconciling: %v", err)
+	}
+	if got := podNames(); len(got) != 1 || got[0] != "pod1" {
+		t.Errorf("Expected pod1 after the slice is created, got %v", got)
+	}
+
+	// A terminating endpoint stops receiving new requests.
+	slice.Endpoints[0].Conditions = discoveryv1.EndpointConditions{
+		Ready:       ptr.To(false),
+		Serving:     ptr.To(true),
+		Terminating: ptr.To(true),
+	}
+	if err := fakeClient.Update(ctx, slice); err != nil {
+		t.Fatalf("Unexpected error updating slice: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Unexpected error reconciling: %v", err)
+	}
+	if got := podNames(); len(got) != 0 {
+		t.Errorf("Expected no pods once the endpoint is terminating, got %v", got)
+	}
+
+	slice.Endpoints[0].Conditions = discoveryv1.EndpointConditions{Ready: ptr.To(true)}
+	if err := fakeClient.Update(ctx, slice); err != nil {
+		t.Fatalf("Unexpected error updating slice: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Unexpected error reconciling: %v", err)
+	}
+	if err := fakeClient.Delete(ctx, slice); err != nil {
+		t.Fatalf("Unexpected error deleting slice: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Unexpected error reconciling: %v", err)
+	}
+	if got := podNames(); len(got) != 0 {
+		t.Errorf("Expected no pods once the slice is deleted, got %v", got)
+	}
+}
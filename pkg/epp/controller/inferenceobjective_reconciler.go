@@ -33,10 +33,32 @@ import (
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
 
+// PriorityBandSyncer provisions the flow control priority bands of the priorities in use.
+type PriorityBandSyncer interface {
+	SyncPriorityBands(priorities []int) error
+}
+
+// SyncPriorityBands reports the priorities of the InferenceObjectives of the datastore to the syncer. The requests
+// without an InferenceObjective, or whose InferenceObjective has no priority, have priority 0.
+func SyncPriorityBands(ds datastore.Datastore, syncer PriorityBandSyncer) error {
+	priorities := []int{0}
+	for _, objective := range ds.ObjectiveGetAll() {
+		if objective.Spec.Priority != nil {
+			priorities = append(priorities, *objective.Spec.Priority)
+		}
+	}
+	if err := syncer.SyncPriorityBands(priorities); err != nil {
+		return fmt.Errorf("failed to synchronize the flow control priority bands - %w", err)
+	}
+	return nil
+}
+
 type InferenceObjectiveReconciler struct {
 	client.Reader
 	Datastore datastore.Datastore
 	PoolGKNN  common.GKNN
+	// PriorityBands is synchronized with the priorities of the InferenceObjectives when set.
+	PriorityBands PriorityBandSyncer
 }
 
 func (c *InferenceObjectiveReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -57,7 +79,7 @@ func (c *InferenceObjectiveReconciler) Reconcile(ctx context.Context, req ctrl.R
 	if notFound || !infObjective.DeletionTimestamp.IsZero() || infObjective.Spec.PoolRef.Name != v1alpha2.ObjectName(c.PoolGKNN.Name) || infObjective.Spec.PoolRef.Group != v1alpha2.Group(c.PoolGKNN.Group) {
 		// InferenceObjective object got deleted or changed the referenced pool.
 		c.Datastore.ObjectiveDelete(req.NamespacedName)
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, c.syncPriorityBands()
 	}
 
 	// Add or update if the InferenceObjective instance has a creation timestamp older than the existing entry of the model.
@@ -65,7 +87,14 @@ func (c *InferenceObjectiveReconciler) Reconcile(ctx context.Context, req ctrl.R
 	c.Datastore.ObjectiveSet(infObjective)
 	logger.Info("Added/Updated InferenceObjective")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, c.syncPriorityBands()
+}
+
+func (c *InferenceObjectiveReconciler) syncPriorityBands() error {
+	if c.PriorityBands == nil {
+		return nil
+	}
+	return SyncPriorityBands(c.Datastore, c.PriorityBands)
 }
 
 func (c *InferenceObjectiveReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -86,59 +87,69 @@ func TestInferenceObjectiveReconciler(t *testing.T) {
 		objective             *v1alpha2.InferenceObjective
 		incomingReq           *types.NamespacedName
 		wantObjectives        []*v1alpha2.InferenceObjective
+		wantPriorities        []int
 		wantResult            ctrl.Result
 	}{
 		{
 			name:           "Empty store, add new objective",
 			objective:      infObjective1,
 			wantObjectives: []*v1alpha2.InferenceObjective{infObjective1},
+			wantPriorities: []int{0, 1},
 		},
 		{
 			name:               "Existing objective changed pools",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			objective:          infObjective1Pool2,
 			wantObjectives:     []*v1alpha2.InferenceObjective{},
+			wantPriorities:     []int{0},
 		},
 		{
 			name:               "Not found, delete existing objective",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			incomingReq:        &types.NamespacedName{Name: infObjective1.Name, Namespace: infObjective1.Namespace},
 			wantObjectives:     []*v1alpha2.InferenceObjective{},
+			wantPriorities:     []int{0},
 		},
 		{
 			name:               "Deletion timestamp set, delete existing objective",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			objective:          infObjective1Deleted,
 			wantObjectives:     []*v1alpha2.InferenceObjective{},
+			wantPriorities:     []int{0},
 		},
 		{
 			name:               "Objective changed priority",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			objective:          infObjective1Critical,
 			wantObjectives:     []*v1alpha2.InferenceObjective{infObjective1Critical},
+			wantPriorities:     []int{0, 2},
 		},
 		{
 			name:               "Objective not found, no matching existing objective to delete",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			incomingReq:        &types.NamespacedName{Name: "non-existent-objective", Namespace: pool.Namespace},
 			wantObjectives:     []*v1alpha2.InferenceObjective{infObjective1},
+			wantPriorities:     []int{0, 1},
 		},
 		{
 			name:               "Add to existing",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			objective:          infObjective2,
 			wantObjectives:     []*v1alpha2.InferenceObjective{infObjective1, infObjective2},
+			wantPriorities:     []int{0, 1},
 		},
 		{
 			name:               "Objective deleted due to group mismatch for the inference pool",
 			objectivessInStore: []*v1alpha2.InferenceObjective{infObjective1},
 			objective:          infObjective1DiffGroup,
 			wantObjectives:     []*v1alpha2.InferenceObjective{},
+			wantPriorities:     []int{0},
 		},
 		{
 			name:           "Objective ignored due to group mismatch for the inference pool",
 			objective:      infObjective1DiffGroup,
 			wantObjectives: []*v1alpha2.InferenceObjective{},
+			wantPriorities: []int{0},
 		},
 	}
 	for _, test := range tests {
@@ -165,6 +176,7 @@ func TestInferenceObjectiveReconciler(t *testing.T) {
 				ds.ObjectiveSet(m)
 			}
 			_ = ds.PoolSet(context.Background(), fakeClient, pool)
+			bands := &fakePriorityBands{}
 			reconciler := &InferenceObjectiveReconciler{
 				Reader:    fakeClient,
 				Datastore: ds,
@@ -172,6 +184,7 @@ func TestInferenceObjectiveReconciler(t *testing.T) {
 					NamespacedName: types.NamespacedName{Name: pool.Name, Namespace: pool.Namespace},
 					GroupKind:      schema.GroupKind{Group: pool.GroupVersionKind().Group, Kind: pool.GroupVersionKind().Kind},
 				},
+				PriorityBands: bands,
 			}
 			if test.incomingReq == nil {
 				test.incomingReq = &types.NamespacedName{Name: test.objective.Name, Namespace: test.objective.Namespace}
@@ -195,6 +208,19 @@ func TestInferenceObjectiveReconciler(t *testing.T) {
 				t.Errorf("Unexpected diff (+got/-want): %s", diff)
 			}
 
+			if diff := cmp.Diff(test.wantPriorities, bands.priorities); diff != "" {
+				t.Errorf("Unexpected priority bands diff (-want +got): %s", diff)
+			}
 		})
 	}
 }
+
+// fakePriorityBands records the last priorities synchronized, sorted and deduplicated.
+type fakePriorityBands struct {
+	priorities []int
+}
+
+func (f *fakePriorityBands) SyncPriorityBands(priorities []int) error {
+	f.priorities = sets.List(sets.New(priorities...))
+	return nil
+}
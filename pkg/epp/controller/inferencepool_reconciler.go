@@ -48,15 +48,10 @@ func (c *InferencePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	logger.Info("Reconciling InferencePool")
 
 	// 1. Initialize a generic client.Object based on the group.
-	var obj client.Object
-	switch c.PoolGKNN.Group {
-	case v1.GroupName:
-		obj = &v1.InferencePool{}
-	case v1alpha2.GroupName:
-		obj = &v1alpha2.InferencePool{}
-	default:
+	obj, err := newInferencePool(c.PoolGKNN.Group)
+	if err != nil {
 		// Handle unsupported groups gracefully.
-		return ctrl.Result{}, fmt.Errorf("cannot reconcile InferencePool - unsupported API group: %s", c.PoolGKNN.Group)
+		return ctrl.Result{}, fmt.Errorf("cannot reconcile InferencePool - %w", err)
 	}
 
 	// 2. Perform a single, generic fetch for the object.
@@ -77,27 +72,45 @@ func (c *InferencePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 
 	// 4. Convert the fetched object to the canonical v1.InferencePool.
-	v1infPool := &v1.InferencePool{}
+	v1infPool, err := toV1InferencePool(obj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := c.Datastore.PoolSet(ctx, c.Reader, v1infPool); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update datastore - %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
 
+// newInferencePool returns an empty InferencePool object of the given API group.
+func newInferencePool(group string) (client.Object, error) {
+	switch group {
+	case v1.GroupName:
+		return &v1.InferencePool{}, nil
+	case v1alpha2.GroupName:
+		return &v1alpha2.InferencePool{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported API group: %s", group)
+	}
+}
+
+// toV1InferencePool converts a fetched InferencePool object to the canonical v1.InferencePool.
+func toV1InferencePool(obj client.Object) (*v1.InferencePool, error) {
 	switch pool := obj.(type) {
 	case *v1.InferencePool:
 		// If it's already a v1 object, just use it.
-		v1infPool = pool
+		return pool, nil
 	case *v1alpha2.InferencePool:
-		var err error
-		err = pool.ConvertTo(v1infPool)
-		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to convert XInferencePool to InferencePool - %w", err)
+		v1infPool := &v1.InferencePool{}
+		if err := pool.ConvertTo(v1infPool); err != nil {
+			return nil, fmt.Errorf("failed to convert XInferencePool to InferencePool - %w", err)
 		}
+		return v1infPool, nil
 	default:
-		return ctrl.Result{}, fmt.Errorf("unsupported API group: %s", c.PoolGKNN.Group)
+		return nil, fmt.Errorf("unsupported InferencePool type: %T", obj)
 	}
-
-	if err := c.Datastore.PoolSet(ctx, c.Reader, v1infPool); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to update datastore - %w", err)
-	}
-
-	return ctrl.Result{}, nil
 }
 
 func (c *InferencePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
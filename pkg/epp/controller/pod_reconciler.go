@@ -58,7 +58,7 @@ func (c *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, fmt.Errorf("unable to get pod - %w", err)
 	}
 
-	c.updateDatastore(logger, pod)
+	updateDatastore(logger, c.Datastore, pod)
 	return ctrl.Result{}, nil
 }
 
@@ -88,12 +88,12 @@ func (c *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(c)
 }
 
-func (c *PodReconciler) updateDatastore(logger logr.Logger, pod *corev1.Pod) {
-	if !podutil.IsPodReady(pod) || !c.Datastore.PoolLabelsMatch(pod.Labels) {
+func updateDatastore(logger logr.Logger, ds datastore.Datastore, pod *corev1.Pod) {
+	if !podutil.IsPodReady(pod) || !ds.PoolLabelsMatch(pod.Labels) {
 		logger.V(logutil.DEBUG).Info("Pod removed or not added")
-		c.Datastore.PodDelete(pod.Name)
+		ds.PodDelete(pod.Name)
 	} else {
-		if c.Datastore.PodUpdateOrAddIfNotExist(pod) {
+		if ds.PodUpdateOrAddIfNotExist(pod) {
 			logger.V(logutil.DEFAULT).Info("Pod added")
 		} else {
 			logger.V(logutil.DEFAULT).Info("Pod already exists")
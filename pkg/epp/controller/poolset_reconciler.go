@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+// PoolSet holds a datastore per InferencePool, when the Endpoint Picker serves several pools.
+type PoolSet interface {
+	// Selects returns whether the given InferencePool is served.
+	Selects(pool client.Object) bool
+	// Datastore returns the datastore of a served pool.
+	Datastore(pool types.NamespacedName) (datastore.Datastore, bool)
+	// Add returns the datastore of a served pool, creating it if the pool was not served yet. The returned
+	// boolean is true when the datastore was created.
+	Add(pool types.NamespacedName) (datastore.Datastore, bool, error)
+	// Remove stops serving a pool and clears its datastore.
+	Remove(pool types.NamespacedName)
+	// Datastores returns the datastores of all served pools.
+	Datastores() []datastore.Datastore
+	// SyncPriorityBands synchronizes the flow control priority bands of the served pools with the priorities of
+	// their InferenceObjectives.
+	SyncPriorityBands() error
+}
+
+// InferencePoolSetReconciler maintains the datastores of the InferencePools selected by a PoolSet. Pools are
+// added to the set when they are first reconciled and removed when deleted or no longer selected.
+type InferencePoolSetReconciler struct {
+	client.Reader
+	Pools PoolSet
+	// PoolGKNN holds the group and namespace of the served pools, its name is ignored.
+	PoolGKNN common.GKNN
+}
+
+func (c *InferencePoolSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("group", c.PoolGKNN.Group).V(logutil.DEFAULT)
+	ctx = ctrl.LoggerInto(ctx, logger)
+
+	logger.Info("Reconciling InferencePool")
+
+	obj, err := newInferencePool(c.PoolGKNN.Group)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("cannot reconcile InferencePool - %w", err)
+	}
+
+	if err := c.Get(ctx, req.NamespacedName, obj); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("InferencePool not found. Removing it from the served pools")
+			c.Pools.Remove(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("unable to get InferencePool - %w", err)
+	}
+
+	if !obj.GetDeletionTimestamp().IsZero() || !c.Pools.Selects(obj) {
+		logger.Info("InferencePool is marked for deletion or not selected. Removing it from the served pools")
+		c.Pools.Remove(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	v1infPool, err := toV1InferencePool(obj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ds, added, err := c.Pools.Add(req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to serve InferencePool - %w", err)
+	}
+	if added {
+		logger.Info("Serving InferencePool")
+		// The objectives referencing the pool may have been reconciled before the pool was served.
+		if err := c.populateObjectives(ctx, ds, req.NamespacedName); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := c.Pools.SyncPriorityBands(); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := ds.PoolSet(ctx, c.Reader, v1infPool); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update datastore - %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (c *InferencePoolSetReconciler) populateObjectives(ctx context.Context, ds datastore.Datastore, pool types.NamespacedName) error {
+	objectives := &v1alpha2.InferenceObjectiveList{}
+	if err := c.List(ctx, objectives, client.InNamespace(pool.Namespace)); err != nil {
+		return fmt.Errorf("failed to list InferenceObjectives - %w", err)
+	}
+	for i := range objectives.Items {
+		objective := &objectives.Items[i]
+		if objective.DeletionTimestamp.IsZero() && referencesPool(objective, c.PoolGKNN.Group, pool.Name) {
+			ds.ObjectiveSet(objective)
+		}
+	}
+	return nil
+}
+
+func (c *InferencePoolSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	obj, err := newInferencePool(c.PoolGKNN.Group)
+	if err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(obj).
+		Complete(c)
+}
+
+// PodSetReconciler maintains the pods of the datastores of a PoolSet. A pod is added to the datastore of each
+// served pool whose selector it matches.
+type PodSetReconciler struct {
+	client.Reader
+	Pools PoolSet
+}
+
+func (c *PodSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(logutil.VERBOSE).Info("Pod being reconciled")
+
+	pod := &corev1.Pod{}
+	if err := c.Get(ctx, req.NamespacedName, pod); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("unable to get pod - %w", err)
+		}
+		pod = nil
+	}
+
+	for _, ds := range c.Pools.Datastores() {
+		// When a pool is synced it lists the appropriate pods and populates its datastore.
+		if !ds.PoolHasSynced() {
+			continue
+		}
+		if pod == nil {
+			ds.PodDelete(req.Name)
+		} else {
+			updateDatastore(logger, ds, pod)
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (c *PodSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	filter := predicate.Funcs{
+		CreateFunc: func(ce event.CreateEvent) bool {
+			return c.labelsMatch(ce.Object)
+		},
+		UpdateFunc: func(ue event.UpdateEvent) bool {
+			return c.labelsMatch(ue.ObjectOld) || c.labelsMatch(ue.ObjectNew)
+		},
+		DeleteFunc: func(de event.DeleteEvent) bool {
+			return c.labelsMatch(de.Object)
+		},
+		GenericFunc: func(ge event.GenericEvent) bool {
+			return c.labelsMatch(ge.Object)
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}).
+		WithEventFilter(filter).
+		Complete(c)
+}
+
+func (c *PodSetReconciler) labelsMatch(pod client.Object) bool {
+	for _, ds := range c.Pools.Datastores() {
+		if ds.PoolLabelsMatch(pod.GetLabels()) {
+			return true
+		}
+	}
+	return false
+}
+
+// InferenceObjectiveSetReconciler maintains the objectives of the datastores of a PoolSet. An objective is set
+// on the datastore of the pool it references.
+type InferenceObjectiveSetReconciler struct {
+	client.Reader
+	Pools PoolSet
+	// PoolGKNN holds the group and namespace of the served pools, its name is ignored.
+	PoolGKNN common.GKNN
+}
+
+func (c *InferenceObjectiveSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).V(logutil.DEFAULT)
+	ctx = ctrl.LoggerInto(ctx, logger)
+
+	logger.Info("Reconciling InferenceObjective")
+
+	infObjective := &v1alpha2.InferenceObjective{}
+	if err := c.Get(ctx, req.NamespacedName, infObjective); err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("unable to get InferenceObjective - %w", err)
+		}
+		infObjective = nil
+	}
+
+	// The objective may have changed the referenced pool, so it is removed from all the other pools.
+	var target datastore.Datastore
+	if infObjective != nil && infObjective.DeletionTimestamp.IsZero() && string(infObjective.Spec.PoolRef.Group) == c.PoolGKNN.Group {
+		target, _ = c.Pools.Datastore(types.NamespacedName{Name: string(infObjective.Spec.PoolRef.Name), Namespace: req.Namespace})
+	}
+	for _, ds := range c.Pools.Datastores() {
+		if ds != target {
+			ds.ObjectiveDelete(req.NamespacedName)
+		}
+	}
+
+	if target != nil {
+		logger = logger.WithValues("poolRef", infObjective.Spec.PoolRef)
+		target.ObjectiveSet(infObjective)
+		logger.Info("Added/Updated InferenceObjective")
+	}
+	return ctrl.Result{}, c.Pools.SyncPriorityBands()
+}
+
+func (c *InferenceObjectiveSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha2.InferenceObjective{}).
+		Complete(c)
+}
+
+// referencesPool returns whether the objective references the pool of the given group and name.
+func referencesPool(objective *v1alpha2.InferenceObjective, group, name string) bool {
+	return string(objective.Spec.PoolRef.Group) == group && string(objective.Spec.PoolRef.Name) == name
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	utiltest "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/testing"
+)
+
+// fakePoolSet serves the pools of the given names, each with its own datastore.
+type fakePoolSet struct {
+	ctx    context.Context
+	names  sets.Set[string]
+	stores map[types.NamespacedName]datastore.Datastore
+	bands  map[types.NamespacedName]*fakePriorityBands
+}
+
+func newFakePoolSet(ctx context.Context, names ...string) *fakePoolSet {
+	return &fakePoolSet{
+		ctx:    ctx,
+		names:  sets.New(names...),
+		stores: make(map[types.NamespacedName]datastore.Datastore),
+		bands:  make(map[types.NamespacedName]*fakePriorityBands),
+	}
+}
+
+func (f *fakePoolSet) Selects(pool client.Object) bool {
+	return f.names.Has(pool.GetName())
+}
+
+func (f *fakePoolSet) Datastore(pool types.NamespacedName) (datastore.Datastore, bool) {
+	ds, ok := f.stores[pool]
+	return ds, ok
+}
+
+func (f *fakePoolSet) Add(pool types.NamespacedName) (datastore.Datastore, bool, error) {
+	if ds, ok := f.stores[pool]; ok {
+		return ds, false, nil
+	}
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(f.ctx, pmf, 0)
+	f.stores[pool] = ds
+	f.bands[pool] = &fakePriorityBands{}
+	return ds, true, nil
+}
+
+func (f *fakePoolSet) Remove(pool types.NamespacedName) {
+	if ds, ok := f.stores[pool]; ok {
+		ds.Clear()
+		delete(f.stores, pool)
+		delete(f.bands, pool)
+	}
+}
+
+func (f *fakePoolSet) Datastores() []datastore.Datastore {
+	names := make([]types.NamespacedName, 0, len(f.stores))
+	for name := range f.stores {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i].Name < names[j].Name })
+	stores := make([]datastore.Datastore, 0, len(names))
+	for _, name := range names {
+		stores = append(stores, f.stores[name])
+	}
+	return stores
+}
+
+func (f *fakePoolSet) SyncPriorityBands() error {
+	for name, ds := range f.stores {
+		if err := SyncPriorityBands(ds, f.bands[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestPoolSetReconcilers(t *testing.T) {
+	// As for the single pool reconciler, the steps depend on each other.
+	const namespace = "pools-ns"
+	gvk := schema.GroupVersionKind{
+		Group:   v1.GroupVersion.Group,
+		Version: v1.GroupVersion.Version,
+		Kind:    "InferencePool",
+	}
+	makePool := func(name string, selector map[string]string) *v1.InferencePool {
+		pool := utiltest.MakeInferencePool(name).
+			Namespace(namespace).
+			Selector(selector).
+			TargetPorts(8080).
+			EndpointPickerRef("epp-service").ObjRef()
+		pool.SetGroupVersionKind(gvk)
+		return pool
+	}
+	poolA := makePool("pool-a", selector_v1)
+	poolB := makePool("pool-b", selector_v2)
+	poolC := makePool("pool-c", selector_v1)
+	objective := utiltest.MakeInferenceObjective("objective").
+		Namespace(namespace).
+		PoolName(poolA.Name).
+		PoolGroup(v1.GroupName).
+		Priority(1).ObjRef()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.Install(scheme)
+	_ = v1.Install(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(poolA, poolB, poolC, objective,
+			utiltest.MakePod("pod1").Namespace(namespace).Labels(selector_v1).ReadyCondition().ObjRef(),
+			utiltest.MakePod("pod2").Namespace(namespace).Labels(selector_v2).ReadyCondition().ObjRef(),
+			utiltest.MakePod("pod3").Namespace(namespace).Labels(selector_v2).ObjRef()).
+		Build()
+
+	ctx := context.Background()
+	gknn := common.GKNN{
+		NamespacedName: types.NamespacedName{Namespace: namespace},
+		GroupKind:      schema.GroupKind{Group: v1.GroupName, Kind: "InferencePool"},
+	}
+	pools := newFakePoolSet(ctx, poolA.Name, poolB.Name)
+	poolReconciler := &InferencePoolSetReconciler{Reader: fakeClient, Pools: pools, PoolGKNN: gknn}
+	podReconciler := &PodSetReconciler{Reader: fakeClient, Pools: pools}
+	objectiveReconciler := &InferenceObjectiveSetReconciler{Reader: fakeClient, Pools: pools, PoolGKNN: gknn}
+	request := func(obj client.Object) ctrl.Request {
+		return ctrl.Request{NamespacedName: types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}}
+	}
+	reconcile := func(r interface {
+		Reconcile(context.Context, ctrl.Request) (ctrl.Result, error)
+	}, obj client.Object) {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, request(obj)); err != nil {
+			t.Fatalf("Unexpected reconcile error for %s: %v", obj.GetName(), err)
+		}
+	}
+	checkPriorities := func(pool *v1.InferencePool, want []int) {
+		t.Helper()
+		bands := pools.bands[types.NamespacedName{Name: pool.Name, Namespace: pool.Namespace}]
+		if diff := cmp.Diff(want, bands.priorities); diff != "" {
+			t.Errorf("Unexpected priority bands diff for pool %s (-want +got): %s", pool.Name, diff)
+		}
+	}
+	checkStore := func(pool *v1.InferencePool, params diffStoreParams) {
+		t.Helper()
+		ds, ok := pools.Datastore(types.NamespacedName{Name: pool.Name, Namespace: pool.Namespace})
+		if !ok {
+			t.Fatalf("Expected pool %s to be served", pool.Name)
+		}
+		if diff := diffStore(ds, params); diff != "" {
+			t.Errorf("Unexpected diff for pool %s (+got/-want): %s", pool.Name, diff)
+		}
+	}
+
+	// Step 1: the objective is reconciled before its pool is served.
+	reconcile(objectiveReconciler, objective)
+	if got := len(pools.Datastores()); got != 0 {
+		t.Fatalf("Expected no served pool, got %d", got)
+	}
+
+	// Step 2: the selected pools are served with their ready pods, the objective is populated.
+	reconcile(poolReconciler, poolA)
+	reconcile(poolReconciler, poolB)
+	reconcile(poolReconciler, poolC)
+	if got := len(pools.Datastores()); got != 2 {
+		t.Fatalf("Expected 2 served pools, got %d", got)
+	}
+	checkStore(poolA, diffStoreParams{wantPool: poolA, wantPods: []string{"pod1-rank-0"},
+		wantObjectives: []*v1alpha2.InferenceObjective{objective}})
+	checkStore(poolB, diffStoreParams{wantPool: poolB, wantPods: []string{"pod2-rank-0"}})
+	checkPriorities(poolA, []int{0, 1})
+	checkPriorities(poolB, []int{0})
+
+	// Step 3: the objective moves to another pool.
+	updated := objective.DeepCopy()
+	updated.Spec.PoolRef.Name = v1alpha2.ObjectName(poolB.Name)
+	if err := fakeClient.Update(ctx, updated); err != nil {
+		t.Fatalf("Unexpected objective update error: %v", err)
+	}
+	reconcile(objectiveReconciler, updated)
+	if err := fakeClient.Get(ctx, request(updated).NamespacedName, updated); err != nil {
+		t.Fatalf("Unexpected objective get error: %v", err)
+	}
+	checkStore(poolA, diffStoreParams{wantPool: poolA, wantPods: []string{"pod1-rank-0"}})
+	checkStore(poolB, diffStoreParams{wantPool: poolB, wantPods: []string{"pod2-rank-0"},
+		wantObjectives: []*v1alpha2.InferenceObjective{updated}})
+	checkPriorities(poolA, []int{0})
+	checkPriorities(poolB, []int{0, 1})
+
+	// Step 4: a pod becomes ready and is added to the pool it matches.
+	pod3 := &corev1.Pod{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "pod3", Namespace: namespace}, pod3); err != nil {
+		t.Fatalf("Unexpected pod get error: %v", err)
+	}
+	pod3.Status = utiltest.MakePod("pod3").ReadyCondition().ObjRef().Status
+	if err := fakeClient.Status().Update(ctx, pod3); err != nil {
+		t.Fatalf("Unexpected pod update error: %v", err)
+	}
+	reconcile(podReconciler, pod3)
+	checkStore(poolA, diffStoreParams{wantPool: poolA, wantPods: []string{"pod1-rank-0"}})
+	checkStore(poolB, diffStoreParams{wantPool: poolB, wantPods: []string{"pod2-rank-0", "pod3-rank-0"},
+		wantObjectives: []*v1alpha2.InferenceObjective{updated}})
+
+	// Step 5: a deleted pool is no longer served.
+	if err := fakeClient.Delete(ctx, poolA); err != nil {
+		t.Fatalf("Unexpected pool delete error: %v", err)
+	}
+	reconcile(poolReconciler, poolA)
+	if _, ok := pools.Datastore(request(poolA).NamespacedName); ok {
+		t.Errorf("Expected pool %s to no longer be served", poolA.Name)
+	}
+	checkStore(poolB, diffStoreParams{wantPool: poolB, wantPods: []string{"pod2-rank-0", "pod3-rank-0"},
+		wantObjectives: []*v1alpha2.InferenceObjective{updated}})
+}
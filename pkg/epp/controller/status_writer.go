@@ -0,0 +1,249 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	"sigs.k8s.io/gateway-api-inference-extension/internal/runnable"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+const (
+	// StatusControllerName is the controller name of the InferencePool parent status written by the Endpoint Picker.
+	StatusControllerName v1.ControllerName = "inference.networking.k8s.io/endpoint-picker"
+
+	// EndpointPickerConditionReady is the type of the condition reporting whether the Endpoint Picker has endpoints
+	// to serve requests with. Its message reports the endpoint counts of the pool.
+	EndpointPickerConditionReady v1.InferencePoolConditionType = "Ready"
+	// EndpointPickerReasonEndpointsAvailable is used with the "Ready" condition when the pool has ready endpoints.
+	EndpointPickerReasonEndpointsAvailable v1.InferencePoolReason = "EndpointsAvailable"
+	// EndpointPickerReasonNoEndpointsAvailable is used with the "Ready" condition when the pool has no ready endpoints.
+	EndpointPickerReasonNoEndpointsAvailable v1.InferencePoolReason = "NoEndpointsAvailable"
+)
+
+// StatusWriter periodically writes the status of the served InferencePools and of their InferenceObjectives.
+// The InferencePool gets a parent status entry for its Endpoint Picker, reporting its endpoint counts, and the
+// InferenceObjectives get their "Accepted" and "ResolvedRefs" conditions.
+//
+// Only the leader writes status. An object is only written when its status changes, and at most once per Interval.
+type StatusWriter struct {
+	client.Client
+	// Datastores returns the datastores of the served pools.
+	Datastores func() []datastore.Datastore
+	PoolGKNN   common.GKNN
+	Interval   time.Duration
+}
+
+// SetupWithManager adds the status writer to the manager as a runnable requiring leader election.
+func (w *StatusWriter) SetupWithManager(mgr ctrl.Manager) error {
+	if w.Interval <= 0 {
+		return fmt.Errorf("invalid status update interval %v", w.Interval)
+	}
+	return mgr.Add(runnable.RequireLeaderElection(manager.RunnableFunc(w.Start)))
+}
+
+// Start writes the status every Interval until ctx is done.
+func (w *StatusWriter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.Sync(ctx)
+		}
+	}
+}
+
+// Sync writes the status of the served pools and objectives that changed. Failed writes are logged and retried
+// on the next sync.
+func (w *StatusWriter) Sync(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for _, ds := range w.Datastores() {
+		pool, err := ds.PoolGet()
+		if err != nil {
+			continue
+		}
+		if err := w.syncPoolStatus(ctx, ds, pool); err != nil {
+			logger.Error(err, "Failed to write InferencePool status", "pool", client.ObjectKeyFromObject(pool))
+		}
+		for _, objective := range ds.ObjectiveGetAll() {
+			if err := w.syncObjectiveStatus(ctx, ds, client.ObjectKeyFromObject(objective)); err != nil {
+				logger.Error(err, "Failed to write InferenceObjective status", "objective", client.ObjectKeyFromObject(objective))
+			}
+		}
+	}
+}
+
+func (w *StatusWriter) syncPoolStatus(ctx context.Context, ds datastore.Datastore, served *v1.InferencePool) error {
+	obj, err := newInferencePool(w.PoolGKNN.Group)
+	if err != nil {
+		return err
+	}
+	if err := w.Get(ctx, client.ObjectKeyFromObject(served), obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	pool, err := toV1InferencePool(obj)
+	if err != nil {
+		return err
+	}
+	// The conversion of a v1alpha2 pool normalizes its status, so the status is compared in its v1 form.
+	current := pool.Status.DeepCopy()
+
+	parentRef := endpointPickerParentRef(pool)
+	var parent *v1.ParentStatus
+	for i := range pool.Status.Parents {
+		if equality.Semantic.DeepEqual(pool.Status.Parents[i].ParentRef, parentRef) {
+			parent = &pool.Status.Parents[i]
+			break
+		}
+	}
+	if parent == nil {
+		pool.Status.Parents = append(pool.Status.Parents, v1.ParentStatus{ParentRef: parentRef})
+		parent = &pool.Status.Parents[len(pool.Status.Parents)-1]
+	}
+	parent.ControllerName = StatusControllerName
+	setPoolConditions(&parent.Conditions, pool.Generation, ds)
+
+	if equality.Semantic.DeepEqual(current, &pool.Status) {
+		return nil
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Writing InferencePool status", "pool", client.ObjectKeyFromObject(pool))
+
+	switch obj.(type) {
+	case *v1alpha2.InferencePool:
+		xpool := &v1alpha2.InferencePool{}
+		if err := xpool.ConvertFrom(pool); err != nil {
+			return fmt.Errorf("failed to convert InferencePool to XInferencePool - %w", err)
+		}
+		return w.Status().Update(ctx, xpool)
+	default:
+		return w.Status().Update(ctx, pool)
+	}
+}
+
+// endpointPickerParentRef returns the reference identifying the parent status entry of the Endpoint Picker,
+// which is its Service referenced by the pool.
+func endpointPickerParentRef(pool *v1.InferencePool) v1.ParentReference {
+	group := v1.Group("")
+	if pool.Spec.EndpointPickerRef.Group != nil {
+		group = *pool.Spec.EndpointPickerRef.Group
+	}
+	kind := pool.Spec.EndpointPickerRef.Kind
+	if kind == "" {
+		kind = "Service"
+	}
+	return v1.ParentReference{
+		Group:     &group,
+		Kind:      kind,
+		Name:      pool.Spec.EndpointPickerRef.Name,
+		Namespace: v1.Namespace(pool.Namespace),
+	}
+}
+
+func setPoolConditions(conditions *[]metav1.Condition, generation int64, ds datastore.Datastore) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               string(v1.InferencePoolConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1.InferencePoolReasonAccepted),
+		Message:            "InferencePool is served by the Endpoint Picker",
+		ObservedGeneration: generation,
+	})
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               string(v1.InferencePoolConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1.InferencePoolReasonResolvedRefs),
+		Message:            "Endpoint Picker is running",
+		ObservedGeneration: generation,
+	})
+
+	endpoints := len(ds.PodList(backendmetrics.AllPodsPredicate))
+	ready := len(ds.PodList(backendmetrics.ServingPodsPredicate))
+	readyCondition := metav1.Condition{
+		Type:               string(EndpointPickerConditionReady),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(EndpointPickerReasonEndpointsAvailable),
+		Message:            fmt.Sprintf("%d of %d endpoints ready, %d draining", ready, endpoints, endpoints-ready),
+		ObservedGeneration: generation,
+	}
+	if ready == 0 {
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = string(EndpointPickerReasonNoEndpointsAvailable)
+	}
+	meta.SetStatusCondition(conditions, readyCondition)
+}
+
+func (w *StatusWriter) syncObjectiveStatus(ctx context.Context, ds datastore.Datastore, key types.NamespacedName) error {
+	objective := &v1alpha2.InferenceObjective{}
+	if err := w.Get(ctx, key, objective); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	current := objective.Status.DeepCopy()
+
+	// The CRD defaults the status to a pending placeholder, which is replaced by the actual conditions.
+	if c := meta.FindStatusCondition(objective.Status.Conditions, "Ready"); c != nil && c.Reason == string(v1alpha2.ObjectiveReasonPending) {
+		meta.RemoveStatusCondition(&objective.Status.Conditions, "Ready")
+	}
+	meta.SetStatusCondition(&objective.Status.Conditions, metav1.Condition{
+		Type:               string(v1alpha2.ObjectiveConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1alpha2.ObjectiveReasonAccepted),
+		Message:            "InferenceObjective is served by the Endpoint Picker",
+		ObservedGeneration: objective.Generation,
+	})
+	resolved := metav1.Condition{
+		Type:               string(v1alpha2.ObjectiveConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1alpha2.ObjectiveReasonResolvedRefs),
+		Message:            "InferencePool is resolved",
+		ObservedGeneration: objective.Generation,
+	}
+	if !ds.PoolHasSynced() {
+		resolved.Status = metav1.ConditionFalse
+		resolved.Reason = string(v1alpha2.ObjectiveReasonPoolNotFound)
+		resolved.Message = fmt.Sprintf("InferencePool %s not found", objective.Spec.PoolRef.Name)
+	}
+	meta.SetStatusCondition(&objective.Status.Conditions, resolved)
+
+	if equality.Semantic.DeepEqual(current, &objective.Status) {
+		return nil
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Writing InferenceObjective status", "objective", key)
+	return w.Status().Update(ctx, objective)
+}
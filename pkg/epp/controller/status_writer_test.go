@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+func TestStatusWriter(t *testing.T) {
+	pool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default", Generation: 2},
+		Spec: v1.InferencePoolSpec{
+			TargetPorts:       []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+			EndpointPickerRef: v1.EndpointPickerRef{Kind: "Service", Name: "epp", Port: &v1.Port{Number: 9002}},
+		},
+		Status: v1.InferencePoolStatus{
+			Parents: []v1.ParentStatus{{
+				ParentRef: v1.ParentReference{Kind: "Gateway", Name: "gateway", Namespace: "default"},
+				Conditions: []metav1.Condition{{
+					Type:               string(v1.InferencePoolConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					Reason:             string(v1.InferencePoolReasonAccepted),
+					LastTransitionTime: metav1.Now(),
+				}},
+			}},
+		},
+	}
+	objective := &v1alpha2.InferenceObjective{
+		ObjectMeta: metav1.ObjectMeta{Name: "objective", Namespace: "default", Generation: 3},
+		Spec: v1alpha2.InferenceObjectiveSpec{
+			PoolRef: v1alpha2.PoolObjectReference{Name: "pool"},
+		},
+		Status: v1alpha2.InferenceObjectiveStatus{
+			Conditions: []metav1.Condition{{
+				Type:               "Ready",
+				Status:             metav1.ConditionUnknown,
+				Reason:             string(v1alpha2.ObjectiveReasonPending),
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+	pods := []*corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "default"}, Status: corev1.PodStatus{PodIP: "10.0.0.1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pod2", Namespace: "default"}, Status: corev1.PodStatus{PodIP: "10.0.0.2"}},
+	}
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.Install(scheme)
+	_ = v1.Install(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pool, objective).
+		WithStatusSubresource(pool, objective).
+		Build()
+
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(t.Context(), pmf, 0, datastore.WithDrainTimeout(time.Minute))
+	if err := ds.PoolSet(ctx, fakeClient, pool); err != nil {
+		t.Fatalf("Unexpected error setting pool: %v", err)
+	}
+	for _, pod := range pods {
+		ds.PodUpdateOrAddIfNotExist(pod)
+	}
+	ds.ObjectiveSet(objective)
+
+	writer := &StatusWriter{
+		Client:     fakeClient,
+		Datastores: func() []datastore.Datastore { return []datastore.Datastore{ds} },
+		PoolGKNN:   common.GKNN{NamespacedName: client.ObjectKeyFromObject(pool), GroupKind: schema.GroupKind{Group: v1.GroupName, Kind: "InferencePool"}},
+		Interval:   time.Second,
+	}
+
+	// eppConditions returns the conditions of the parent status entry of the Endpoint Picker.
+	eppConditions := func() []metav1.Condition {
+		got := &v1.InferencePool{}
+		if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(pool), got); err != nil {
+			t.Fatalf("Unexpected error getting pool: %v", err)
+		}
+		if len(got.Status.Parents) != 2 {
+			t.Fatalf("Expected the gateway and Endpoint Picker parents, got %+v", got.Status.Parents)
+		}
+		parent := got.Status.Parents[1]
+		if parent.ParentRef.Kind != "Service" || parent.ParentRef.Name != "epp" || parent.ControllerName != StatusControllerName {
+			t.Fatalf("Unexpected Endpoint Picker parent %+v", parent)
+		}
+		return parent.Conditions
+	}
+	assertCondition := func(conditions []metav1.Condition, conditionType string, status metav1.ConditionStatus, reason string, generation int64) *metav1.Condition {
+		t.Helper()
+		c := meta.FindStatusCondition(conditions, conditionType)
+		if c == nil {
+			t.Fatalf("Condition %q not found in %+v", conditionType, conditions)
+		}
+		if c.Status != status || c.Reason != reason || c.ObservedGeneration != generation {
+			t.Errorf("Unexpected condition %q: %+v", conditionType, c)
+		}
+		return c
+	}
+
+	writer.Sync(ctx)
+	conditions := eppConditions()
+	assertCondition(conditions, string(v1.InferencePoolConditionAccepted), metav1.ConditionTrue, string(v1.InferencePoolReasonAccepted), 2)
+	assertCondition(conditions, string(v1.InferencePoolConditionResolvedRefs), metav1.ConditionTrue, string(v1.InferencePoolReasonResolvedRefs), 2)
+	ready := assertCondition(conditions, string(EndpointPickerConditionReady), metav1.ConditionTrue, string(EndpointPickerReasonEndpointsAvailable), 2)
+	if want := "2 of 2 endpoints ready, 0 draining"; ready.Message != want {
+		t.Errorf("Expected ready message %q, got %q", want, ready.Message)
+	}
+
+	gotObjective := &v1alpha2.InferenceObjective{}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(objective), gotObjective); err != nil {
+		t.Fatalf("Unexpected error getting objective: %v", err)
+	}
+	if len(gotObjective.Status.Conditions) != 2 {
+		t.Errorf("Expected the pending condition to be replaced, got %+v", gotObjective.Status.Conditions)
+	}
+	assertCondition(gotObjective.Status.Conditions, string(v1alpha2.ObjectiveConditionAccepted), metav1.ConditionTrue, string(v1alpha2.ObjectiveReasonAccepted), 3)
+	assertCondition(gotObjective.Status.Conditions, string(v1alpha2.ObjectiveConditionResolvedRefs), metav1.ConditionTrue, string(v1alpha2.ObjectiveReasonResolvedRefs), 3)
+
+	// Unchanged status is not written again.
+	versions := func() (string, string) {
+		gotPool := &v1.InferencePool{}
+		_ = fakeClient.Get(ctx, client.ObjectKeyFromObject(pool), gotPool)
+		gotObjective := &v1alpha2.InferenceObjective{}
+		_ = fakeClient.Get(ctx, client.ObjectKeyFromObject(objective), gotObjective)
+		return gotPool.ResourceVersion, gotObjective.ResourceVersion
+	}
+	poolVersion, objectiveVersion := versions()
+	writer.Sync(ctx)
+	if gotPool, gotObjective := versions(); gotPool != poolVersion || gotObjective != objectiveVersion {
+		t.Errorf("Expected unchanged status not to be written")
+	}
+
+	// Draining and removed endpoints are reported.
+	ds.PodRequestStarted(types.NamespacedName{Name: "pod2-rank-0", Namespace: "default"})
+	ds.PodDelete("pod2")
+	writer.Sync(ctx)
+	ready = assertCondition(eppConditions(), string(EndpointPickerConditionReady), metav1.ConditionTrue, string(EndpointPickerReasonEndpointsAvailable), 2)
+	if want := "1 of 2 endpoints ready, 1 draining"; ready.Message != want {
+		t.Errorf("Expected ready message %q, got %q", want, ready.Message)
+	}
+
+	ds.PodDelete("pod1")
+	writer.Sync(ctx)
+	ready = assertCondition(eppConditions(), string(EndpointPickerConditionReady), metav1.ConditionFalse, string(EndpointPickerReasonNoEndpointsAvailable), 2)
+	if want := "0 of 1 endpoints ready, 1 draining"; ready.Message != want {
+		t.Errorf("Expected ready message %q, got %q", want, ready.Message)
+	}
+}
+
+func TestStatusWriterV1alpha2Pool(t *testing.T) {
+	pool := &v1alpha2.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: v1alpha2.InferencePoolSpec{
+			TargetPortNumber: 8000,
+			ExtensionRef:     v1alpha2.Extension{Name: "epp"},
+		},
+	}
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.Install(scheme)
+	_ = v1.Install(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(pool).
+		WithStatusSubresource(pool).
+		Build()
+
+	v1pool := &v1.InferencePool{}
+	if err := pool.ConvertTo(v1pool); err != nil {
+		t.Fatalf("Unexpected error converting pool: %v", err)
+	}
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(t.Context(), pmf, 0)
+	if err := ds.PoolSet(ctx, fakeClient, v1pool); err != nil {
+		t.Fatalf("Unexpected error setting pool: %v", err)
+	}
+
+	writer := &StatusWriter{
+		Client:     fakeClient,
+		Datastores: func() []datastore.Datastore { return []datastore.Datastore{ds} },
+		PoolGKNN:   common.GKNN{NamespacedName: client.ObjectKeyFromObject(pool), GroupKind: schema.GroupKind{Group: v1alpha2.GroupName, Kind: "InferencePool"}},
+		Interval:   time.Second,
+	}
+	writer.Sync(ctx)
+
+	got := &v1alpha2.InferencePool{}
+	if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(pool), got); err != nil {
+		t.Fatalf("Unexpected error getting pool: %v", err)
+	}
+	if len(got.Status.Parents) != 1 {
+		t.Fatalf("Expected the Endpoint Picker parent, got %+v", got.Status.Parents)
+	}
+	parent := got.Status.Parents[0]
+	if parent.GatewayRef.Kind == nil || *parent.GatewayRef.Kind != "Service" || parent.GatewayRef.Name != "epp" {
+		t.Errorf("Unexpected Endpoint Picker parent %+v", parent.GatewayRef)
+	}
+	c := meta.FindStatusCondition(parent.Conditions, string(EndpointPickerConditionReady))
+	if c == nil || c.Status != metav1.ConditionFalse || c.Message != "0 of 0 endpoints ready, 0 draining" {
+		t.Errorf("Unexpected ready condition %+v", c)
+	}
+}
@@ -22,8 +22,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
 
@@ -31,8 +33,7 @@ import (
 // currently the data store is expected to manage the state of multiple
 // Collectors (e.g., using sync.Map mapping pod to its Collector). Alternatively,
 // this can be encapsulated in this file, providing the data store with an interface
-// to only update on endpoint addition/change and deletion. This can also be used
-// to centrally track statistics such errors, active routines, etc.
+// to only update on endpoint addition/change and deletion.
 
 const (
 	defaultCollectionTimeout = time.Second
@@ -73,12 +74,26 @@ type Collector struct {
 	startOnce sync.Once
 	stopOnce  sync.Once
 
-	// TODO: optional metrics tracking collection (e.g., errors, invocations, ...)
+	// cancels the collection scheduled on a worker pool, if any
+	unschedule func()
+
+	// collection health tracking
+	policy HealthPolicy
+	now    func() time.Time
 }
 
-// NewCollector returns a new collector.
+// NewCollector returns a new collector, tracking endpoint health with the default policy.
 func NewCollector() *Collector {
-	return &Collector{}
+	return &Collector{
+		policy: DefaultHealthPolicy(),
+		now:    time.Now,
+	}
+}
+
+// WithHealthPolicy sets the policy used to track the health of the endpoint.
+func (c *Collector) WithHealthPolicy(policy HealthPolicy) *Collector {
+	c.policy = policy
+	return c
 }
 
 // Start initiates data source collection for the endpoint.
@@ -110,9 +125,7 @@ func (c *Collector) Start(ctx context.Context, ticker Ticker, ep Endpoint, sourc
 				case <-ticker.Channel():
 					// TODO: do not collect if there's no pool specified?
 					for _, src := range sources {
-						ctx, cancel := context.WithTimeout(c.ctx, defaultCollectionTimeout)
-						_ = src.Collect(ctx, endpoint) // TODO: track errors per collector?
-						cancel()                       // release the ctx timeout resources
+						c.collect(logger, endpoint, src)
 					}
 				}
 			}
@@ -139,6 +152,70 @@ func (c *Collector) Start(ctx context.Context, ticker Ticker, ep Endpoint, sourc
 	}
 }
 
+// Schedule initiates data source collection for the endpoint every interval, running
+// on the shared worker pool rather than on a goroutine of its own.
+func (c *Collector) Schedule(ctx context.Context, pool *WorkerPool, interval time.Duration, ep Endpoint, sources []DataSource) error {
+	started := false
+
+	c.startOnce.Do(func() {
+		logger := log.FromContext(ctx).WithValues("endpoint", ep.GetPod().GetIPAddress())
+		c.ctx, c.cancel = context.WithCancel(ctx)
+		started = true
+
+		logger.V(logging.DEFAULT).Info("scheduling collection", "interval", interval)
+		c.unschedule = pool.Schedule(c.ctx, interval, func(context.Context) {
+			for _, src := range sources {
+				c.collect(logger, ep, src)
+			}
+		})
+	})
+
+	if !started {
+		return errors.New("collector start called multiple times")
+	}
+	return nil
+}
+
+// collect runs a single collection from the data source, unless it is backing off
+// after failures, and records the result in the endpoint health.
+func (c *Collector) collect(logger logr.Logger, ep Endpoint, src DataSource) {
+	start := c.now()
+	if !c.policy.ShouldCollect(ep.GetHealth(), src.Name(), start) {
+		return
+	}
+
+	timeout := defaultCollectionTimeout
+	if timed, ok := src.(interface{ CollectionTimeout() time.Duration }); ok {
+		timeout = timed.CollectionTimeout() // e.g., health probes waiting for a completion
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, timeout)
+	err := src.Collect(ctx, ep)
+	cancel() // release the ctx timeout resources
+	if c.ctx.Err() != nil {
+		return // the collector was stopped, the result is irrelevant
+	}
+
+	metrics.RecordDataLayerCollectionLatency(src.Name(), c.now().Sub(start))
+	if err != nil {
+		logger.V(logging.TRACE).Info("Failed to collect data", "source", src.Name(), "err", err)
+	}
+	if !IsCollectionFailure(err) {
+		err = nil // partial results still show the endpoint is reporting
+	} else {
+		metrics.RecordDataLayerCollectionError(src.Name())
+	}
+
+	var previous, updated *Health
+	ep.UpdateHealth(func(current *Health) *Health {
+		previous, updated = current, c.policy.Record(current, src.Name(), err, start)
+		return updated
+	})
+	if previous.IsHealthy() != updated.IsHealthy() {
+		logger.V(logging.DEFAULT).Info("Endpoint health changed", "source", src.Name(),
+			"state", updated.State, "consecutiveFailures", updated.Sources[src.Name()].ConsecutiveFailures, "err", err)
+	}
+}
+
 // Stop terminates the collector.
 func (c *Collector) Stop() error {
 	if c.ctx == nil || c.cancel == nil {
@@ -149,6 +226,9 @@ func (c *Collector) Stop() error {
 	c.stopOnce.Do(func() {
 		stopped = true
 		c.cancel()
+		if c.unschedule != nil {
+			c.unschedule()
+		}
 	})
 
 	if !stopped {
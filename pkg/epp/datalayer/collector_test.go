@@ -18,6 +18,7 @@ package datalayer
 
 import (
 	"context"
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -108,6 +109,26 @@ func TestCollectorCollectsOnTicks(t *testing.T) {
 	require.NoError(t, c.Stop())
 }
 
+func TestCollectorCollectsOnWorkerPool(t *testing.T) {
+	source := &DummySource{}
+	c := NewCollector()
+	pool := NewWorkerPool(2)
+	ctx := context.Background()
+
+	require.NoError(t, c.Schedule(ctx, pool, time.Millisecond, endpoint, []DataSource{source}))
+	assert.Error(t, c.Schedule(ctx, pool, time.Millisecond, endpoint, []DataSource{source}),
+		"multiple collector start should error")
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&source.callCount) >= 2
+	}, 1*time.Second, 2*time.Millisecond, "expected periodic collections")
+
+	require.NoError(t, c.Stop())
+	time.Sleep(5 * time.Millisecond) // let a collection running on Stop complete
+	stopped := atomic.LoadInt64(&source.callCount)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt64(&source.callCount), "expected no collections after Stop")
+}
+
 func TestCollectorStopCancelsContext(t *testing.T) {
 	source := &DummySource{}
 	c := NewCollector()
@@ -126,3 +147,61 @@ func TestCollectorStopCancelsContext(t *testing.T) {
 	after := atomic.LoadInt64(&source.callCount)
 	assert.Equal(t, before, after, "call count changed after stop")
 }
+
+type FailingSource struct {
+	callCount int64
+	err       error
+}
+
+func (f *FailingSource) Name() string                   { return "test-failing-data-source" }
+func (f *FailingSource) AddExtractor(_ Extractor) error { return nil }
+func (f *FailingSource) Collect(ctx context.Context, ep Endpoint) error {
+	atomic.AddInt64(&f.callCount, 1)
+	return f.err
+}
+
+func TestCollectorTracksHealth(t *testing.T) {
+	source := &FailingSource{err: errors.New("connection refused")}
+	ep := defaultEndpoint()
+	now := time.Now()
+	var clock atomic.Pointer[time.Time]
+	clock.Store(&now)
+	c := NewCollector().WithHealthPolicy(HealthPolicy{
+		UnhealthyThreshold: 2,
+		InitialBackoff:     time.Second,
+		MaxBackoff:         time.Minute,
+	})
+	c.now = func() time.Time { return *clock.Load() }
+	ticker := mocks.NewTicker()
+
+	require.NoError(t, c.Start(context.Background(), ticker, ep, []DataSource{source}))
+	defer func() { require.NoError(t, c.Stop()) }()
+
+	ticker.Tick()
+	require.Eventually(t, func() bool {
+		return ep.GetHealth() != nil
+	}, time.Second, 2*time.Millisecond, "expected health to be recorded")
+	assert.Equal(t, Healthy, ep.GetHealth().State)
+	assert.Equal(t, 1, ep.GetHealth().Sources[source.Name()].ConsecutiveFailures)
+
+	ticker.Tick() // backing off, not collected
+	ticker.Tick()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&source.callCount), "expected no collection while backing off")
+
+	later := now.Add(time.Second)
+	clock.Store(&later)
+	ticker.Tick()
+	require.Eventually(t, func() bool {
+		return ep.GetHealth().State == Unhealthy
+	}, time.Second, 2*time.Millisecond, "expected the endpoint to become unhealthy")
+
+	partial := later.Add(2 * time.Second)
+	clock.Store(&partial)
+	source.err = &ExtractionError{Err: errors.New("metric missing")}
+	ticker.Tick()
+	require.Eventually(t, func() bool {
+		return ep.GetHealth().State == Healthy
+	}, time.Second, 2*time.Millisecond, "expected partial results to recover the endpoint")
+	assert.Equal(t, 2, ep.GetHealth().Sources[source.Name()].TotalFailures)
+}
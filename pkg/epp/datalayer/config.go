@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SourceFactoryFunc is the definition of the factory functions that are used to instantiate
+// data sources specified in a configuration.
+type SourceFactoryFunc func(name string, parameters json.RawMessage) (DataSource, error)
+
+// ExtractorFactoryFunc is the definition of the factory functions that are used to instantiate
+// extractors specified in a configuration.
+type ExtractorFactoryFunc func(name string, parameters json.RawMessage) (Extractor, error)
+
+// SourceFactories is a mapping from data source type to its factory function.
+var SourceFactories = map[string]SourceFactoryFunc{}
+
+// ExtractorFactories is a mapping from extractor type to its factory function.
+var ExtractorFactories = map[string]ExtractorFactoryFunc{}
+
+// RegisterSourceFactory is a static function that can be called to register data source factory functions.
+func RegisterSourceFactory(sourceType string, factory SourceFactoryFunc) {
+	SourceFactories[sourceType] = factory
+}
+
+// RegisterExtractorFactory is a static function that can be called to register extractor factory functions.
+func RegisterExtractorFactory(extractorType string, factory ExtractorFactoryFunc) {
+	ExtractorFactories[extractorType] = factory
+}
+
+// Config is the data layer configuration loaded from the text based configuration.
+type Config struct {
+	// Sources are the configured data sources, with their extractors already added.
+	Sources []SourceConfig
+}
+
+// SourceConfig is a configured data source along with the interval it is collected at.
+type SourceConfig struct {
+	Source DataSource
+	// Extractors are the extractors that were added to the source.
+	Extractors []Extractor
+	// RefreshInterval is the interval at which the source is collected for each endpoint.
+	// Zero means the endpoint factory's refresh interval is used.
+	RefreshInterval time.Duration
+}
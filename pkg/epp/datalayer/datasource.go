@@ -40,6 +40,16 @@ type DataSource interface {
 	Collect(ctx context.Context, ep Endpoint) error
 }
 
+// IsolatedDataSource is implemented by data sources whose collection may be slow (e.g., health
+// probes waiting for a wedged engine), and must therefore not delay the collection of other data
+// sources. An isolated data source is collected on its own, even when it shares the refresh
+// interval of other data sources.
+type IsolatedDataSource interface {
+	DataSource
+	// Isolated returns true if the data source must be collected on its own.
+	Isolated() bool
+}
+
 // Extractor transforms raw data into structured attributes.
 type Extractor interface {
 	Name() string
@@ -132,3 +142,26 @@ func ValidateExtractorType(collectorOutput, extractorInput reflect.Type) error {
 	return fmt.Errorf("extractor input type %v cannot handle data source output type %v",
 		extractorInput, collectorOutput)
 }
+
+// ExtractionError reports errors in processing data that was collected successfully
+// (e.g., a metric missing from an otherwise successful scrape). Data sources return
+// it to distinguish partial results from collection failures, which affect the
+// endpoint's health.
+type ExtractionError struct {
+	Err error
+}
+
+func (e *ExtractionError) Error() string {
+	return "extraction failed: " + e.Err.Error()
+}
+
+func (e *ExtractionError) Unwrap() error {
+	return e.Err
+}
+
+// IsCollectionFailure returns true if the error returned from a data source's Collect
+// is a collection failure, as opposed to a partial result.
+func IsCollectionFailure(err error) bool {
+	var extractionErr *ExtractionError
+	return err != nil && !errors.As(err, &extractionErr)
+}
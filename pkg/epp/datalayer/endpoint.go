@@ -33,11 +33,20 @@ type EndpointMetricsState interface {
 	UpdateMetrics(*Metrics)
 }
 
+// EndpointHealthState allows management of the collection health of the endpoint.
+// As multiple collectors may report on the same endpoint concurrently, the health is
+// updated by applying a function to its current value.
+type EndpointHealthState interface {
+	GetHealth() *Health
+	UpdateHealth(update func(current *Health) *Health)
+}
+
 // Endpoint represents an inference serving endpoint and its related attributes.
 type Endpoint interface {
 	fmt.Stringer
 	EndpointPodState
 	EndpointMetricsState
+	EndpointHealthState
 	AttributeMap
 }
 
@@ -45,6 +54,7 @@ type Endpoint interface {
 type ModelServer struct {
 	pod        atomic.Pointer[PodInfo]
 	metrics    atomic.Pointer[Metrics]
+	health     atomic.Pointer[Health]
 	attributes *Attributes
 }
 
@@ -77,6 +87,19 @@ func (srv *ModelServer) UpdateMetrics(metrics *Metrics) {
 	srv.metrics.Store(metrics)
 }
 
+func (srv *ModelServer) GetHealth() *Health {
+	return srv.health.Load()
+}
+
+func (srv *ModelServer) UpdateHealth(update func(current *Health) *Health) {
+	for {
+		current := srv.health.Load()
+		if srv.health.CompareAndSwap(current, update(current)) {
+			return
+		}
+	}
+}
+
 func (srv *ModelServer) Put(key string, value Cloneable) {
 	srv.attributes.Put(key, value)
 }
@@ -95,5 +118,6 @@ func (srv *ModelServer) Clone() *ModelServer {
 	}
 	clone.pod.Store(srv.pod.Load().Clone())
 	clone.metrics.Store(srv.metrics.Load().Clone())
+	clone.health.Store(srv.health.Load().Clone())
 	return clone
 }
@@ -18,6 +18,7 @@ package datalayer
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -51,9 +52,11 @@ type EndpointFactory interface {
 // EndpointLifecycle manages the life cycle (creation and termination) of
 // endpoints.
 type EndpointLifecycle struct {
-	sources         []DataSource  // data sources for collectors
-	collectors      sync.Map      // collectors map. key: Pod namespaced name, value: *Collector
-	refreshInterval time.Duration // metrics refresh interval
+	sources         []DataSource             // data sources for collectors
+	intervals       map[string]time.Duration // per data source refresh interval. key: data source name
+	collectors      sync.Map                 // collectors map. key: Pod namespaced name, value: []*Collector
+	refreshInterval time.Duration            // metrics refresh interval
+	pool            *WorkerPool              // runs the collections of all endpoints
 }
 
 // NewEndpointFactory returns a new endpoint for factory, managing collectors for
@@ -63,11 +66,32 @@ func NewEndpointFactory(sources []DataSource, refreshMetricsInterval time.Durati
 		sources:         sources,
 		collectors:      sync.Map{},
 		refreshInterval: refreshMetricsInterval,
+		pool:            NewWorkerPool(DefaultWorkerPoolSize),
 	}
 }
 
+// WithWorkerPool sets the worker pool running the collections of the endpoints.
+func (lc *EndpointLifecycle) WithWorkerPool(pool *WorkerPool) *EndpointLifecycle {
+	lc.pool = pool
+	return lc
+}
+
+// NewEndpointFactoryFromConfig returns a new endpoint factory for the configured data sources.
+// Sources without a refresh interval of their own are collected every refreshMetricsInterval.
+func NewEndpointFactoryFromConfig(config *Config, refreshMetricsInterval time.Duration) *EndpointLifecycle {
+	lc := NewEndpointFactory(nil, refreshMetricsInterval)
+	lc.intervals = make(map[string]time.Duration, len(config.Sources))
+	for _, sourceConfig := range config.Sources {
+		lc.sources = append(lc.sources, sourceConfig.Source)
+		if sourceConfig.RefreshInterval > 0 {
+			lc.intervals[sourceConfig.Source.Name()] = sourceConfig.RefreshInterval
+		}
+	}
+	return lc
+}
+
 // NewEndpoint implements EndpointFactory.NewEndpoint.
-// Creates a new endpoint and starts its associated collector with its own ticker.
+// Creates a new endpoint and schedules its associated collectors on the worker pool.
 // Guards against multiple concurrent calls for the same endpoint.
 func (lc *EndpointLifecycle) NewEndpoint(parent context.Context, inpod *PodInfo, _ PoolInfo) Endpoint {
 	key := types.NamespacedName{Namespace: inpod.GetNamespacedName().Namespace, Name: inpod.GetNamespacedName().Name}
@@ -80,40 +104,82 @@ func (lc *EndpointLifecycle) NewEndpoint(parent context.Context, inpod *PodInfo,
 
 	endpoint := NewEndpoint()
 	endpoint.UpdatePod(inpod)
-	collector := NewCollector() // for full backward compatibility, set the logger and poolinfo
 
-	if _, loaded := lc.collectors.LoadOrStore(key, collector); loaded {
+	// sources that share a refresh interval are collected by the same collector, unless isolated.
+	groups := lc.sourcesByInterval()
+	collectors := make([]*Collector, len(groups))
+	for i := range groups {
+		collectors[i] = NewCollector() // for full backward compatibility, set the logger and poolinfo
+	}
+
+	if _, loaded := lc.collectors.LoadOrStore(key, collectors); loaded {
 		// another goroutine already created and stored a collector for this endpoint.
 		// No need to start the new collector.
 		logger.Info("collector already running for endpoint", "endpoint", key)
 		return nil
 	}
 
-	ticker := NewTimeTicker(lc.refreshInterval)
-	if err := collector.Start(parent, ticker, endpoint, lc.sources); err != nil {
-		logger.Error(err, "failed to start collector for endpoint", "endpoint", key)
-		lc.collectors.Delete(key)
+	for i, group := range groups {
+		if err := collectors[i].Schedule(parent, lc.pool, group.interval, endpoint, group.sources); err != nil {
+			logger.Error(err, "failed to start collector for endpoint", "endpoint", key)
+			lc.ReleaseEndpoint(endpoint)
+			break
+		}
 	}
 
 	return endpoint
 }
 
+type sourceGroup struct {
+	interval time.Duration
+	sources  []DataSource
+	isolated bool // the group holds a single isolated data source
+}
+
+// sourcesByInterval groups the data sources by their refresh interval, keeping the sources' order.
+// Isolated data sources are put in groups of their own.
+func (lc *EndpointLifecycle) sourcesByInterval() []sourceGroup {
+	groups := []sourceGroup{{interval: lc.refreshInterval, sources: []DataSource{}}}
+	for _, src := range lc.sources {
+		interval, ok := lc.intervals[src.Name()]
+		if !ok {
+			interval = lc.refreshInterval
+		}
+		if isolated, ok := src.(IsolatedDataSource); ok && isolated.Isolated() {
+			groups = append(groups, sourceGroup{interval: interval, sources: []DataSource{src}, isolated: true})
+			continue
+		}
+		idx := slices.IndexFunc(groups, func(group sourceGroup) bool { return !group.isolated && group.interval == interval })
+		if idx < 0 {
+			groups = append(groups, sourceGroup{interval: interval})
+			idx = len(groups) - 1
+		}
+		groups[idx].sources = append(groups[idx].sources, src)
+	}
+	if len(groups[0].sources) == 0 && len(groups) > 1 {
+		groups = groups[1:] // no source uses the default refresh interval
+	}
+	return groups
+}
+
 // ReleaseEndpoint implements EndpointFactory.ReleaseEndpoint
 // Stops the collector and cleans up resources for the endpoint
 func (lc *EndpointLifecycle) ReleaseEndpoint(ep Endpoint) {
 	key := ep.GetPod().GetNamespacedName()
 
 	if value, ok := lc.collectors.LoadAndDelete(key); ok {
-		collector := value.(*Collector)
-		_ = collector.Stop()
+		for _, collector := range value.([]*Collector) {
+			_ = collector.Stop()
+		}
 	}
 }
 
 // Shutdown gracefully stops all collectors and cleans up all resources.
 func (lc *EndpointLifecycle) Shutdown() {
 	lc.collectors.Range(func(key, value any) bool {
-		collector := value.(*Collector)
-		_ = collector.Stop()
+		for _, collector := range value.([]*Collector) {
+			_ = collector.Stop()
+		}
 		lc.collectors.Delete(key)
 		return true
 	})
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourcesByInterval(t *testing.T) {
+	fast := &mockDataSource{name: "fast"}
+	slow := &mockDataSource{name: "slow"}
+	other := &mockDataSource{name: "other"}
+
+	lc := NewEndpointFactoryFromConfig(&Config{Sources: []SourceConfig{
+		{Source: fast, RefreshInterval: 10 * time.Millisecond},
+		{Source: slow, RefreshInterval: time.Second},
+		{Source: other},
+	}}, 50*time.Millisecond)
+	assert.Equal(t, []sourceGroup{
+		{interval: 50 * time.Millisecond, sources: []DataSource{other}},
+		{interval: 10 * time.Millisecond, sources: []DataSource{fast}},
+		{interval: time.Second, sources: []DataSource{slow}},
+	}, lc.sourcesByInterval())
+
+	// sources without their own interval share a single group
+	lc = NewEndpointFactory([]DataSource{fast, other}, 50*time.Millisecond)
+	assert.Equal(t, []sourceGroup{
+		{interval: 50 * time.Millisecond, sources: []DataSource{fast, other}},
+	}, lc.sourcesByInterval())
+
+	// no group is created for the default interval when all sources have their own
+	lc = NewEndpointFactoryFromConfig(&Config{Sources: []SourceConfig{
+		{Source: slow, RefreshInterval: time.Second},
+	}}, 50*time.Millisecond)
+	assert.Equal(t, []sourceGroup{
+		{interval: time.Second, sources: []DataSource{slow}},
+	}, lc.sourcesByInterval())
+
+	// isolated sources are collected on their own, even when they share the interval of other sources
+	probe := &isolatedDataSource{mockDataSource{name: "probe"}}
+	lc = NewEndpointFactory([]DataSource{fast, probe, other}, 50*time.Millisecond)
+	assert.Equal(t, []sourceGroup{
+		{interval: 50 * time.Millisecond, sources: []DataSource{fast, other}},
+		{interval: 50 * time.Millisecond, sources: []DataSource{probe}, isolated: true},
+	}, lc.sourcesByInterval())
+}
+
+// isolatedDataSource is a data source that must be collected on its own.
+type isolatedDataSource struct {
+	mockDataSource
+}
+
+func (s *isolatedDataSource) Isolated() bool { return true }
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+const (
+	// DefaultUnhealthyThreshold is the number of consecutive collection failures
+	// of a data source after which the endpoint is considered unhealthy.
+	DefaultUnhealthyThreshold = 3
+	// DefaultInitialBackoff is the delay before retrying a failed collection.
+	DefaultInitialBackoff = 100 * time.Millisecond
+	// DefaultMaxBackoff bounds the exponentially growing delay between failed collections.
+	DefaultMaxBackoff = 5 * time.Second
+)
+
+// HealthState is the state of an endpoint, as observed by data collection.
+type HealthState int
+
+const (
+	// HealthUnknown is the state of an endpoint before any collection completed.
+	HealthUnknown HealthState = iota
+	// Healthy endpoints are reporting successfully.
+	Healthy
+	// Unhealthy endpoints stopped reporting: at least one of their data sources
+	// failed UnhealthyThreshold consecutive times.
+	Unhealthy
+)
+
+func (s HealthState) String() string {
+	switch s {
+	case Healthy:
+		return "Healthy"
+	case Unhealthy:
+		return "Unhealthy"
+	default:
+		return "Unknown"
+	}
+}
+
+// SourceHealth tracks the collection results of a single data source for an endpoint.
+type SourceHealth struct {
+	ConsecutiveFailures int
+	TotalFailures       int
+	LastError           string
+	LastSuccess         time.Time
+	LastFailure         time.Time
+	// NextAttempt is the earliest time the next collection is attempted, when backing off.
+	NextAttempt time.Time
+}
+
+// Health is the collection health of an endpoint.
+type Health struct {
+	State   HealthState
+	Sources map[string]SourceHealth // key: data source name
+}
+
+// NewHealth returns a new Health in the HealthUnknown state.
+func NewHealth() *Health {
+	return &Health{
+		Sources: map[string]SourceHealth{},
+	}
+}
+
+// String returns a string with the endpoint health information.
+func (h *Health) String() string {
+	if h == nil {
+		return ""
+	}
+	return fmt.Sprintf("%+v", *h)
+}
+
+// GetState returns the health state, or HealthUnknown if the health is nil.
+func (h *Health) GetState() HealthState {
+	if h == nil {
+		return HealthUnknown
+	}
+	return h.State
+}
+
+// IsHealthy returns false only for endpoints known to be unhealthy. Endpoints without
+// health information (e.g., newly added ones) are considered healthy.
+func (h *Health) IsHealthy() bool {
+	return h.GetState() != Unhealthy
+}
+
+// Clone creates a copy of Health and returns its pointer.
+// Clone returns nil if the object being cloned is nil.
+func (h *Health) Clone() *Health {
+	if h == nil {
+		return nil
+	}
+	return &Health{
+		State:   h.State,
+		Sources: maps.Clone(h.Sources),
+	}
+}
+
+// HealthPolicy defines how collection results translate into endpoint health and backoff.
+type HealthPolicy struct {
+	// UnhealthyThreshold is the number of consecutive failures of a data source
+	// after which the endpoint is considered unhealthy.
+	UnhealthyThreshold int
+	// InitialBackoff is the delay before retrying a failed collection. It doubles
+	// with every consecutive failure, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultHealthPolicy returns the default HealthPolicy.
+func DefaultHealthPolicy() HealthPolicy {
+	return HealthPolicy{
+		UnhealthyThreshold: DefaultUnhealthyThreshold,
+		InitialBackoff:     DefaultInitialBackoff,
+		MaxBackoff:         DefaultMaxBackoff,
+	}
+}
+
+// ShouldCollect returns true if the source is not backing off at the given time.
+func (p HealthPolicy) ShouldCollect(current *Health, source string, now time.Time) bool {
+	if current == nil {
+		return true
+	}
+	return !now.Before(current.Sources[source].NextAttempt)
+}
+
+// Record returns a copy of the current health (which may be nil), updated with the
+// result of a collection from the named source.
+func (p HealthPolicy) Record(current *Health, source string, err error, now time.Time) *Health {
+	updated := current.Clone()
+	if updated == nil {
+		updated = NewHealth()
+	}
+	if updated.Sources == nil {
+		updated.Sources = map[string]SourceHealth{}
+	}
+
+	sourceHealth := updated.Sources[source]
+	if err == nil {
+		sourceHealth.ConsecutiveFailures = 0
+		sourceHealth.LastSuccess = now
+		sourceHealth.NextAttempt = time.Time{}
+	} else {
+		sourceHealth.ConsecutiveFailures++
+		sourceHealth.TotalFailures++
+		sourceHealth.LastError = err.Error()
+		sourceHealth.LastFailure = now
+		sourceHealth.NextAttempt = now.Add(p.backoff(sourceHealth.ConsecutiveFailures))
+	}
+	updated.Sources[source] = sourceHealth
+
+	updated.State = Healthy
+	for _, sh := range updated.Sources {
+		if sh.ConsecutiveFailures >= p.UnhealthyThreshold {
+			updated.State = Unhealthy
+			break
+		}
+	}
+	return updated
+}
+
+// backoff returns the delay after the given number of consecutive failures.
+func (p HealthPolicy) backoff(failures int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < failures && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, p.MaxBackoff)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthPolicyRecord(t *testing.T) {
+	policy := HealthPolicy{
+		UnhealthyThreshold: 3,
+		InitialBackoff:     100 * time.Millisecond,
+		MaxBackoff:         time.Second,
+	}
+	now := time.Now()
+	failure := errors.New("connection refused")
+
+	var health *Health
+	assert.True(t, health.IsHealthy(), "unknown health should be considered healthy")
+	assert.True(t, policy.ShouldCollect(health, "metrics", now))
+
+	wantBackoffs := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, time.Second, time.Second}
+	for i, wantBackoff := range wantBackoffs {
+		health = policy.Record(health, "metrics", failure, now)
+		sourceHealth := health.Sources["metrics"]
+		assert.Equal(t, i+1, sourceHealth.ConsecutiveFailures)
+		assert.Equal(t, "connection refused", sourceHealth.LastError)
+		assert.Equal(t, now.Add(wantBackoff), sourceHealth.NextAttempt, "backoff after %d failures", i+1)
+		assert.False(t, policy.ShouldCollect(health, "metrics", now))
+		assert.True(t, policy.ShouldCollect(health, "metrics", sourceHealth.NextAttempt))
+		assert.True(t, policy.ShouldCollect(health, "models", now), "other sources are not backing off")
+		if i+1 < policy.UnhealthyThreshold {
+			assert.Equal(t, Healthy, health.State)
+		} else {
+			assert.Equal(t, Unhealthy, health.State)
+		}
+	}
+
+	// a healthy source does not hide the failing one
+	health = policy.Record(health, "models", nil, now)
+	assert.Equal(t, Unhealthy, health.State)
+
+	previous := health
+	health = policy.Record(health, "metrics", nil, now)
+	assert.Equal(t, Healthy, health.State)
+	assert.Equal(t, 0, health.Sources["metrics"].ConsecutiveFailures)
+	assert.Equal(t, len(wantBackoffs), health.Sources["metrics"].TotalFailures)
+	assert.Equal(t, now, health.Sources["metrics"].LastSuccess)
+	assert.Equal(t, Unhealthy, previous.State, "Record must not modify the current health")
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	DataSourceName = "health-check-data-source"
+
+	// HealthCheckKey is the endpoint attribute holding the latest health check Result.
+	HealthCheckKey = "HealthCheck"
+
+	// maxResponseBytes bounds how much of a probe response is read.
+	maxResponseBytes = 64 << 10
+)
+
+// Result is the outcome of a single health check probe.
+type Result struct {
+	Healthy    bool
+	StatusCode int
+	Latency    time.Duration
+	Error      string
+	Time       time.Time
+}
+
+// Clone returns a copy of the result.
+func (r *Result) Clone() datalayer.Cloneable {
+	if r == nil {
+		return nil
+	}
+	clone := *r
+	return &clone
+}
+
+// DataSource actively probes a health endpoint (e.g., /health, or a tiny completion request)
+// on the serving port of every endpoint. A pod may be ready from Kubernetes' point of view
+// while its engine is wedged; probing catches engines that accept connections but do not respond.
+// The probe result is stored on the endpoint as an attribute, and failed probes are reported
+// as collection failures, marking the endpoint unhealthy after consecutive failures. Probes are
+// collected on their own, so that a slow probe does not delay the collection of other data sources.
+type DataSource struct {
+	name    string
+	scheme  string
+	path    string
+	method  string
+	body    []byte
+	timeout time.Duration
+	client  *http.Client
+}
+
+// NewDataSource returns a new health check data source, sending the given method and (optional) body
+// to the path on the endpoints' serving port. Probes that do not respond within the timeout fail.
+func NewDataSource(scheme, path, method string, body []byte, timeout time.Duration, skipCertVerification bool) *DataSource {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if scheme == "https" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: skipCertVerification,
+		}
+	}
+	return &DataSource{
+		name:    DataSourceName,
+		scheme:  scheme,
+		path:    path,
+		method:  method,
+		body:    body,
+		timeout: timeout,
+		client:  &http.Client{Transport: transport},
+	}
+}
+
+// Name returns the health check data source name.
+func (dataSrc *DataSource) Name() string {
+	return dataSrc.name
+}
+
+// WithName sets the name of the health check data source.
+func (dataSrc *DataSource) WithName(name string) *DataSource {
+	dataSrc.name = name
+	return dataSrc
+}
+
+// Isolated returns true, so that slow probes do not delay the collection of other data sources.
+func (dataSrc *DataSource) Isolated() bool {
+	return true
+}
+
+// CollectionTimeout returns the probe timeout, which bounds the collection of the data source.
+func (dataSrc *DataSource) CollectionTimeout() time.Duration {
+	return dataSrc.timeout
+}
+
+// Produces returns the data stored on the endpoints by the health check data source.
+func (dataSrc *DataSource) Produces() map[string]any {
+	return map[string]any{HealthCheckKey: &Result{}}
+}
+
+// AddExtractor is not supported, probe results are stored on the endpoints directly.
+func (dataSrc *DataSource) AddExtractor(extractor datalayer.Extractor) error {
+	return fmt.Errorf("unable to add extractor %s to %s, health check data sources do not support extractors", extractor.Name(), dataSrc.Name())
+}
+
+// Collect probes the endpoint and stores the result on it. An error is returned if the probe failed.
+func (dataSrc *DataSource) Collect(ctx context.Context, ep datalayer.Endpoint) error {
+	result := dataSrc.probe(ctx, ep.GetPod())
+	ep.Put(HealthCheckKey, result)
+	if !result.Healthy {
+		return errors.New("health check failed: " + result.Error)
+	}
+	return nil
+}
+
+func (dataSrc *DataSource) probe(ctx context.Context, pod *datalayer.PodInfo) *Result {
+	ctx, cancel := context.WithTimeout(ctx, dataSrc.timeout)
+	defer cancel()
+
+	start := time.Now()
+	result := &Result{Time: start}
+	target := url.URL{
+		Scheme: dataSrc.scheme,
+		Host:   net.JoinHostPort(pod.GetIPAddress(), pod.GetPort()),
+		Path:   dataSrc.path,
+	}
+	req, err := http.NewRequestWithContext(ctx, dataSrc.method, target.String(), bytes.NewReader(dataSrc.body))
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if len(dataSrc.body) != 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := dataSrc.client.Do(req)
+	if err == nil {
+		// read the response, a wedged engine may send the headers and hang while generating
+		_, err = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+		_ = resp.Body.Close()
+	}
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.StatusCode = resp.StatusCode
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		result.Error = fmt.Sprintf("unexpected status code %d", resp.StatusCode)
+		return result
+	}
+	result.Healthy = true
+	return result
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+func newEndpoint(t *testing.T, serverURL string) datalayer.Endpoint {
+	req, err := http.NewRequest(http.MethodGet, serverURL, nil)
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(req.URL.Host)
+	require.NoError(t, err)
+
+	ep := datalayer.NewEndpoint()
+	ep.UpdatePod(&datalayer.PodInfo{Address: host, Port: port})
+	return ep
+}
+
+func getResult(t *testing.T, ep datalayer.Endpoint) *Result {
+	value, ok := ep.Get(HealthCheckKey)
+	require.True(t, ok, "expected a health check result")
+	result, ok := value.(*Result)
+	require.True(t, ok, "unexpected health check result type %T", value)
+	return result
+}
+
+func TestCollect(t *testing.T) {
+	var mu sync.Mutex
+	var gotMethod, gotPath, gotBody string
+	status := http.StatusOK
+	hang := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/hang" {
+			<-hang
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		gotMethod, gotPath, gotBody = r.Method, r.URL.Path, string(body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	defer close(hang)
+	ep := newEndpoint(t, server.URL)
+	ctx := context.Background()
+
+	healthy := NewDataSource("http", DefaultPath, DefaultMethod, nil, time.Second, true)
+	require.NoError(t, healthy.Collect(ctx, ep))
+	result := getResult(t, ep)
+	assert.True(t, result.Healthy)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Positive(t, result.Latency)
+	mu.Lock()
+	assert.Equal(t, http.MethodGet, gotMethod)
+	assert.Equal(t, DefaultPath, gotPath)
+	mu.Unlock()
+
+	completion := NewDataSource("http", "/v1/completions", http.MethodPost,
+		[]byte(`{"model":"m","prompt":"hi","max_tokens":1}`), time.Second, true)
+	require.NoError(t, completion.Collect(ctx, ep))
+	mu.Lock()
+	assert.Equal(t, http.MethodPost, gotMethod)
+	assert.Equal(t, `{"model":"m","prompt":"hi","max_tokens":1}`, gotBody)
+	status = http.StatusServiceUnavailable
+	mu.Unlock()
+	assert.Error(t, healthy.Collect(ctx, ep))
+	result = getResult(t, ep)
+	assert.False(t, result.Healthy)
+	assert.Equal(t, http.StatusServiceUnavailable, result.StatusCode)
+
+	hanging := NewDataSource("http", "/hang", DefaultMethod, nil, 50*time.Millisecond, true)
+	assert.Error(t, hanging.Collect(ctx, ep))
+	result = getResult(t, ep)
+	assert.False(t, result.Healthy)
+	assert.NotEmpty(t, result.Error)
+}
+
+func TestDataSourceFactory(t *testing.T) {
+	source, err := DataSourceFactory("probe", nil)
+	require.NoError(t, err)
+	probe := source.(*DataSource)
+	assert.Equal(t, "probe", probe.Name())
+	assert.Equal(t, DefaultPath, probe.path)
+	assert.Equal(t, DefaultMethod, probe.method)
+	assert.Equal(t, DefaultTimeout, probe.CollectionTimeout())
+
+	source, err = DataSourceFactory("probe", json.RawMessage(`{"path":"/v1/completions","method":"post","body":{"prompt":"hi"},"timeout":"3s"}`))
+	require.NoError(t, err)
+	probe = source.(*DataSource)
+	assert.Equal(t, http.MethodPost, probe.method)
+	assert.JSONEq(t, `{"prompt":"hi"}`, string(probe.body))
+	assert.Equal(t, 3*time.Second, probe.CollectionTimeout())
+
+	for _, params := range []string{
+		`{"scheme":"tcp"}`,
+		`{"method":"DELETE"}`,
+		`{"timeout":"never"}`,
+	} {
+		_, err := DataSourceFactory("probe", json.RawMessage(params))
+		assert.Error(t, err, params)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	// DataSourceType is the type of the health check data source in the configuration.
+	DataSourceType = DataSourceName
+
+	DefaultScheme  = "http"
+	DefaultPath    = "/health"
+	DefaultMethod  = http.MethodGet
+	DefaultTimeout = time.Second
+)
+
+// compile-time type validation
+var (
+	_ datalayer.SourceFactoryFunc  = DataSourceFactory
+	_ datalayer.IsolatedDataSource = &DataSource{}
+)
+
+type dataSourceParameters struct {
+	Scheme             string          `json:"scheme"`
+	Path               string          `json:"path"`
+	Method             string          `json:"method"`
+	Body               json.RawMessage `json:"body"`
+	Timeout            string          `json:"timeout"`
+	InsecureSkipVerify bool            `json:"insecureSkipVerify"`
+}
+
+// DataSourceFactory defines the factory function for the health check DataSource.
+func DataSourceFactory(name string, rawParameters json.RawMessage) (datalayer.DataSource, error) {
+	parameters := dataSourceParameters{
+		Scheme:             DefaultScheme,
+		Path:               DefaultPath,
+		Method:             DefaultMethod,
+		InsecureSkipVerify: true,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' data source - %w", DataSourceType, err)
+		}
+	}
+	if parameters.Scheme != "http" && parameters.Scheme != "https" {
+		return nil, fmt.Errorf("unexpected scheme '%s' for the '%s' data source, it can only be 'http' or 'https'", parameters.Scheme, DataSourceType)
+	}
+	parameters.Method = strings.ToUpper(parameters.Method)
+	if parameters.Method != http.MethodGet && parameters.Method != http.MethodPost {
+		return nil, fmt.Errorf("unexpected method '%s' for the '%s' data source, it can only be 'GET' or 'POST'", parameters.Method, DataSourceType)
+	}
+
+	timeout := DefaultTimeout
+	if parameters.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(parameters.Timeout)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid timeout '%s' for the '%s' data source", parameters.Timeout, DataSourceType)
+		}
+	}
+
+	return NewDataSource(parameters.Scheme, parameters.Path, parameters.Method, parameters.Body, timeout,
+		parameters.InsecureSkipVerify).WithName(name), nil
+}
@@ -18,6 +18,7 @@ package datalayer
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -54,6 +55,16 @@ func (m *Metrics) String() string {
 	return fmt.Sprintf("%+v", *m)
 }
 
+// KVCacheFreeTokens returns the estimated number of tokens that can still be stored in the KV cache.
+// The second return value is false if the KV cache token capacity is unknown.
+func (m *Metrics) KVCacheFreeTokens() (int, bool) {
+	if m == nil || m.KvCacheMaxTokenCapacity <= 0 {
+		return 0, false
+	}
+	usage := min(max(m.KVCacheUsagePercent, 0), 1)
+	return int(math.Round(float64(m.KvCacheMaxTokenCapacity) * (1 - usage))), true
+}
+
 // Clone creates a copy of Metrics and returns its pointer.
 // Clone returns nil if the object being cloned is nil.
 func (m *Metrics) Clone() *Metrics {
@@ -18,20 +18,29 @@ package metrics
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
 
-	"github.com/prometheus/common/expfmt"
-	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 )
 
 // Client is an interface for retrieving the metrics from an endpoint URL.
 type Client interface {
-	Get(ctx context.Context, target *url.URL, ep datalayer.Addressable) (PrometheusMetricMap, error)
+	Get(ctx context.Context, target *url.URL, ep datalayer.Addressable, opts ScrapeOptions) (PrometheusMetricMap, error)
+}
+
+// ScrapeOptions control how the metrics are requested from an endpoint and parsed.
+type ScrapeOptions struct {
+	// Format is the requested exposition format. The text format is used if empty.
+	Format Format
+	// Families are the metric families to parse, the others are skipped. All families are parsed if nil.
+	Families sets.Set[string]
 }
 
 const (
@@ -47,6 +56,8 @@ const (
 	// and updating might take some time). This allows maintaining up to two idle connections
 	// per endpoint (defined as scheme://host:port).
 	maxIdleConnsPerHost = 2
+	// bounds the unread response body drained before closing it, allowing the connection's reuse.
+	maxDrainBytes = 64 * 1024
 )
 
 var (
@@ -67,27 +78,44 @@ type client struct {
 	http.Client
 }
 
-func (cl *client) Get(ctx context.Context, target *url.URL, ep datalayer.Addressable) (PrometheusMetricMap, error) {
+// NewHTTPClient returns an HTTP client for scraping the metrics of many endpoints, keeping
+// idle connections to each endpoint for reuse by the following scrapes.
+func NewHTTPClient(scheme string, skipCertVerification bool) *http.Client {
+	transport := baseTransport.Clone()
+	if scheme == "https" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: skipCertVerification,
+		}
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+func (cl *client) Get(ctx context.Context, target *url.URL, ep datalayer.Addressable, opts ScrapeOptions) (PrometheusMetricMap, error) {
+	return Scrape(ctx, &cl.Client, target, ep, opts)
+}
+
+// Scrape gets the metrics of the endpoint from the target URL using the HTTP client.
+func Scrape(ctx context.Context, httpClient *http.Client, target *url.URL, ep datalayer.Addressable, opts ScrapeOptions) (PrometheusMetricMap, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
-	resp, err := defaultClient.Do(req)
+	req.Header.Set("Accept", opts.Format.AcceptHeader())
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch metrics from %s: %w", ep.GetNamespacedName(), err)
 	}
 	defer func() {
+		// drain the unread body, so the connection can be reused by the next scrape.
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
 		_ = resp.Body.Close()
 	}()
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("unexpected status code from %s: %v", ep.GetNamespacedName(), resp.StatusCode)
 	}
-
-	parser := expfmt.NewTextParser(model.LegacyValidation)
-	metricFamilies, err := parser.TextToMetricFamilies(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return metricFamilies, err
+	return DecodeFamilies(resp, opts.Families)
 }
@@ -23,6 +23,9 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 )
@@ -34,11 +37,22 @@ const (
 // DataSource is a Model Server Protocol (MSP) compliant metrics data source,
 // returning Prometheus formatted metrics for an endpoint.
 type DataSource struct {
+	name          string
 	metricsScheme string // scheme to use in metrics URL
 	metricsPath   string // path to use in metrics URL
+	format        Format // exposition format requested from the endpoints
 
 	client     Client   // client (e.g. a wrapped http.Client) used to get metrics
 	extractors sync.Map // key: name, value: extractor
+	// the metric families read by the extractors, nil if any extractor may read all families.
+	families atomic.Pointer[sets.Set[string]]
+}
+
+// FamiliesConsumer is implemented by extractors reading only some of the metric families,
+// allowing the data source to skip parsing the others.
+type FamiliesConsumer interface {
+	// MetricFamilies returns the names of the metric families read, or nil if any family may be read.
+	MetricFamilies() sets.Set[string]
 }
 
 // NewDataSource returns a new MSP compliant metrics data source, configured with
@@ -59,16 +73,30 @@ func NewDataSource(metricsScheme string, metricsPath string, skipCertVerificatio
 	}
 
 	dataSrc := &DataSource{
+		name:          DataSourceName,
 		metricsScheme: metricsScheme,
 		metricsPath:   metricsPath,
+		format:        FormatText,
 		client:        cl,
 	}
 	return dataSrc
 }
 
+// WithFormat sets the exposition format requested from the endpoints.
+func (dataSrc *DataSource) WithFormat(format Format) *DataSource {
+	dataSrc.format = format
+	return dataSrc
+}
+
 // Name returns the metrics data source name.
 func (dataSrc *DataSource) Name() string {
-	return DataSourceName
+	return dataSrc.name
+}
+
+// WithName sets the name of the metrics data source.
+func (dataSrc *DataSource) WithName(name string) *DataSource {
+	dataSrc.name = name
+	return dataSrc
 }
 
 // AddExtractor adds an extractor to the data source, validating it can process
@@ -80,14 +108,44 @@ func (dataSrc *DataSource) AddExtractor(extractor datalayer.Extractor) error {
 	if _, loaded := dataSrc.extractors.LoadOrStore(extractor.Name(), extractor); loaded {
 		return fmt.Errorf("attempt to add extractor with duplicate name %s to %s", extractor.Name(), dataSrc.Name())
 	}
+	dataSrc.updateFamilies()
 	return nil
 }
 
+// updateFamilies collects the metric families read by the extractors.
+func (dataSrc *DataSource) updateFamilies() {
+	families := sets.New[string]()
+	all := false
+	dataSrc.extractors.Range(func(_, val any) bool {
+		consumer, ok := val.(FamiliesConsumer)
+		if !ok || consumer.MetricFamilies() == nil {
+			all = true
+			return false // no need to look further
+		}
+		families = families.Union(consumer.MetricFamilies())
+		return true
+	})
+	if all {
+		dataSrc.families.Store(nil)
+	} else {
+		dataSrc.families.Store(&families)
+	}
+}
+
+// scrapeOptions returns the options of the endpoints' scrapes.
+func (dataSrc *DataSource) scrapeOptions() ScrapeOptions {
+	opts := ScrapeOptions{Format: dataSrc.format}
+	if families := dataSrc.families.Load(); families != nil {
+		opts.Families = *families
+	}
+	return opts
+}
+
 // Collect is triggered by the data layer framework to fetch potentially new
 // MSP metrics data for an endpoint.
 func (dataSrc *DataSource) Collect(ctx context.Context, ep datalayer.Endpoint) error {
 	target := dataSrc.getMetricsEndpoint(ep.GetPod())
-	families, err := dataSrc.client.Get(ctx, target, ep.GetPod())
+	families, err := dataSrc.client.Get(ctx, target, ep.GetPod(), dataSrc.scrapeOptions())
 
 	if err != nil {
 		return err
@@ -104,7 +162,7 @@ func (dataSrc *DataSource) Collect(ctx context.Context, ep datalayer.Endpoint) e
 	})
 
 	if len(errs) != 0 {
-		return errors.Join(errs...)
+		return &datalayer.ExtractionError{Err: errors.Join(errs...)}
 	}
 	return nil
 }
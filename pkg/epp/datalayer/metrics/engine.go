@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	// EngineKey is the key of the pod label, or annotation, that names the engine of a model server.
+	// The engine selects the metric mapping profile used to interpret the endpoint's metrics. When both
+	// the label and the annotation are set, the label takes precedence.
+	EngineKey = "inference.networking.k8s.io/engine"
+
+	EngineVLLM   = "vllm"
+	EngineSGLang = "sglang"
+	EngineTGI    = "tgi"
+	EngineTriton = "triton"
+)
+
+// EngineProfile bundles the metric specifications of a model server engine, in the format
+// accepted by NewMapping. An empty specification means the engine doesn't expose the metric.
+type EngineProfile struct {
+	TotalQueuedRequests string
+	KVCacheUtilization  string
+	LoraRequestInfo     string
+	CacheInfo           string
+}
+
+// EngineProfiles holds the built-in metric mapping profiles of the common model server engines.
+var EngineProfiles = map[string]EngineProfile{
+	EngineVLLM: {
+		TotalQueuedRequests: DefaultTotalQueuedRequestsMetric,
+		KVCacheUtilization:  DefaultKvCacheUsagePercentageMetric,
+		LoraRequestInfo:     DefaultLoraInfoMetric,
+		CacheInfo:           DefaultCacheInfoMetric,
+	},
+	EngineSGLang: {
+		TotalQueuedRequests: "sglang:num_queue_reqs",
+		KVCacheUtilization:  "sglang:token_usage",
+	},
+	// TGI doesn't expose the KV cache utilization.
+	EngineTGI: {
+		TotalQueuedRequests: "tgi_queue_size",
+	},
+	EngineTriton: {
+		TotalQueuedRequests: "nv_trt_llm_request_metrics{request_type=waiting}",
+		KVCacheUtilization:  "nv_trt_llm_kv_cache_block_metrics{kv_cache_block_type=fraction}",
+	},
+}
+
+// EngineOf returns the engine of the pod, taken from its EngineKey label or annotation.
+// It returns an empty string if the pod doesn't name its engine.
+func EngineOf(pod *datalayer.PodInfo) string {
+	if pod == nil {
+		return ""
+	}
+	if engine, ok := pod.Labels[EngineKey]; ok {
+		return engine
+	}
+	return pod.Annotations[EngineKey]
+}
+
+// NewEngineMappings returns a Mapping for each of the built-in engine profiles.
+func NewEngineMappings() (map[string]*Mapping, error) {
+	mappings := make(map[string]*Mapping, len(EngineProfiles))
+	for engine, profile := range EngineProfiles {
+		mapping, err := NewMapping(profile.TotalQueuedRequests, profile.KVCacheUtilization, profile.LoraRequestInfo, profile.CacheInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the metrics Mapping of engine '%s' - %w", engine, err)
+		}
+		mappings[engine] = mapping
+	}
+	return mappings, nil
+}
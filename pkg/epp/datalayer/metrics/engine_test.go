@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+func TestEngineOf(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *datalayer.PodInfo
+		want string
+	}{
+		{
+			name: "nil pod",
+			want: "",
+		},
+		{
+			name: "no engine",
+			pod:  &datalayer.PodInfo{Labels: map[string]string{"app": "model"}},
+			want: "",
+		},
+		{
+			name: "engine label",
+			pod:  &datalayer.PodInfo{Labels: map[string]string{EngineKey: EngineSGLang}},
+			want: EngineSGLang,
+		},
+		{
+			name: "engine annotation",
+			pod:  &datalayer.PodInfo{Annotations: map[string]string{EngineKey: EngineTriton}},
+			want: EngineTriton,
+		},
+		{
+			name: "label takes precedence over annotation",
+			pod: &datalayer.PodInfo{
+				Labels:      map[string]string{EngineKey: EngineTGI},
+				Annotations: map[string]string{EngineKey: EngineVLLM},
+			},
+			want: EngineTGI,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, EngineOf(test.pod))
+		})
+	}
+}
+
+func TestNewEngineMappings(t *testing.T) {
+	mappings, err := NewEngineMappings()
+	require.NoError(t, err)
+	for engine := range EngineProfiles {
+		require.Contains(t, mappings, engine)
+		assert.NotNil(t, mappings[engine].TotalQueuedRequests, "engine %s must map the queue size", engine)
+	}
+	assert.Equal(t, "nv_trt_llm_request_metrics", mappings[EngineTriton].TotalQueuedRequests.Name)
+	assert.Equal(t, map[string]string{"request_type": "waiting"}, mappings[EngineTriton].TotalQueuedRequests.Labels)
+}
+
+func TestExtractUsesEngineMapping(t *testing.T) {
+	families := PrometheusMetricMap{
+		DefaultTotalQueuedRequestsMetric: makeMetricFamily(DefaultTotalQueuedRequestsMetric, makeMetric(nil, 3, 1000)),
+		"sglang:num_queue_reqs":          makeMetricFamily("sglang:num_queue_reqs", makeMetric(nil, 7, 1000)),
+	}
+	extractor, err := NewExtractor(DefaultTotalQueuedRequestsMetric, "", "", "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   int
+	}{
+		{
+			name: "default mapping",
+			want: 3,
+		},
+		{
+			name:   "engine mapping",
+			labels: map[string]string{EngineKey: EngineSGLang},
+			want:   7,
+		},
+		{
+			name:   "unknown engine uses the default mapping",
+			labels: map[string]string{EngineKey: "unknown"},
+			want:   3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ep := datalayer.NewEndpoint()
+			ep.UpdatePod(&datalayer.PodInfo{Labels: test.labels})
+			ep.UpdateMetrics(datalayer.NewMetrics())
+
+			// the errors are of the metrics that are missing from the engine's families, if any
+			_ = extractor.Extract(context.Background(), families, ep)
+			assert.Equal(t, test.want, ep.GetMetrics().WaitingQueueSize)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Format is the exposition format requested from the model servers' metrics endpoints.
+type Format string
+
+const (
+	// FormatText is the Prometheus text exposition format.
+	FormatText Format = "text"
+	// FormatProtobuf is the Prometheus delimited protobuf exposition format. Model servers that
+	// do not support it fall back to the text format.
+	FormatProtobuf Format = "protobuf"
+
+	textAcceptHeader     = `text/plain;version=0.0.4;q=1,*/*;q=0.1`
+	protobufAcceptHeader = `application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited;q=0.7,` +
+		`text/plain;version=0.0.4;q=0.3,*/*;q=0.1`
+
+	// maxLineLength bounds the length of a line in the text exposition format.
+	maxLineLength = 1 << 20
+	// maxMessageSize bounds the size of a metric family in the protobuf exposition format.
+	maxMessageSize = 16 << 20
+	// familyNameField is the field number of the name in the MetricFamily protobuf message.
+	familyNameField = 1
+)
+
+// ParseFormat returns the exposition format named by the string, the text format if empty.
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatProtobuf:
+		return FormatProtobuf, nil
+	}
+	return "", fmt.Errorf("unexpected metrics format '%s', it can only be '%s' or '%s'", format, FormatText, FormatProtobuf)
+}
+
+// AcceptHeader returns the HTTP Accept header requesting the exposition format.
+func (f Format) AcceptHeader() string {
+	if f == FormatProtobuf {
+		return protobufAcceptHeader
+	}
+	return textAcceptHeader
+}
+
+// familySuffixes are the suffixes of the sample names of histograms, summaries and counters.
+var familySuffixes = [][]byte{[]byte("_bucket"), []byte("_sum"), []byte("_count"), []byte("_total"), []byte("_created")}
+
+// DecodeFamilies parses the metrics exposition in the response body, in the format announced
+// by the response's content type. Only the given metric families are returned, and with the
+// text format, the lines of other families are skipped before parsing them, which is most of
+// the scrape's cost as model servers expose many histograms. All families are parsed if nil.
+func DecodeFamilies(resp *http.Response, families sets.Set[string]) (PrometheusMetricMap, error) {
+	if expfmt.ResponseFormat(resp.Header).FormatType() == expfmt.TypeProtoDelim {
+		return decodeProtobuf(resp.Body, families)
+	}
+	return decodeText(resp.Body, families)
+}
+
+// decodeProtobuf parses the delimited protobuf exposition. The families not given are skipped
+// by peeking at their names, without unmarshalling them.
+func decodeProtobuf(body io.Reader, families sets.Set[string]) (PrometheusMetricMap, error) {
+	result := PrometheusMetricMap{}
+	if families == nil {
+		decoder := expfmt.NewDecoder(body, expfmt.NewFormat(expfmt.TypeProtoDelim))
+		for {
+			family := &dto.MetricFamily{}
+			if err := decoder.Decode(family); err != nil {
+				if errors.Is(err, io.EOF) {
+					return result, nil
+				}
+				return nil, err
+			}
+			result[family.GetName()] = family
+		}
+	}
+
+	buf := scanBuffers.Get().(*[]byte)
+	reader := readers.Get().(*bufio.Reader)
+	reader.Reset(body)
+	defer func() {
+		scanBuffers.Put(buf)
+		reader.Reset(nil)
+		readers.Put(reader)
+	}()
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if size > maxMessageSize {
+			return nil, fmt.Errorf("metric family message of %d bytes exceeds the maximal size", size)
+		}
+		if uint64(cap(*buf)) < size {
+			*buf = make([]byte, size)
+		}
+		msg := (*buf)[:size]
+		if _, err := io.ReadFull(reader, msg); err != nil {
+			return nil, err
+		}
+		if _, ok := families[string(messageName(msg))]; !ok {
+			continue
+		}
+		family := &dto.MetricFamily{}
+		if err := proto.Unmarshal(msg, family); err != nil {
+			return nil, err
+		}
+		result[family.GetName()] = family
+	}
+}
+
+// messageName returns the name field of a MetricFamily protobuf message, nil if not found.
+func messageName(msg []byte) []byte {
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		if n < 0 {
+			return nil
+		}
+		msg = msg[n:]
+		if num == familyNameField && typ == protowire.BytesType {
+			name, n := protowire.ConsumeBytes(msg)
+			if n < 0 {
+				return nil
+			}
+			return name
+		}
+		if n = protowire.ConsumeFieldValue(num, typ, msg); n < 0 {
+			return nil
+		}
+		msg = msg[n:]
+	}
+	return nil
+}
+
+var (
+	// scanBuffers, selectedBuffers and readers are reused across scrapes to save allocations.
+	scanBuffers = sync.Pool{
+		New: func() any {
+			buf := make([]byte, 64*1024)
+			return &buf
+		},
+	}
+	selectedBuffers = sync.Pool{
+		New: func() any {
+			return &bytes.Buffer{}
+		},
+	}
+	readers = sync.Pool{
+		New: func() any {
+			return bufio.NewReader(nil)
+		},
+	}
+)
+
+// decodeText parses the text exposition, skipping the lines of the families not given.
+func decodeText(body io.Reader, families sets.Set[string]) (PrometheusMetricMap, error) {
+	parser := expfmt.NewTextParser(model.LegacyValidation)
+	if families == nil {
+		return parser.TextToMetricFamilies(body)
+	}
+
+	buf := scanBuffers.Get().(*[]byte)
+	selected := selectedBuffers.Get().(*bytes.Buffer)
+	defer func() {
+		scanBuffers.Put(buf)
+		selected.Reset()
+		selectedBuffers.Put(selected)
+	}()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(*buf, maxLineLength)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if name := familyName(line); name != nil && wanted(families, name) {
+			selected.Write(line)
+			selected.WriteByte('\n')
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return parser.TextToMetricFamilies(selected)
+}
+
+// familyName returns the metric name of a text exposition line: the family named by HELP and
+// TYPE comments or the sample's name. Other comments and blank lines have no name.
+func familyName(line []byte) []byte {
+	if len(line) == 0 {
+		return nil
+	}
+	if line[0] == '#' {
+		rest, ok := bytes.CutPrefix(line, []byte("# HELP "))
+		if !ok {
+			if rest, ok = bytes.CutPrefix(line, []byte("# TYPE ")); !ok {
+				return nil
+			}
+		}
+		line = bytes.TrimLeft(rest, " \t")
+	}
+	if end := bytes.IndexAny(line, "{ \t"); end >= 0 {
+		return line[:end]
+	}
+	return line
+}
+
+// wanted returns true if the named family, or the family of the named sample, is wanted.
+func wanted(families sets.Set[string], name []byte) bool {
+	if _, ok := families[string(name)]; ok {
+		return true
+	}
+	for _, suffix := range familySuffixes {
+		if !bytes.HasSuffix(name, suffix) {
+			continue
+		}
+		if _, ok := families[string(name[:len(name)-len(suffix)])]; ok {
+			return true
+		}
+	}
+	return false
+}
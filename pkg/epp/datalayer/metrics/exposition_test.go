@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+// vllmExposition returns a text exposition resembling vLLM's, where the mapped metrics are
+// a handful among many histograms.
+func vllmExposition() []byte {
+	var buf bytes.Buffer
+	gauge := func(name, labels string, value float64) {
+		fmt.Fprintf(&buf, "# HELP %s A gauge.\n# TYPE %s gauge\n%s{%s} %g\n", name, name, name, labels, value)
+	}
+	gauge("vllm:num_requests_running", `model_name="llama"`, 3)
+	gauge(DefaultTotalQueuedRequestsMetric, `model_name="llama"`, 2)
+	gauge(DefaultKvCacheUsagePercentageMetric, `model_name="llama"`, 0.4)
+	gauge(DefaultLoraInfoMetric, `max_lora="4",running_lora_adapters="sql-lora,chat-lora",waiting_lora_adapters=""`, 1.7e9)
+	gauge(DefaultCacheInfoMetric, `block_size="16",num_gpu_blocks="1000"`, 1)
+	for i := range 40 {
+		name := fmt.Sprintf("vllm:histogram_%d_seconds", i)
+		fmt.Fprintf(&buf, "# HELP %s A histogram.\n# TYPE %s histogram\n", name, name)
+		for bucket := range 20 {
+			fmt.Fprintf(&buf, "%s_bucket{le=\"%g\",model_name=\"llama\"} %d\n", name, 0.01*float64(int(1)<<bucket), 10*bucket)
+		}
+		fmt.Fprintf(&buf, "%s_bucket{le=\"+Inf\",model_name=\"llama\"} 200\n", name)
+		fmt.Fprintf(&buf, "%s_sum{model_name=\"llama\"} 42.5\n%s_count{model_name=\"llama\"} 200\n", name, name)
+	}
+	return buf.Bytes()
+}
+
+// toProtobuf converts a text exposition to the delimited protobuf format.
+func toProtobuf(t testing.TB, text []byte) []byte {
+	parser := expfmt.NewTextParser(model.LegacyValidation)
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(text))
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	encoder := expfmt.NewEncoder(&buf, expfmt.NewFormat(expfmt.TypeProtoDelim))
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		require.NoError(t, encoder.Encode(families[name]))
+	}
+	return buf.Bytes()
+}
+
+// exposition serves the metrics from memory, in protobuf if requested and available.
+type exposition struct {
+	text, protobuf []byte
+}
+
+func (e *exposition) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, contentType := e.text, string(expfmt.NewFormat(expfmt.TypeTextPlain))
+	if e.protobuf != nil && strings.Contains(req.Header.Get("Accept"), "application/vnd.google.protobuf") {
+		body, contentType = e.protobuf, string(expfmt.NewFormat(expfmt.TypeProtoDelim))
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func decode(t *testing.T, body []byte, contentType string, families sets.Set[string]) PrometheusMetricMap {
+	resp := &http.Response{
+		Header: http.Header{"Content-Type": []string{contentType}},
+		Body:   io.NopCloser(bytes.NewReader(body)),
+	}
+	result, err := DecodeFamilies(resp, families)
+	require.NoError(t, err)
+	return result
+}
+
+func TestDecodeFamilies(t *testing.T) {
+	text := vllmExposition()
+	textType := string(expfmt.NewFormat(expfmt.TypeTextPlain))
+	all := decode(t, text, textType, nil)
+	require.Len(t, all, 45)
+
+	wanted := sets.New(DefaultTotalQueuedRequestsMetric, DefaultLoraInfoMetric, "vllm:histogram_3_seconds")
+	for name, decoded := range map[string]PrometheusMetricMap{
+		"text":     decode(t, text, textType, wanted),
+		"protobuf": decode(t, toProtobuf(t, text), string(expfmt.NewFormat(expfmt.TypeProtoDelim)), wanted),
+		"unknown":  decode(t, text, "", wanted),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.ElementsMatch(t, sets.List(wanted), keys(decoded))
+			for family := range wanted {
+				assert.True(t, proto.Equal(all[family], decoded[family]), "family %s differs from the unfiltered parse", family)
+			}
+		})
+	}
+}
+
+func keys(families PrometheusMetricMap) []string {
+	names := []string{}
+	for name := range families {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestFamilyName(t *testing.T) {
+	for line, want := range map[string]string{
+		`# HELP vllm:num_requests_waiting Number of requests waiting.`: "vllm:num_requests_waiting",
+		`# TYPE vllm:e2e_request_latency_seconds histogram`:            "vllm:e2e_request_latency_seconds",
+		`vllm:num_requests_waiting{model_name="llama"} 2`:              "vllm:num_requests_waiting",
+		`process_open_fds 12`: "process_open_fds",
+		`# a comment`:         "",
+		``:                    "",
+	} {
+		assert.Equal(t, want, string(familyName([]byte(line))), line)
+	}
+
+	families := sets.New("vllm:e2e_request_latency_seconds")
+	assert.True(t, wanted(families, []byte("vllm:e2e_request_latency_seconds_bucket")))
+	assert.True(t, wanted(families, []byte("vllm:e2e_request_latency_seconds_count")))
+	assert.False(t, wanted(families, []byte("vllm:e2e_request_latency")))
+}
+
+func TestParseFormat(t *testing.T) {
+	for input, want := range map[string]Format{"": FormatText, "text": FormatText, "protobuf": FormatProtobuf} {
+		format, err := ParseFormat(input)
+		require.NoError(t, err)
+		assert.Equal(t, want, format)
+	}
+	_, err := ParseFormat("openmetrics")
+	assert.Error(t, err)
+}
+
+// opaqueExtractor hides the metric families read by the extractor.
+type opaqueExtractor struct {
+	datalayer.Extractor
+}
+
+func TestDataSourceParsesExtractorFamilies(t *testing.T) {
+	extractor, err := NewExtractor(DefaultTotalQueuedRequestsMetric, DefaultKvCacheUsagePercentageMetric,
+		DefaultLoraInfoMetric, DefaultCacheInfoMetric)
+	require.NoError(t, err)
+
+	source := NewDataSource("http", DefaultMetricsPath, false, nil)
+	require.NoError(t, source.AddExtractor(extractor))
+	opts := source.scrapeOptions()
+	assert.Equal(t, FormatText, opts.Format)
+	assert.True(t, opts.Families.HasAll(DefaultTotalQueuedRequestsMetric, DefaultKvCacheUsagePercentageMetric,
+		DefaultLoraInfoMetric, DefaultCacheInfoMetric))
+
+	require.NoError(t, source.AddExtractor(opaqueExtractor{extractor.WithName("opaque")}))
+	assert.Nil(t, source.scrapeOptions().Families, "expected all families to be parsed")
+}
+
+// BenchmarkCollect measures the cost of scraping and extracting the metrics of 1k and 5k
+// endpoints, on the shared worker pool. Each operation collects the metrics of every endpoint
+// once. Endpoints serve a vLLM like exposition from memory, to leave the network out.
+func BenchmarkCollect(b *testing.B) {
+	text := vllmExposition()
+	payload := &exposition{text: text, protobuf: toProtobuf(b, text)}
+	for _, endpoints := range []int{1000, 5000} {
+		for _, test := range []struct {
+			name        string
+			format      Format
+			allFamilies bool
+		}{
+			{name: "text", format: FormatText, allFamilies: true},
+			{name: "text-mapped-families", format: FormatText},
+			{name: "protobuf-mapped-families", format: FormatProtobuf},
+		} {
+			b.Run(fmt.Sprintf("endpoints=%d/%s", endpoints, test.name), func(b *testing.B) {
+				extractor, err := NewExtractor(DefaultTotalQueuedRequestsMetric, DefaultKvCacheUsagePercentageMetric,
+					DefaultLoraInfoMetric, DefaultCacheInfoMetric)
+				require.NoError(b, err)
+				var ext datalayer.Extractor = extractor
+				if test.allFamilies {
+					ext = opaqueExtractor{extractor}
+				}
+				source := NewDataSource("http", DefaultMetricsPath, false,
+					&client{Client: http.Client{Transport: payload}}).WithFormat(test.format)
+				require.NoError(b, source.AddExtractor(ext))
+				benchmarkCollect(b, source, endpoints)
+			})
+		}
+	}
+}
+
+func benchmarkCollect(b *testing.B, source *DataSource, endpoints int) {
+	eps := make([]datalayer.Endpoint, endpoints)
+	for i := range eps {
+		eps[i] = datalayer.NewEndpoint()
+		eps[i].UpdatePod(&datalayer.PodInfo{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: fmt.Sprintf("pod-%d", i)},
+			Address:        fmt.Sprintf("10.0.%d.%d", i/256, i%256),
+			Port:           "8000",
+		})
+		eps[i].UpdateMetrics(datalayer.NewMetrics())
+	}
+
+	pool := datalayer.NewWorkerPool(datalayer.DefaultWorkerPoolSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var remaining atomic.Int64 // endpoints still collecting
+	remaining.Store(int64(endpoints))
+	done := make(chan struct{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for _, ep := range eps {
+		collections := 0 // a task never runs concurrently with itself
+		pool.Schedule(ctx, time.Microsecond, func(ctx context.Context) {
+			if collections == b.N {
+				return
+			}
+			_ = source.Collect(ctx, ep)
+			if collections++; collections == b.N && remaining.Add(-1) == 0 {
+				close(done)
+			}
+		})
+	}
+	<-done
+	b.StopTimer()
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*endpoints), "ns/endpoint")
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	dto "github.com/prometheus/client_model/go"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
@@ -40,22 +41,28 @@ const (
 	LoraInfoMaxAdaptersMetricName     = "max_lora"
 
 	CacheConfigBlockSizeInfoMetricName = "block_size"
+	CacheConfigNumGPUBlocksMetricName  = "num_gpu_blocks"
 )
 
 // Extractor implements the metrics extraction based on the model
 // server protocol standard.
 type Extractor struct {
+	name    string
 	mapping *Mapping
+	// engineMappings are used instead of mapping for endpoints that name their engine.
+	engineMappings map[string]*Mapping
 }
 
+// Produces returns the endpoint metrics produced by the model server protocol metrics extractor.
 func Produces() map[string]any {
 	return map[string]any{
-		metrics.WaitingQueueSizeKey:    int(0),
-		metrics.KVCacheUsagePercentKey: float64(0),
-		metrics.ActiveModelsKey:        map[string]int{},
-		metrics.WaitingModelsKey:       map[string]int{},
-		metrics.MaxActiveModelsKey:     int(0),
-		metrics.UpdateTimeKey:          time.Time{},
+		metrics.WaitingQueueSizeKey:        int(0),
+		metrics.KVCacheUsagePercentKey:     float64(0),
+		metrics.KvCacheMaxTokenCapacityKey: int(0),
+		metrics.ActiveModelsKey:            map[string]int{},
+		metrics.WaitingModelsKey:           map[string]int{},
+		metrics.MaxActiveModelsKey:         int(0),
+		metrics.UpdateTimeKey:              time.Time{},
 	}
 }
 
@@ -68,14 +75,41 @@ func NewExtractor(queueSpec, kvusageSpec, loraSpec, cacheInfoSpec string) (*Extr
 	if err != nil {
 		return nil, fmt.Errorf("failed to create extractor metrics Mapping - %w", err)
 	}
+	engineMappings, err := NewEngineMappings()
+	if err != nil {
+		return nil, err
+	}
 	return &Extractor{
-		mapping: mapping,
+		name:           extractorName,
+		mapping:        mapping,
+		engineMappings: engineMappings,
 	}, nil
 }
 
 // Name returns the name of the metrics.Extractor.
 func (ext *Extractor) Name() string {
-	return extractorName
+	return ext.name
+}
+
+// WithName sets the name of the metrics.Extractor.
+func (ext *Extractor) WithName(name string) *Extractor {
+	ext.name = name
+	return ext
+}
+
+// Produces returns the endpoint metrics produced by the metrics.Extractor.
+func (ext *Extractor) Produces() map[string]any {
+	return Produces()
+}
+
+// MetricFamilies returns the names of the metric families read by the metrics.Extractor,
+// for any of the engines it maps.
+func (ext *Extractor) MetricFamilies() sets.Set[string] {
+	families := ext.mapping.Families()
+	for _, mapping := range ext.engineMappings {
+		families = families.Union(mapping.Families())
+	}
+	return families
 }
 
 // ExpectedType defines the type expected by the metrics.Extractor - a
@@ -96,8 +130,9 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep datalayer.Endpoi
 	current := ep.GetMetrics()
 	clone := current.Clone()
 	updated := false
+	mapping := ext.mappingFor(ep.GetPod())
 
-	if spec := ext.mapping.TotalQueuedRequests; spec != nil { // extract queued requests
+	if spec := mapping.TotalQueuedRequests; spec != nil { // extract queued requests
 		if metric, err := spec.getLatestMetric(families); err != nil {
 			errs = append(errs, err)
 		} else {
@@ -106,7 +141,7 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep datalayer.Endpoi
 		}
 	}
 
-	if spec := ext.mapping.KVCacheUtilization; spec != nil { // extract KV cache usage
+	if spec := mapping.KVCacheUtilization; spec != nil { // extract KV cache usage
 		if metric, err := spec.getLatestMetric(families); err != nil {
 			errs = append(errs, err)
 		} else {
@@ -115,7 +150,7 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep datalayer.Endpoi
 		}
 	}
 
-	if spec := ext.mapping.LoraRequestInfo; spec != nil { // extract LoRA-specific metrics
+	if spec := mapping.LoraRequestInfo; spec != nil { // extract LoRA-specific metrics
 		metric, err := spec.getLatestMetric(families)
 		if err != nil {
 			errs = append(errs, err)
@@ -125,7 +160,7 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep datalayer.Endpoi
 		}
 	}
 
-	if spec := ext.mapping.CacheInfo; spec != nil { // extract CacheInfo-specific metrics
+	if spec := mapping.CacheInfo; spec != nil { // extract CacheInfo-specific metrics
 		metric, err := spec.getLatestMetric(families)
 		if err != nil {
 			errs = append(errs, err)
@@ -146,6 +181,15 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep datalayer.Endpoi
 	return nil
 }
 
+// mappingFor returns the metrics Mapping of the endpoint's engine, or the configured Mapping
+// if the endpoint doesn't name a known engine.
+func (ext *Extractor) mappingFor(pod *datalayer.PodInfo) *Mapping {
+	if mapping, ok := ext.engineMappings[EngineOf(pod)]; ok {
+		return mapping
+	}
+	return ext.mapping
+}
+
 // populateLoRAMetrics updates the metrics with LoRA adapter info from the metric labels.
 func populateLoRAMetrics(clone *datalayer.Metrics, metric *dto.Metric, errs *[]error) {
 	clone.ActiveModels = map[string]int{}
@@ -170,20 +214,30 @@ func populateLoRAMetrics(clone *datalayer.Metrics, metric *dto.Metric, errs *[]e
 }
 
 // populateCacheInfoMetrics updates the metrics with cache info from the metric labels.
+// The KV cache token capacity is the number of GPU blocks multiplied by the block size.
 func populateCacheInfoMetrics(clone *datalayer.Metrics, metric *dto.Metric, errs *[]error) {
 	clone.CacheBlockSize = 0
+	numGPUBlocks := 0
 	for _, label := range metric.GetLabel() {
-		if label.GetName() == CacheConfigBlockSizeInfoMetricName {
-			if label.GetValue() != "" {
-				if val, err := strconv.Atoi(label.GetValue()); err == nil {
-					clone.CacheBlockSize = val
-					break
-				} else {
-					*errs = append(*errs, err)
-				}
+		if label.GetValue() == "" {
+			continue
+		}
+		switch label.GetName() {
+		case CacheConfigBlockSizeInfoMetricName:
+			if val, err := strconv.Atoi(label.GetValue()); err == nil {
+				clone.CacheBlockSize = val
+			} else {
+				*errs = append(*errs, err)
+			}
+		case CacheConfigNumGPUBlocksMetricName:
+			if val, err := strconv.Atoi(label.GetValue()); err == nil {
+				numGPUBlocks = val
+			} else {
+				*errs = append(*errs, err)
 			}
 		}
 	}
+	clone.KvCacheMaxTokenCapacity = clone.CacheBlockSize * numGPUBlocks
 }
 
 // addAdapters splits a comma-separated adapter list and stores keys with default value 0.
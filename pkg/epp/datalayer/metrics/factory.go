@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	// DataSourceType is the type of the metrics data source in the configuration.
+	DataSourceType = DataSourceName
+	// ExtractorType is the type of the model server protocol metrics extractor in the configuration.
+	ExtractorType = extractorName
+
+	DefaultMetricsScheme                = "http"
+	DefaultMetricsPath                  = "/metrics"
+	DefaultTotalQueuedRequestsMetric    = "vllm:num_requests_waiting"
+	DefaultKvCacheUsagePercentageMetric = "vllm:gpu_cache_usage_perc"
+	DefaultLoraInfoMetric               = "vllm:lora_requests_info"
+	DefaultCacheInfoMetric              = "vllm:cache_config_info"
+)
+
+// compile-time type validation
+var (
+	_ datalayer.SourceFactoryFunc    = DataSourceFactory
+	_ datalayer.ExtractorFactoryFunc = ExtractorFactory
+)
+
+type dataSourceParameters struct {
+	Scheme             string `json:"scheme"`
+	Path               string `json:"path"`
+	Format             string `json:"format"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+}
+
+// DataSourceFactory defines the factory function for the metrics DataSource.
+func DataSourceFactory(name string, rawParameters json.RawMessage) (datalayer.DataSource, error) {
+	parameters := dataSourceParameters{
+		Scheme:             DefaultMetricsScheme,
+		Path:               DefaultMetricsPath,
+		InsecureSkipVerify: true,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' data source - %w", DataSourceType, err)
+		}
+	}
+	if parameters.Scheme != "http" && parameters.Scheme != "https" {
+		return nil, fmt.Errorf("unexpected scheme '%s' for the '%s' data source, it can only be 'http' or 'https'", parameters.Scheme, DataSourceType)
+	}
+	format, err := ParseFormat(parameters.Format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters of the '%s' data source - %w", DataSourceType, err)
+	}
+
+	return NewDataSource(parameters.Scheme, parameters.Path, parameters.InsecureSkipVerify, nil).
+		WithFormat(format).WithName(name), nil
+}
+
+type extractorParameters struct {
+	TotalQueuedRequestsMetric    string `json:"totalQueuedRequestsMetric"`
+	KvCacheUsagePercentageMetric string `json:"kvCacheUsagePercentageMetric"`
+	LoraInfoMetric               string `json:"loraInfoMetric"`
+	CacheInfoMetric              string `json:"cacheInfoMetric"`
+}
+
+// ExtractorFactory defines the factory function for the model server protocol metrics Extractor.
+func ExtractorFactory(name string, rawParameters json.RawMessage) (datalayer.Extractor, error) {
+	parameters := extractorParameters{
+		TotalQueuedRequestsMetric:    DefaultTotalQueuedRequestsMetric,
+		KvCacheUsagePercentageMetric: DefaultKvCacheUsagePercentageMetric,
+		LoraInfoMetric:               DefaultLoraInfoMetric,
+		CacheInfoMetric:              DefaultCacheInfoMetric,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' extractor - %w", ExtractorType, err)
+		}
+	}
+
+	extractor, err := NewExtractor(parameters.TotalQueuedRequestsMetric, parameters.KvCacheUsagePercentageMetric,
+		parameters.LoraInfoMetric, parameters.CacheInfoMetric)
+	if err != nil {
+		return nil, err
+	}
+	return extractor.WithName(name), nil
+}
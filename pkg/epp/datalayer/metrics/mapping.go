@@ -18,6 +18,8 @@ package metrics
 
 import (
 	"errors"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // Mapping holds specifications for the well-known metrics defined
@@ -61,3 +63,17 @@ func NewMapping(queue, kvusage, lora, cacheInfo string) (*Mapping, error) {
 		CacheInfo:           cacheInfoSpec,
 	}, nil
 }
+
+// Families returns the names of the metric families read by the Mapping.
+func (m *Mapping) Families() sets.Set[string] {
+	families := sets.New[string]()
+	for _, spec := range []*Spec{m.TotalQueuedRequests, m.KVCacheUtilization, m.CacheInfo} {
+		if spec != nil {
+			families.Insert(spec.Name)
+		}
+	}
+	if m.LoraRequestInfo != nil && m.LoraRequestInfo.Spec != nil {
+		families.Insert(m.LoraRequestInfo.Name)
+	}
+	return families
+}
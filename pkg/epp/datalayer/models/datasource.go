@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	DataSourceName = "models-data-source"
+
+	// ServedModelsKey is the endpoint attribute holding the ServedModels of the endpoint.
+	ServedModelsKey = "ServedModels"
+
+	// maxResponseBytes bounds how much of a models listing is read.
+	maxResponseBytes = 1 << 20
+)
+
+// ServedModels are the models served by an endpoint, as listed by its OpenAI compatible models API.
+type ServedModels struct {
+	// Models maps the served model names to their parent (base) model. Base models have no parent.
+	Models map[string]string
+}
+
+// Clone returns a copy of the served models.
+func (s *ServedModels) Clone() datalayer.Cloneable {
+	if s == nil {
+		return nil
+	}
+	return &ServedModels{Models: maps.Clone(s.Models)}
+}
+
+// Serves returns true if the model (a base model or an adapter) is served.
+func (s *ServedModels) Serves(model string) bool {
+	_, ok := s.Models[model]
+	return ok
+}
+
+// Adapters returns the sorted names of the served adapters, i.e., the models that have a parent.
+func (s *ServedModels) Adapters() []string {
+	var adapters []string
+	for model, parent := range s.Models {
+		if parent != "" {
+			adapters = append(adapters, model)
+		}
+	}
+	slices.Sort(adapters)
+	return adapters
+}
+
+// modelList is the response of the OpenAI models API. Engines listing LoRA adapters
+// (e.g., vLLM) set the adapter's parent to its base model.
+type modelList struct {
+	Data []struct {
+		ID     string `json:"id"`
+		Parent string `json:"parent"`
+	} `json:"data"`
+}
+
+// DataSource polls the OpenAI compatible models API (/v1/models) of each endpoint and stores
+// the served base models and adapters on the endpoint. Optionally, the served adapters are also
+// reported as the endpoint's active models, for engines that do not expose LoRA info metrics.
+type DataSource struct {
+	name                 string
+	scheme               string
+	path                 string
+	populateActiveModels bool
+	client               *http.Client
+}
+
+// NewDataSource returns a new models data source, listing the models at the given path
+// of the endpoints' serving port.
+func NewDataSource(scheme, path string, populateActiveModels, skipCertVerification bool) *DataSource {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if scheme == "https" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: skipCertVerification,
+		}
+	}
+	return &DataSource{
+		name:                 DataSourceName,
+		scheme:               scheme,
+		path:                 path,
+		populateActiveModels: populateActiveModels,
+		client:               &http.Client{Transport: transport},
+	}
+}
+
+// Name returns the models data source name.
+func (dataSrc *DataSource) Name() string {
+	return dataSrc.name
+}
+
+// WithName sets the name of the models data source.
+func (dataSrc *DataSource) WithName(name string) *DataSource {
+	dataSrc.name = name
+	return dataSrc
+}
+
+// Produces returns the data stored on the endpoints by the models data source.
+func (dataSrc *DataSource) Produces() map[string]any {
+	produced := map[string]any{ServedModelsKey: &ServedModels{}}
+	if dataSrc.populateActiveModels {
+		produced[metrics.ActiveModelsKey] = map[string]int{}
+	}
+	return produced
+}
+
+// AddExtractor is not supported, the served models are stored on the endpoints directly.
+func (dataSrc *DataSource) AddExtractor(extractor datalayer.Extractor) error {
+	return fmt.Errorf("unable to add extractor %s to %s, models data sources do not support extractors", extractor.Name(), dataSrc.Name())
+}
+
+// Collect lists the models served by the endpoint and stores them on it.
+func (dataSrc *DataSource) Collect(ctx context.Context, ep datalayer.Endpoint) error {
+	pod := ep.GetPod()
+	target := url.URL{
+		Scheme: dataSrc.scheme,
+		Host:   net.JoinHostPort(pod.GetIPAddress(), pod.GetPort()),
+		Path:   dataSrc.path,
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := dataSrc.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to list the models of %s - %w", pod.NamespacedName, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from %s: %d", pod.NamespacedName, resp.StatusCode)
+	}
+
+	var list modelList
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&list); err != nil {
+		return fmt.Errorf("failed to parse the models of %s - %w", pod.NamespacedName, err)
+	}
+	served := &ServedModels{Models: make(map[string]string, len(list.Data))}
+	for _, model := range list.Data {
+		if model.Parent == model.ID {
+			model.Parent = ""
+		}
+		served.Models[model.ID] = model.Parent
+	}
+	ep.Put(ServedModelsKey, served)
+
+	if dataSrc.populateActiveModels {
+		dataSrc.updateActiveModels(ep, served)
+	}
+	return nil
+}
+
+// updateActiveModels reports the served adapters as the endpoint's active models.
+func (dataSrc *DataSource) updateActiveModels(ep datalayer.Endpoint, served *ServedModels) {
+	updated := ep.GetMetrics().Clone()
+	if updated == nil {
+		updated = datalayer.NewMetrics()
+	}
+	updated.ActiveModels = map[string]int{}
+	for _, adapter := range served.Adapters() {
+		updated.ActiveModels[adapter] = 0
+	}
+	ep.UpdateMetrics(updated)
+}
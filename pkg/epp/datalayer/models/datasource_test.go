@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+func newEndpoint(t *testing.T, serverURL string) datalayer.Endpoint {
+	req, err := http.NewRequest(http.MethodGet, serverURL, nil)
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(req.URL.Host)
+	require.NoError(t, err)
+
+	ep := datalayer.NewEndpoint()
+	ep.UpdatePod(&datalayer.PodInfo{Address: host, Port: port})
+	return ep
+}
+
+func TestCollect(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != DefaultPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"object":"list","data":[
+			{"id":"llama","object":"model","root":"llama","parent":null},
+			{"id":"sql-lora","object":"model","root":"/adapters/sql","parent":"llama"},
+			{"id":"chat-lora","object":"model","root":"/adapters/chat","parent":"llama"}]}`))
+	}))
+	defer server.Close()
+	ep := newEndpoint(t, server.URL)
+	ctx := context.Background()
+
+	source := NewDataSource("http", DefaultPath, true, true)
+	require.NoError(t, source.Collect(ctx, ep))
+	value, ok := ep.Get(ServedModelsKey)
+	require.True(t, ok, "expected served models")
+	served := value.(*ServedModels)
+	assert.Equal(t, map[string]string{"llama": "", "sql-lora": "llama", "chat-lora": "llama"}, served.Models)
+	assert.True(t, served.Serves("sql-lora"))
+	assert.False(t, served.Serves("other-lora"))
+	assert.Equal(t, []string{"chat-lora", "sql-lora"}, served.Adapters())
+	assert.Equal(t, map[string]int{"chat-lora": 0, "sql-lora": 0}, ep.GetMetrics().ActiveModels)
+
+	status = http.StatusInternalServerError
+	assert.Error(t, source.Collect(ctx, ep))
+	assert.Error(t, NewDataSource("http", "/missing", false, true).Collect(ctx, ep))
+}
+
+func TestProduces(t *testing.T) {
+	assert.Contains(t, NewDataSource("http", DefaultPath, false, true).Produces(), ServedModelsKey)
+	assert.NotContains(t, NewDataSource("http", DefaultPath, false, true).Produces(), "ActiveModels")
+	assert.Contains(t, NewDataSource("http", DefaultPath, true, true).Produces(), "ActiveModels")
+}
+
+func TestDataSourceFactory(t *testing.T) {
+	source, err := DataSourceFactory("models", nil)
+	require.NoError(t, err)
+	models := source.(*DataSource)
+	assert.Equal(t, "models", models.Name())
+	assert.Equal(t, DefaultPath, models.path)
+	assert.False(t, models.populateActiveModels)
+
+	source, err = DataSourceFactory("models", json.RawMessage(`{"scheme":"https","path":"/models","populateActiveModels":true}`))
+	require.NoError(t, err)
+	models = source.(*DataSource)
+	assert.Equal(t, "https", models.scheme)
+	assert.Equal(t, "/models", models.path)
+	assert.True(t, models.populateActiveModels)
+
+	_, err = DataSourceFactory("models", json.RawMessage(`{"scheme":"tcp"}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	// DataSourceType is the type of the models data source in the configuration.
+	DataSourceType = DataSourceName
+
+	DefaultScheme = "http"
+	DefaultPath   = "/v1/models"
+)
+
+// compile-time type validation
+var _ datalayer.SourceFactoryFunc = DataSourceFactory
+
+type dataSourceParameters struct {
+	Scheme               string `json:"scheme"`
+	Path                 string `json:"path"`
+	PopulateActiveModels bool   `json:"populateActiveModels"`
+	InsecureSkipVerify   bool   `json:"insecureSkipVerify"`
+}
+
+// DataSourceFactory defines the factory function for the models DataSource.
+func DataSourceFactory(name string, rawParameters json.RawMessage) (datalayer.DataSource, error) {
+	parameters := dataSourceParameters{
+		Scheme:             DefaultScheme,
+		Path:               DefaultPath,
+		InsecureSkipVerify: true,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' data source - %w", DataSourceType, err)
+		}
+	}
+	if parameters.Scheme != "http" && parameters.Scheme != "https" {
+		return nil, fmt.Errorf("unexpected scheme '%s' for the '%s' data source, it can only be 'http' or 'https'", parameters.Scheme, DataSourceType)
+	}
+
+	return NewDataSource(parameters.Scheme, parameters.Path, parameters.PopulateActiveModels,
+		parameters.InsecureSkipVerify).WithName(name), nil
+}
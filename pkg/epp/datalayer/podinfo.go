@@ -38,6 +38,10 @@ type PodInfo struct {
 	Port           string
 	MetricsHost    string
 	Labels         map[string]string
+	Annotations    map[string]string
+	// Draining is set when the pod left the pool while serving requests. It receives no new requests, but keeps
+	// serving its in-flight ones until they complete or the drain timeout expires.
+	Draining bool
 }
 
 // String returns a string representation of the pod.
@@ -58,6 +62,13 @@ func (p *PodInfo) Clone() *PodInfo {
 	for key, value := range p.Labels {
 		clonedLabels[key] = value
 	}
+	var clonedAnnotations map[string]string
+	if p.Annotations != nil {
+		clonedAnnotations = make(map[string]string, len(p.Annotations))
+		for key, value := range p.Annotations {
+			clonedAnnotations[key] = value
+		}
+	}
 	return &PodInfo{
 		NamespacedName: types.NamespacedName{
 			Name:      p.NamespacedName.Name,
@@ -68,6 +79,8 @@ func (p *PodInfo) Clone() *PodInfo {
 		Port:        p.Port,
 		MetricsHost: p.MetricsHost,
 		Labels:      clonedLabels,
+		Annotations: clonedAnnotations,
+		Draining:    p.Draining,
 	}
 }
 
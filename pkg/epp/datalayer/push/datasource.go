@@ -0,0 +1,283 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+const (
+	DataSourceName = "push-data-source"
+
+	// ReportsPath is the HTTP path load reports are posted to.
+	ReportsPath = "/v1/load-reports"
+
+	// maxReportBodyBytes bounds the size of a single load reports request.
+	maxReportBodyBytes = 1 << 20
+)
+
+// ErrSourceMismatch is returned for load reports that were not sent from the address of the pod they report on.
+var ErrSourceMismatch = errors.New("load report not sent from the address of the pod")
+
+// trackedEndpoint is an endpoint known to the push data source, along with
+// the times it was last seen by the data layer and last updated by a push.
+type trackedEndpoint struct {
+	endpoint datalayer.Endpoint
+	lastSeen time.Time
+	lastPush time.Time
+}
+
+// DataSource is a data source fed by load reports pushed by the model servers.
+// Pushed reports update the endpoint metrics directly, as soon as they arrive.
+// When no fresh report was pushed for an endpoint, collection is delegated to an
+// optional fallback (scraping) data source.
+type DataSource struct {
+	name               string
+	port               int
+	stalenessThreshold time.Duration
+	endpointExpiry     time.Duration
+	fallback           datalayer.DataSource
+	now                func() time.Time
+
+	mu        sync.Mutex
+	endpoints map[types.NamespacedName]map[string]*trackedEndpoint // key: namespace/pod name, value: endpoints keyed by port
+	lastSweep time.Time
+}
+
+// NewDataSource returns a new push data source, accepting reports on the given port.
+// Pushed metrics are considered fresh for stalenessThreshold, after which the fallback
+// data source (if not nil) is used.
+func NewDataSource(port int, stalenessThreshold time.Duration, fallback datalayer.DataSource) *DataSource {
+	return &DataSource{
+		name:               DataSourceName,
+		port:               port,
+		stalenessThreshold: stalenessThreshold,
+		endpointExpiry:     max(time.Minute, 10*stalenessThreshold),
+		fallback:           fallback,
+		now:                time.Now,
+		endpoints:          map[types.NamespacedName]map[string]*trackedEndpoint{},
+	}
+}
+
+// Name returns the push data source name.
+func (dataSrc *DataSource) Name() string {
+	return dataSrc.name
+}
+
+// WithName sets the name of the push data source.
+func (dataSrc *DataSource) WithName(name string) *DataSource {
+	dataSrc.name = name
+	return dataSrc
+}
+
+// Port returns the port the load reports server listens on.
+func (dataSrc *DataSource) Port() int {
+	return dataSrc.port
+}
+
+// Produces returns the data pushed reports store on the endpoints.
+func (dataSrc *DataSource) Produces() map[string]any {
+	return dlmetrics.Produces()
+}
+
+// AddExtractor adds an extractor to the fallback data source. Pushed reports are
+// already structured, so extractors are only meaningful with a fallback.
+func (dataSrc *DataSource) AddExtractor(extractor datalayer.Extractor) error {
+	if dataSrc.fallback == nil {
+		return fmt.Errorf("unable to add extractor %s to %s, extractors require a fallback data source", extractor.Name(), dataSrc.Name())
+	}
+	return dataSrc.fallback.AddExtractor(extractor)
+}
+
+// Collect is triggered by the data layer framework for every endpoint. It keeps track of the
+// endpoint so pushed reports can be matched to it, and delegates to the fallback data
+// source when the endpoint did not push a fresh report.
+func (dataSrc *DataSource) Collect(ctx context.Context, ep datalayer.Endpoint) error {
+	now := dataSrc.now()
+	tracked := dataSrc.track(ep, now)
+	if dataSrc.fallback == nil {
+		return nil
+	}
+
+	dataSrc.mu.Lock()
+	fresh := !tracked.lastPush.IsZero() && now.Sub(tracked.lastPush) <= dataSrc.stalenessThreshold
+	dataSrc.mu.Unlock()
+	if fresh {
+		return nil
+	}
+	return dataSrc.fallback.Collect(ctx, ep)
+}
+
+// track records the endpoint as seen at the given time.
+func (dataSrc *DataSource) track(ep datalayer.Endpoint, now time.Time) *trackedEndpoint {
+	pod := ep.GetPod()
+	key := types.NamespacedName{Namespace: pod.NamespacedName.Namespace, Name: pod.PodName}
+
+	dataSrc.mu.Lock()
+	defer dataSrc.mu.Unlock()
+	byPort, ok := dataSrc.endpoints[key]
+	if !ok {
+		byPort = map[string]*trackedEndpoint{}
+		dataSrc.endpoints[key] = byPort
+	}
+	tracked, ok := byPort[pod.Port]
+	if !ok || tracked.endpoint != ep { // the endpoint may be replaced when the pod is recreated
+		tracked = &trackedEndpoint{endpoint: ep}
+		byPort[pod.Port] = tracked
+	}
+	tracked.lastSeen = now
+	return tracked
+}
+
+// Ingest applies a load report sent from the given source IP address to the matching endpoints,
+// returning the number of endpoints updated. A pod only reports its own load, so the report is
+// rejected with ErrSourceMismatch when the source address is not the address of the pod.
+func (dataSrc *DataSource) Ingest(report *LoadReport, sourceAddress string) (int, error) {
+	if err := report.validate(); err != nil {
+		return 0, err
+	}
+	source := net.ParseIP(sourceAddress)
+	now := dataSrc.now()
+
+	dataSrc.mu.Lock()
+	defer dataSrc.mu.Unlock()
+	dataSrc.sweep(now)
+
+	byPort := dataSrc.endpoints[types.NamespacedName{Namespace: report.Namespace, Name: report.Pod}]
+	for _, tracked := range byPort {
+		if address := tracked.endpoint.GetPod().Address; !source.Equal(net.ParseIP(address)) {
+			return 0, fmt.Errorf("%w %s/%s - sent from %q, pod address is %q", ErrSourceMismatch,
+				report.Namespace, report.Pod, sourceAddress, address)
+		}
+	}
+
+	updated := 0
+	for port, tracked := range byPort {
+		if report.Port != "" && report.Port != port {
+			continue
+		}
+		tracked.endpoint.UpdateMetrics(report.apply(tracked.endpoint.GetMetrics(), now))
+		tracked.lastPush = now
+		updated++
+	}
+	return updated, nil
+}
+
+// sweep forgets endpoints the data layer stopped collecting, e.g., because the pod was deleted.
+// It must be called with the mutex held.
+func (dataSrc *DataSource) sweep(now time.Time) {
+	if now.Sub(dataSrc.lastSweep) < dataSrc.endpointExpiry {
+		return
+	}
+	dataSrc.lastSweep = now
+	for key, byPort := range dataSrc.endpoints {
+		for port, tracked := range byPort {
+			if now.Sub(tracked.lastSeen) > dataSrc.endpointExpiry {
+				delete(byPort, port)
+			}
+		}
+		if len(byPort) == 0 {
+			delete(dataSrc.endpoints, key)
+		}
+	}
+}
+
+// Handler returns the HTTP handler accepting load reports. The request body is either a
+// single LoadReport or a JSON array of reports. Reports for unknown pods are ignored, and
+// reports that were not sent from the address of their pod are rejected.
+func (dataSrc *DataSource) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ReportsPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		sourceAddress, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid remote address %q", r.RemoteAddr), http.StatusBadRequest)
+			return
+		}
+		reports, err := decodeReports(http.MaxBytesReader(w, r.Body, maxReportBodyBytes))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		logger := log.FromContext(r.Context()).V(logutil.TRACE)
+		for i := range reports {
+			updated, err := dataSrc.Ingest(&reports[i], sourceAddress)
+			if errors.Is(err, ErrSourceMismatch) {
+				http.Error(w, fmt.Sprintf("forbidden load report at index %d - %v", i, err), http.StatusForbidden)
+				return
+			}
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid load report at index %d - %v", i, err), http.StatusBadRequest)
+				return
+			}
+			if updated == 0 {
+				logger.Info("Ignoring load report for an unknown endpoint", "namespace", reports[i].Namespace,
+					"pod", reports[i].Pod, "port", reports[i].Port)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return mux
+}
+
+// decodeReports decodes either a single load report or an array of load reports.
+func decodeReports(body io.Reader) ([]LoadReport, error) {
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read load reports - %w", err)
+	}
+	var reports []LoadReport
+	for _, b := range raw {
+		if b == ' ' || b == '\t' || b == '\r' || b == '\n' {
+			continue
+		}
+		if b == '[' {
+			err = json.Unmarshal(raw, &reports)
+		} else {
+			reports = make([]LoadReport, 1)
+			err = json.Unmarshal(raw, &reports[0])
+		}
+		break
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse load reports - %w", err)
+	}
+	if len(reports) == 0 {
+		return nil, errors.New("no load reports in the request")
+	}
+	return reports, nil
+}
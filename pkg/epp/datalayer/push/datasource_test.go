@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+// countingSource is a fallback data source counting the number of collections.
+type countingSource struct {
+	collected atomic.Int32
+}
+
+func (s *countingSource) Name() string                             { return "counting" }
+func (s *countingSource) AddExtractor(_ datalayer.Extractor) error { return nil }
+func (s *countingSource) Collect(_ context.Context, _ datalayer.Endpoint) error {
+	s.collected.Add(1)
+	return nil
+}
+
+// podAddresses are the IP addresses of the test pods.
+var podAddresses = map[string]string{"pod1": "10.0.0.1", "pod2": "10.0.0.2"}
+
+func newEndpoint(pod, port string) datalayer.Endpoint {
+	ep := datalayer.NewEndpoint()
+	ep.UpdatePod(&datalayer.PodInfo{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: pod + "-rank-" + port},
+		PodName:        pod,
+		Address:        podAddresses[pod],
+		Port:           port,
+	})
+	ep.UpdateMetrics(datalayer.NewMetrics())
+	return ep
+}
+
+func TestIngest(t *testing.T) {
+	ctx := context.Background()
+	dataSrc := NewDataSource(DefaultPort, time.Second, nil)
+	rank0 := newEndpoint("pod1", "8000")
+	rank1 := newEndpoint("pod1", "8001")
+	other := newEndpoint("pod2", "8000")
+	for _, ep := range []datalayer.Endpoint{rank0, rank1, other} {
+		require.NoError(t, dataSrc.Collect(ctx, ep))
+	}
+
+	updated, err := dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod1",
+		WaitingQueueSize: ptr(3), KVCacheUsagePercent: ptr(0.5), ActiveModels: []string{"lora1"}}, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+	for _, ep := range []datalayer.Endpoint{rank0, rank1} {
+		assert.Equal(t, 3, ep.GetMetrics().WaitingQueueSize)
+		assert.Equal(t, 0.5, ep.GetMetrics().KVCacheUsagePercent)
+		assert.Equal(t, map[string]int{"lora1": 0}, ep.GetMetrics().ActiveModels)
+		assert.False(t, ep.GetMetrics().UpdateTime.IsZero())
+	}
+	assert.Equal(t, 0, other.GetMetrics().WaitingQueueSize)
+
+	// partial report for a single rank keeps the fields that are not set
+	updated, err = dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod1", Port: "8001", RunningQueueSize: ptr(7)}, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, 7, rank1.GetMetrics().RunningQueueSize)
+	assert.Equal(t, 3, rank1.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 0, rank0.GetMetrics().RunningQueueSize)
+
+	updated, err = dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "unknown", WaitingQueueSize: ptr(1)}, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated)
+
+	_, err = dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod1", KVCacheUsagePercent: ptr(1.5)}, "10.0.0.1")
+	assert.Error(t, err)
+	_, err = dataSrc.Ingest(&LoadReport{Pod: "pod1"}, "10.0.0.1")
+	assert.Error(t, err)
+
+	// a pod only reports its own load
+	_, err = dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod2", WaitingQueueSize: ptr(5)}, "10.0.0.1")
+	assert.ErrorIs(t, err, ErrSourceMismatch)
+	assert.Equal(t, 0, other.GetMetrics().WaitingQueueSize)
+	updated, err = dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod2", WaitingQueueSize: ptr(5)}, "10.0.0.2")
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+}
+
+func TestCollectFallback(t *testing.T) {
+	ctx := context.Background()
+	fallback := &countingSource{}
+	dataSrc := NewDataSource(DefaultPort, time.Second, fallback)
+	now := time.Now()
+	dataSrc.now = func() time.Time { return now }
+	ep := newEndpoint("pod1", "8000")
+
+	require.NoError(t, dataSrc.Collect(ctx, ep))
+	assert.Equal(t, int32(1), fallback.collected.Load(), "no push yet, expected fallback collection")
+
+	_, err := dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod1", WaitingQueueSize: ptr(1)}, "10.0.0.1")
+	require.NoError(t, err)
+	require.NoError(t, dataSrc.Collect(ctx, ep))
+	assert.Equal(t, int32(1), fallback.collected.Load(), "fresh push, expected no fallback collection")
+
+	now = now.Add(2 * time.Second)
+	require.NoError(t, dataSrc.Collect(ctx, ep))
+	assert.Equal(t, int32(2), fallback.collected.Load(), "stale push, expected fallback collection")
+}
+
+func TestSweep(t *testing.T) {
+	dataSrc := NewDataSource(DefaultPort, time.Second, nil)
+	now := time.Now()
+	dataSrc.now = func() time.Time { return now }
+	require.NoError(t, dataSrc.Collect(context.Background(), newEndpoint("pod1", "8000")))
+
+	now = now.Add(dataSrc.endpointExpiry + time.Second)
+	updated, err := dataSrc.Ingest(&LoadReport{Namespace: "default", Pod: "pod1", WaitingQueueSize: ptr(1)}, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated)
+	assert.Empty(t, dataSrc.endpoints)
+}
+
+func TestHandler(t *testing.T) {
+	dataSrc := NewDataSource(DefaultPort, time.Second, nil)
+	ep := newEndpoint("pod1", "8000")
+	require.NoError(t, dataSrc.Collect(context.Background(), ep))
+	handler := dataSrc.Handler()
+
+	tests := []struct {
+		name       string
+		method     string
+		remoteAddr string
+		body       string
+		want       int
+	}{
+		{
+			name:   "single report",
+			method: http.MethodPost,
+			body:   `{"namespace":"default","pod":"pod1","waitingQueueSize":4}`,
+			want:   http.StatusNoContent,
+		},
+		{
+			name:   "batch of reports",
+			method: http.MethodPost,
+			body:   ` [{"namespace":"default","pod":"pod1","runningQueueSize":2},{"namespace":"default","pod":"pod2"}]`,
+			want:   http.StatusNoContent,
+		},
+		{
+			name:   "malformed report",
+			method: http.MethodPost,
+			body:   `{"namespace":`,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "empty batch",
+			method: http.MethodPost,
+			body:   `[]`,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "invalid report",
+			method: http.MethodPost,
+			body:   `{"pod":"pod1"}`,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:       "report from another address",
+			method:     http.MethodPost,
+			remoteAddr: "10.0.0.2:40000",
+			body:       `{"namespace":"default","pod":"pod1","waitingQueueSize":100}`,
+			want:       http.StatusForbidden,
+		},
+		{
+			name:   "wrong method",
+			method: http.MethodGet,
+			want:   http.StatusMethodNotAllowed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(test.method, ReportsPath, strings.NewReader(test.body))
+			req.RemoteAddr = "10.0.0.1:40000"
+			if test.remoteAddr != "" {
+				req.RemoteAddr = test.remoteAddr
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, test.want, rec.Code, rec.Body.String())
+		})
+	}
+	assert.Equal(t, 4, ep.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, 2, ep.GetMetrics().RunningQueueSize)
+}
+
+func TestDataSourceFactory(t *testing.T) {
+	datalayer.RegisterSourceFactory("counting", func(string, json.RawMessage) (datalayer.DataSource, error) {
+		return &countingSource{}, nil
+	})
+
+	source, err := DataSourceFactory("push", nil)
+	require.NoError(t, err)
+	pushSource := source.(*DataSource)
+	assert.Equal(t, "push", pushSource.Name())
+	assert.Equal(t, DefaultPort, pushSource.Port())
+	assert.Equal(t, DefaultStalenessThreshold, pushSource.stalenessThreshold)
+	assert.Nil(t, pushSource.fallback)
+
+	source, err = DataSourceFactory("push", []byte(`{"port":9100,"stalenessThreshold":"500ms","fallback":{"type":"counting"}}`))
+	require.NoError(t, err)
+	pushSource = source.(*DataSource)
+	assert.Equal(t, 9100, pushSource.Port())
+	assert.Equal(t, 500*time.Millisecond, pushSource.stalenessThreshold)
+	assert.NotNil(t, pushSource.fallback)
+
+	for _, params := range []string{
+		`{"port":0}`,
+		`{"stalenessThreshold":"soon"}`,
+		`{"fallback":{"type":"unknown"}}`,
+		`{"fallback":{"type":"push-data-source"}}`,
+	} {
+		_, err := DataSourceFactory("push", []byte(params))
+		assert.Error(t, err, params)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
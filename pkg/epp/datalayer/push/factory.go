@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package push
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+const (
+	// DataSourceType is the type of the push data source in the configuration.
+	DataSourceType = DataSourceName
+
+	DefaultPort               = 9010
+	DefaultStalenessThreshold = 2 * time.Second
+)
+
+// compile-time type validation
+var _ datalayer.SourceFactoryFunc = DataSourceFactory
+
+type fallbackParameters struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters"`
+}
+
+type dataSourceParameters struct {
+	Port               int                 `json:"port"`
+	StalenessThreshold string              `json:"stalenessThreshold"`
+	Fallback           *fallbackParameters `json:"fallback"`
+}
+
+// DataSourceFactory defines the factory function for the push DataSource. The optional
+// fallback data source is created using the registered data source factories.
+func DataSourceFactory(name string, rawParameters json.RawMessage) (datalayer.DataSource, error) {
+	parameters := dataSourceParameters{
+		Port: DefaultPort,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' data source - %w", DataSourceType, err)
+		}
+	}
+	if parameters.Port <= 0 || parameters.Port > 65535 {
+		return nil, fmt.Errorf("invalid port %d for the '%s' data source", parameters.Port, DataSourceType)
+	}
+
+	stalenessThreshold := DefaultStalenessThreshold
+	if parameters.StalenessThreshold != "" {
+		var err error
+		stalenessThreshold, err = time.ParseDuration(parameters.StalenessThreshold)
+		if err != nil || stalenessThreshold <= 0 {
+			return nil, fmt.Errorf("invalid stalenessThreshold '%s' for the '%s' data source", parameters.StalenessThreshold, DataSourceType)
+		}
+	}
+
+	var fallback datalayer.DataSource
+	if parameters.Fallback != nil {
+		if parameters.Fallback.Type == DataSourceType {
+			return nil, fmt.Errorf("the fallback of the '%s' data source can not be another '%s' data source", DataSourceType, DataSourceType)
+		}
+		factory, ok := datalayer.SourceFactories[parameters.Fallback.Type]
+		if !ok {
+			return nil, fmt.Errorf("unknown fallback data source type '%s' for the '%s' data source", parameters.Fallback.Type, DataSourceType)
+		}
+		var err error
+		fallback, err = factory(name+"-fallback", parameters.Fallback.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the fallback of the '%s' data source - %w", DataSourceType, err)
+		}
+	}
+
+	return NewDataSource(parameters.Port, stalenessThreshold, fallback).WithName(name), nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package push
+
+import (
+	"errors"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+// LoadReport is a compact load report pushed by a model server (or a sidecar next to it).
+// Reports are partial: only the fields that are set are applied, so a model server may
+// push just the values that changed since its previous report.
+type LoadReport struct {
+	// Namespace and Pod identify the model server pod the report belongs to.
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	// Port optionally selects a single endpoint (e.g., a data parallel rank) of the pod.
+	// When empty, the report applies to all endpoints of the pod.
+	Port string `json:"port,omitempty"`
+
+	WaitingQueueSize        *int     `json:"waitingQueueSize,omitempty"`
+	RunningQueueSize        *int     `json:"runningQueueSize,omitempty"`
+	KVCacheUsagePercent     *float64 `json:"kvCacheUsagePercent,omitempty"`
+	KvCacheMaxTokenCapacity *int     `json:"kvCacheMaxTokenCapacity,omitempty"`
+	// ActiveModels and WaitingModels list the LoRA adapters that are currently
+	// loaded and waiting to be loaded, respectively.
+	ActiveModels    []string `json:"activeModels,omitempty"`
+	WaitingModels   []string `json:"waitingModels,omitempty"`
+	MaxActiveModels *int     `json:"maxActiveModels,omitempty"`
+}
+
+// validate checks that the report identifies a pod and carries sane values.
+func (r *LoadReport) validate() error {
+	if r.Namespace == "" || r.Pod == "" {
+		return errors.New("load report must set both 'namespace' and 'pod'")
+	}
+	if r.WaitingQueueSize != nil && *r.WaitingQueueSize < 0 {
+		return errors.New("'waitingQueueSize' must not be negative")
+	}
+	if r.RunningQueueSize != nil && *r.RunningQueueSize < 0 {
+		return errors.New("'runningQueueSize' must not be negative")
+	}
+	if r.KVCacheUsagePercent != nil && (*r.KVCacheUsagePercent < 0 || *r.KVCacheUsagePercent > 1) {
+		return errors.New("'kvCacheUsagePercent' must be between 0 and 1")
+	}
+	return nil
+}
+
+// apply returns a copy of the given metrics, updated with the fields set in the report.
+func (r *LoadReport) apply(current *datalayer.Metrics, now time.Time) *datalayer.Metrics {
+	updated := current.Clone()
+	if updated == nil {
+		updated = datalayer.NewMetrics()
+	}
+	if r.WaitingQueueSize != nil {
+		updated.WaitingQueueSize = *r.WaitingQueueSize
+	}
+	if r.RunningQueueSize != nil {
+		updated.RunningQueueSize = *r.RunningQueueSize
+	}
+	if r.KVCacheUsagePercent != nil {
+		updated.KVCacheUsagePercent = *r.KVCacheUsagePercent
+	}
+	if r.KvCacheMaxTokenCapacity != nil {
+		updated.KvCacheMaxTokenCapacity = *r.KvCacheMaxTokenCapacity
+	}
+	if r.ActiveModels != nil {
+		updated.ActiveModels = toModelSet(r.ActiveModels)
+	}
+	if r.WaitingModels != nil {
+		updated.WaitingModels = toModelSet(r.WaitingModels)
+	}
+	if r.MaxActiveModels != nil {
+		updated.MaxActiveModels = *r.MaxActiveModels
+	}
+	updated.UpdateTime = now
+	return updated
+}
+
+func toModelSet(models []string) map[string]int {
+	set := make(map[string]int, len(models))
+	for _, model := range models {
+		set[model] = 0
+	}
+	return set
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	ExtractorName = "metrics-trend-extractor"
+
+	// RunningQueueSizeKey is the name of the running queue size metric. The other
+	// supported metrics are named by their metrics package keys.
+	RunningQueueSizeKey = "RunningQueueSize"
+
+	keySuffix = "Trend"
+)
+
+// metricValues maps the supported metric names to their value in the endpoint metrics.
+var metricValues = map[string]func(*datalayer.Metrics) float64{
+	metrics.WaitingQueueSizeKey:    func(m *datalayer.Metrics) float64 { return float64(m.WaitingQueueSize) },
+	RunningQueueSizeKey:            func(m *datalayer.Metrics) float64 { return float64(m.RunningQueueSize) },
+	metrics.KVCacheUsagePercentKey: func(m *datalayer.Metrics) float64 { return m.KVCacheUsagePercent },
+}
+
+// SupportedMetrics returns the names of the metrics trends can be tracked for.
+func SupportedMetrics() []string {
+	return slices.Sorted(maps.Keys(metricValues))
+}
+
+// Key returns the name of the endpoint attribute holding the Trend of the given metric,
+// e.g., "WaitingQueueSizeTrend".
+func Key(metric string) string {
+	return metric + keySuffix
+}
+
+// Trend holds the smoothed value and rate of change of a metric.
+type Trend struct {
+	// Value is the latest sample of the metric.
+	Value float64
+	// EWMA is the exponentially weighted moving average of the metric.
+	EWMA float64
+	// Rate is the exponentially weighted moving average of the metric's rate of change, per second.
+	Rate float64
+	// UpdateTime is the time of the latest sample.
+	UpdateTime time.Time
+}
+
+// Clone returns a copy of the trend.
+func (t *Trend) Clone() datalayer.Cloneable {
+	if t == nil {
+		return nil
+	}
+	clone := *t
+	return &clone
+}
+
+// Get returns the trend of the metric stored on the given attributes (e.g., an endpoint or a scheduled pod).
+func Get(attributes interface {
+	Get(string) (datalayer.Cloneable, bool)
+}, metric string) (*Trend, bool) {
+	value, ok := attributes.Get(Key(metric))
+	if !ok {
+		return nil, false
+	}
+	t, ok := value.(*Trend)
+	return t, ok
+}
+
+// Extractor decorates another extractor, tracking the trends of the selected metrics after
+// every extraction. Trends are stored as endpoint attributes, named by Key. The moving averages
+// are weighted by time, so that irregular sampling intervals (e.g., due to backoff) do not skew
+// them: a sample's weight halves every halfLife.
+type Extractor struct {
+	name     string
+	inner    datalayer.Extractor
+	metrics  []string
+	halfLife time.Duration
+}
+
+// NewExtractor returns a new trend extractor decorating the given extractor.
+func NewExtractor(inner datalayer.Extractor, trackedMetrics []string, halfLife time.Duration) (*Extractor, error) {
+	if inner == nil {
+		return nil, errors.New("the trend extractor requires an extractor to decorate")
+	}
+	if halfLife <= 0 {
+		return nil, fmt.Errorf("invalid half life %s, it must be positive", halfLife)
+	}
+	for _, metric := range trackedMetrics {
+		if _, ok := metricValues[metric]; !ok {
+			return nil, fmt.Errorf("unsupported metric '%s', supported metrics are %v", metric, SupportedMetrics())
+		}
+	}
+	return &Extractor{
+		name:     ExtractorName,
+		inner:    inner,
+		metrics:  trackedMetrics,
+		halfLife: halfLife,
+	}, nil
+}
+
+// Name returns the name of the extractor.
+func (ext *Extractor) Name() string {
+	return ext.name
+}
+
+// WithName sets the name of the extractor.
+func (ext *Extractor) WithName(name string) *Extractor {
+	ext.name = name
+	return ext
+}
+
+// ExpectedInputType returns the input type of the decorated extractor.
+func (ext *Extractor) ExpectedInputType() reflect.Type {
+	return ext.inner.ExpectedInputType()
+}
+
+// Produces returns the data produced by the decorated extractor, as well as the trends.
+func (ext *Extractor) Produces() map[string]any {
+	produced := map[string]any{}
+	if producer, ok := ext.inner.(interface{ Produces() map[string]any }); ok {
+		maps.Copy(produced, producer.Produces())
+	}
+	for _, metric := range ext.metrics {
+		produced[Key(metric)] = &Trend{}
+	}
+	return produced
+}
+
+// MetricFamilies returns the metric families read by the decorated extractor, allowing metrics
+// data sources to skip parsing the others. Returns nil if the decorated extractor may read any family.
+func (ext *Extractor) MetricFamilies() sets.Set[string] {
+	if consumer, ok := ext.inner.(interface{ MetricFamilies() sets.Set[string] }); ok {
+		return consumer.MetricFamilies()
+	}
+	return nil
+}
+
+// Extract runs the decorated extractor and then updates the trends from the endpoint metrics.
+func (ext *Extractor) Extract(ctx context.Context, data any, ep datalayer.Endpoint) error {
+	err := ext.inner.Extract(ctx, data, ep) // metrics may be updated even on (partial) errors
+
+	current := ep.GetMetrics()
+	if current == nil || current.UpdateTime.IsZero() {
+		return err
+	}
+	for _, metric := range ext.metrics {
+		previous, _ := Get(ep, metric)
+		if updated := ext.update(previous, metricValues[metric](current), current.UpdateTime); updated != previous {
+			ep.Put(Key(metric), updated)
+		}
+	}
+	return err
+}
+
+// update returns the trend updated with a new sample, or the previous trend if the sample is not newer.
+func (ext *Extractor) update(previous *Trend, value float64, sampleTime time.Time) *Trend {
+	if previous == nil {
+		return &Trend{Value: value, EWMA: value, UpdateTime: sampleTime}
+	}
+	elapsed := sampleTime.Sub(previous.UpdateTime)
+	if elapsed <= 0 {
+		return previous // no new sample
+	}
+	// the weight of the new sample, given that the weight of older samples halves every halfLife
+	alpha := 1 - math.Exp2(-elapsed.Seconds()/ext.halfLife.Seconds())
+	rate := (value - previous.Value) / elapsed.Seconds()
+	return &Trend{
+		Value:      value,
+		EWMA:       previous.EWMA + alpha*(value-previous.EWMA),
+		Rate:       previous.Rate + alpha*(rate-previous.Rate),
+		UpdateTime: sampleTime,
+	}
+}
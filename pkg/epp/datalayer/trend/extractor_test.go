@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// sampleExtractor stores the sample it is given as the endpoint metrics.
+type sampleExtractor struct {
+	err error
+}
+
+func (e *sampleExtractor) Name() string { return "sample" }
+func (e *sampleExtractor) ExpectedInputType() reflect.Type {
+	return reflect.TypeOf(&datalayer.Metrics{})
+}
+func (e *sampleExtractor) Produces() map[string]any {
+	return map[string]any{metrics.WaitingQueueSizeKey: int(0)}
+}
+func (e *sampleExtractor) Extract(_ context.Context, data any, ep datalayer.Endpoint) error {
+	ep.UpdateMetrics(data.(*datalayer.Metrics))
+	return e.err
+}
+
+func TestExtract(t *testing.T) {
+	ctx := context.Background()
+	inner := &sampleExtractor{}
+	ext, err := NewExtractor(inner, []string{metrics.WaitingQueueSizeKey, RunningQueueSizeKey}, time.Second)
+	require.NoError(t, err)
+	ep := datalayer.NewEndpoint()
+	start := time.Now()
+
+	require.NoError(t, ext.Extract(ctx, &datalayer.Metrics{WaitingQueueSize: 10, UpdateTime: start}, ep))
+	got, ok := Get(ep, metrics.WaitingQueueSizeKey)
+	require.True(t, ok)
+	assert.Equal(t, &Trend{Value: 10, EWMA: 10, UpdateTime: start}, got)
+
+	// after one half life, the new sample and the average have the same weight
+	require.NoError(t, ext.Extract(ctx, &datalayer.Metrics{WaitingQueueSize: 20, RunningQueueSize: 4, UpdateTime: start.Add(time.Second)}, ep))
+	got, _ = Get(ep, metrics.WaitingQueueSizeKey)
+	assert.Equal(t, 20.0, got.Value)
+	assert.InDelta(t, 15.0, got.EWMA, 1e-9)
+	assert.InDelta(t, 5.0, got.Rate, 1e-9) // half of the 10/s increase
+	running, _ := Get(ep, RunningQueueSizeKey)
+	assert.InDelta(t, 2.0, running.EWMA, 1e-9)
+
+	// the same sample is not counted twice, and errors of the decorated extractor are returned
+	inner.err = errors.New("partial")
+	assert.Error(t, ext.Extract(ctx, &datalayer.Metrics{WaitingQueueSize: 20, UpdateTime: start.Add(time.Second)}, ep))
+	again, _ := Get(ep, metrics.WaitingQueueSizeKey)
+	assert.Equal(t, got, again)
+
+	_, ok = Get(ep, metrics.KVCacheUsagePercentKey)
+	assert.False(t, ok, "untracked metrics should not have a trend")
+
+	assert.Equal(t, map[string]any{
+		metrics.WaitingQueueSizeKey:      int(0),
+		Key(metrics.WaitingQueueSizeKey): &Trend{},
+		Key(RunningQueueSizeKey):         &Trend{},
+	}, ext.Produces())
+}
+
+func TestNewExtractorErrors(t *testing.T) {
+	_, err := NewExtractor(nil, DefaultMetrics, time.Second)
+	assert.Error(t, err)
+	_, err = NewExtractor(&sampleExtractor{}, []string{"Unknown"}, time.Second)
+	assert.Error(t, err)
+	_, err = NewExtractor(&sampleExtractor{}, DefaultMetrics, 0)
+	assert.Error(t, err)
+}
+
+func TestExtractorFactory(t *testing.T) {
+	datalayer.RegisterExtractorFactory("sample", func(string, json.RawMessage) (datalayer.Extractor, error) {
+		return &sampleExtractor{}, nil
+	})
+
+	extractor, err := ExtractorFactory("trends", json.RawMessage(`{"extractor":{"type":"sample"},"halfLife":"500ms"}`))
+	require.NoError(t, err)
+	ext := extractor.(*Extractor)
+	assert.Equal(t, "trends", ext.Name())
+	assert.Equal(t, DefaultMetrics, ext.metrics)
+	assert.Equal(t, 500*time.Millisecond, ext.halfLife)
+
+	for _, params := range []string{
+		``,
+		`{"extractor":{"type":"unknown"}}`,
+		`{"extractor":{"type":"metrics-trend-extractor"}}`,
+		`{"extractor":{"type":"sample"},"metrics":["Unknown"]}`,
+		`{"extractor":{"type":"sample"},"halfLife":"soon"}`,
+	} {
+		var raw json.RawMessage
+		if params != "" {
+			raw = json.RawMessage(params)
+		}
+		_, err := ExtractorFactory("trends", raw)
+		assert.Error(t, err, params)
+	}
+}
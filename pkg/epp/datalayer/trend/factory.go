@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+const (
+	// ExtractorType is the type of the trend extractor in the configuration.
+	ExtractorType = ExtractorName
+
+	DefaultHalfLife = time.Second
+)
+
+// DefaultMetrics are the metrics trends are tracked for when none are configured.
+var DefaultMetrics = []string{metrics.WaitingQueueSizeKey, metrics.KVCacheUsagePercentKey}
+
+// compile-time type validation
+var _ datalayer.ExtractorFactoryFunc = ExtractorFactory
+
+type decoratedParameters struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters"`
+}
+
+type extractorParameters struct {
+	Extractor *decoratedParameters `json:"extractor"`
+	Metrics   []string             `json:"metrics"`
+	HalfLife  string               `json:"halfLife"`
+}
+
+// ExtractorFactory defines the factory function for the trend Extractor. The decorated
+// extractor is created using the registered extractor factories.
+func ExtractorFactory(name string, rawParameters json.RawMessage) (datalayer.Extractor, error) {
+	parameters := extractorParameters{}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' extractor - %w", ExtractorType, err)
+		}
+	}
+	if parameters.Extractor == nil || parameters.Extractor.Type == "" {
+		return nil, fmt.Errorf("the '%s' extractor requires the type of the extractor it decorates", ExtractorType)
+	}
+	if parameters.Extractor.Type == ExtractorType {
+		return nil, fmt.Errorf("the '%s' extractor can not decorate another '%s' extractor", ExtractorType, ExtractorType)
+	}
+	factory, ok := datalayer.ExtractorFactories[parameters.Extractor.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown extractor type '%s' decorated by the '%s' extractor", parameters.Extractor.Type, ExtractorType)
+	}
+	inner, err := factory(name+"-decorated", parameters.Extractor.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the extractor decorated by the '%s' extractor - %w", ExtractorType, err)
+	}
+
+	trackedMetrics := parameters.Metrics
+	if len(trackedMetrics) == 0 {
+		trackedMetrics = DefaultMetrics
+	}
+	halfLife := DefaultHalfLife
+	if parameters.HalfLife != "" {
+		halfLife, err = time.ParseDuration(parameters.HalfLife)
+		if err != nil {
+			return nil, fmt.Errorf("invalid halfLife '%s' for the '%s' extractor", parameters.HalfLife, ExtractorType)
+		}
+	}
+
+	extractor, err := NewExtractor(inner, trackedMetrics, halfLife)
+	if err != nil {
+		return nil, err
+	}
+	return extractor.WithName(name), nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"container/heap"
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultWorkerPoolSize is the default number of collections running concurrently.
+	// Collections mostly wait on the network, so the pool is sized well above the CPU count.
+	DefaultWorkerPoolSize = 256
+)
+
+// WorkerPool runs the periodic data collection of many endpoints on a bounded number of
+// goroutines, instead of a goroutine and ticker per endpoint. The scheduled tasks are kept
+// in a heap ordered by their next run time, and a single dispatcher hands the due tasks to
+// at most size concurrently running workers. The dispatcher only runs while tasks are scheduled.
+//
+// The first run of a task is delayed by a random fraction of its interval, spreading the
+// collections of endpoints added together (e.g., on startup) evenly across the interval.
+// Runs that are missed because the pool is saturated are skipped, as done by time.Ticker.
+type WorkerPool struct {
+	workers chan struct{} // semaphore bounding the running tasks
+	jitter  func(interval time.Duration) time.Duration
+
+	mu      sync.Mutex
+	tasks   taskHeap
+	running bool          // the dispatcher goroutine is running
+	wakeup  chan struct{} // signals the dispatcher that the earliest task changed
+}
+
+// NewWorkerPool returns a new worker pool running up to size tasks concurrently.
+func NewWorkerPool(size int) *WorkerPool {
+	if size <= 0 {
+		size = DefaultWorkerPoolSize
+	}
+	return &WorkerPool{
+		workers: make(chan struct{}, size),
+		jitter: func(interval time.Duration) time.Duration {
+			return rand.N(interval)
+		},
+		wakeup: make(chan struct{}, 1),
+	}
+}
+
+// Size returns the maximal number of concurrently running tasks.
+func (p *WorkerPool) Size() int {
+	return cap(p.workers)
+}
+
+// Schedule runs the task every interval until the returned cancel function is called or
+// the context is done. A task never runs concurrently with itself. The interval must be positive.
+func (p *WorkerPool) Schedule(ctx context.Context, interval time.Duration, run func(context.Context)) (cancel func()) {
+	if interval <= 0 {
+		panic("non-positive interval for WorkerPool.Schedule")
+	}
+	t := &poolTask{
+		ctx:      ctx,
+		run:      run,
+		interval: interval,
+		next:     time.Now().Add(p.jitter(interval)),
+	}
+
+	p.mu.Lock()
+	p.push(t)
+	p.mu.Unlock()
+
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		t.cancelled = true
+		if t.index >= 0 {
+			heap.Remove(&p.tasks, t.index)
+		}
+	}
+}
+
+// push adds the task to the heap, starting or waking up the dispatcher as needed.
+// Must be called with the mutex held.
+func (p *WorkerPool) push(t *poolTask) {
+	heap.Push(&p.tasks, t)
+	if !p.running {
+		p.running = true
+		go p.dispatch()
+	} else if t.index == 0 {
+		select {
+		case p.wakeup <- struct{}{}:
+		default: // a wake up is already pending
+		}
+	}
+}
+
+// dispatch waits for the earliest task to be due and runs it on a worker, until no tasks
+// are left. Tasks are removed from the heap while running, and pushed back when done.
+func (p *WorkerPool) dispatch() {
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	for {
+		p.mu.Lock()
+		if len(p.tasks) == 0 {
+			p.running = false
+			p.mu.Unlock()
+			return
+		}
+		t := p.tasks[0]
+		wait := time.Until(t.next)
+		if wait <= 0 {
+			heap.Pop(&p.tasks)
+		}
+		p.mu.Unlock()
+
+		if wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-p.wakeup:
+			}
+			continue
+		}
+
+		if t.ctx.Err() != nil {
+			continue // the task's context is done, drop it
+		}
+		p.workers <- struct{}{}
+		go func() {
+			defer func() {
+				<-p.workers
+				p.reschedule(t)
+			}()
+			t.run(t.ctx)
+		}()
+	}
+}
+
+// reschedule pushes back the task after it ran, skipping the runs it missed.
+func (p *WorkerPool) reschedule(t *poolTask) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t.cancelled || t.ctx.Err() != nil {
+		return
+	}
+
+	t.next = t.next.Add(t.interval)
+	if now := time.Now(); t.next.Before(now) {
+		missed := now.Sub(t.next)/t.interval + 1
+		t.next = t.next.Add(missed * t.interval)
+	}
+	p.push(t)
+}
+
+// poolTask is a task scheduled on a WorkerPool.
+type poolTask struct {
+	ctx       context.Context
+	run       func(context.Context)
+	interval  time.Duration
+	next      time.Time // next run time
+	index     int       // index in the heap, -1 while running or once removed
+	cancelled bool
+}
+
+// taskHeap implements heap.Interface, ordering tasks by their next run time.
+type taskHeap []*poolTask
+
+func (h taskHeap) Len() int { return len(h) }
+
+func (h taskHeap) Less(i, j int) bool { return h[i].next.Before(h[j].next) }
+
+func (h taskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *taskHeap) Push(x any) {
+	t := x.(*poolTask)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *taskHeap) Pop() any {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	t.index = -1
+	*h = old[:n-1]
+	return t
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerPoolRunsPeriodically(t *testing.T) {
+	pool := NewWorkerPool(4)
+	var runs atomic.Int64
+	cancel := pool.Schedule(context.Background(), 2*time.Millisecond, func(context.Context) {
+		runs.Add(1)
+	})
+	require.Eventually(t, func() bool { return runs.Load() >= 5 }, time.Second, time.Millisecond)
+
+	cancel()
+	time.Sleep(5 * time.Millisecond) // let a run in progress complete
+	cancelled := runs.Load()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, cancelled, runs.Load(), "expected no runs after cancel")
+	require.Eventually(t, func() bool {
+		pool.mu.Lock()
+		defer pool.mu.Unlock()
+		return !pool.running
+	}, time.Second, time.Millisecond, "expected the dispatcher to stop without tasks")
+}
+
+func TestWorkerPoolStopsOnContextDone(t *testing.T) {
+	pool := NewWorkerPool(4)
+	ctx, cancel := context.WithCancel(context.Background())
+	var runs atomic.Int64
+	pool.Schedule(ctx, time.Millisecond, func(context.Context) {
+		runs.Add(1)
+	})
+	require.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+
+	cancel()
+	time.Sleep(5 * time.Millisecond)
+	cancelled := runs.Load()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, cancelled, runs.Load(), "expected no runs after the context is done")
+}
+
+func TestWorkerPoolBoundsConcurrency(t *testing.T) {
+	const size = 3
+	pool := NewWorkerPool(size)
+	assert.Equal(t, size, pool.Size())
+
+	var mu sync.Mutex
+	running, maxRunning, runs := 0, 0, 0
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for range 20 {
+		pool.Schedule(ctx, time.Millisecond, func(context.Context) {
+			mu.Lock()
+			running++
+			runs++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+			time.Sleep(2 * time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+		})
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return runs >= 60
+	}, 5*time.Second, time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.LessOrEqual(t, maxRunning, size, "expected at most %d concurrent runs", size)
+}
+
+func TestWorkerPoolSpreadsFirstRuns(t *testing.T) {
+	pool := NewWorkerPool(DefaultWorkerPoolSize)
+	interval := time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for range 100 {
+		pool.Schedule(ctx, interval, func(context.Context) {})
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	first, last := pool.tasks[0].next, pool.tasks[0].next
+	for _, task := range pool.tasks {
+		assert.WithinDuration(t, time.Now().Add(interval/2), task.next, interval/2)
+		if task.next.Before(first) {
+			first = task.next
+		}
+		if task.next.After(last) {
+			last = task.next
+		}
+	}
+	assert.Greater(t, last.Sub(first), interval/2, "expected the first runs to spread over the interval")
+}
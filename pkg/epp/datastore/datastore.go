@@ -23,9 +23,14 @@ import (
 	"net"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -61,15 +66,54 @@ type Datastore interface {
 	ObjectiveGetAll() []*v1alpha2.InferenceObjective
 
 	// PodList lists pods matching the given predicate.
+	// Draining pods are listed, and can be excluded with backendmetrics.ServingPodsPredicate.
 	PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics
 	PodUpdateOrAddIfNotExist(pod *corev1.Pod) bool
+	// PodDelete removes the pod from the pool. A pod serving requests is drained first: it receives no new requests,
+	// but its endpoints are kept until their in-flight requests finish or the drain timeout expires.
 	PodDelete(podNAme string)
+	// PodRequestStarted and PodRequestFinished track the in-flight requests of an endpoint, for draining.
+	PodRequestStarted(endpoint types.NamespacedName)
+	PodRequestFinished(endpoint types.NamespacedName)
+	// PodResyncAll resyncs the pods of the pool from the pods matching its selector or, when the endpoints are
+	// discovered from EndpointSlices, from the EndpointSlices of its Service.
+	PodResyncAll(ctx context.Context, reader client.Reader) error
+	// EndpointSliceService returns the name of the Service whose EndpointSlices list the endpoints of the pool. It
+	// returns an empty string when the endpoints are discovered from pods, or the pool is not synced.
+	EndpointSliceService() string
+
+	// Remote endpoint operations
+	// RemoteEndpointsSet replaces the endpoints standing for the pool in other clusters. Remote endpoints are not
+	// listed by PodList, and are not part of the pods of the pool.
+	RemoteEndpointsSet(endpoints []backendmetrics.PodMetrics)
+	RemoteEndpointList() []backendmetrics.PodMetrics
 
 	// Clears the store state, happens when the pool gets deleted.
 	Clear()
 }
 
-func NewDatastore(parentCtx context.Context, epFactory datalayer.EndpointFactory, modelServerMetricsPort int32) Datastore {
+// Option configures a datastore.
+type Option func(*datastore)
+
+// WithEndpointSlices discovers the endpoints of the pool from the EndpointSlices of the named Service, instead of
+// the pods matching the pool's selector. An empty service name stands for the name of the pool. Endpoints
+// discovered from EndpointSlices carry no pod labels or annotations.
+func WithEndpointSlices(serviceName string) Option {
+	return func(ds *datastore) {
+		ds.endpointSlices = true
+		ds.endpointSliceService = serviceName
+	}
+}
+
+// WithDrainTimeout sets the maximal duration a removed pod is drained, serving its in-flight requests. Pods are
+// removed right away when the timeout is zero.
+func WithDrainTimeout(timeout time.Duration) Option {
+	return func(ds *datastore) {
+		ds.drainTimeout = timeout
+	}
+}
+
+func NewDatastore(parentCtx context.Context, epFactory datalayer.EndpointFactory, modelServerMetricsPort int32, opts ...Option) Datastore {
 	store := &datastore{
 		parentCtx:              parentCtx,
 		poolAndObjectivesMu:    sync.RWMutex{},
@@ -77,6 +121,11 @@ func NewDatastore(parentCtx context.Context, epFactory datalayer.EndpointFactory
 		pods:                   &sync.Map{},
 		modelServerMetricsPort: modelServerMetricsPort,
 		epf:                    epFactory,
+		inFlight:               make(map[types.NamespacedName]int),
+		draining:               make(map[types.NamespacedName]*time.Timer),
+	}
+	for _, opt := range opts {
+		opt(store)
 	}
 	return store
 }
@@ -95,6 +144,19 @@ type datastore struct {
 	// used only if there is only one inference engine per pod
 	modelServerMetricsPort int32
 	epf                    datalayer.EndpointFactory
+	// endpointSlices is set when the endpoints are discovered from the EndpointSlices of endpointSliceService.
+	endpointSlices       bool
+	endpointSliceService string
+	// drainMu synchronizes the in-flight request counts and the draining endpoints.
+	drainMu      sync.Mutex
+	drainTimeout time.Duration
+	// key: endpoint's types.NamespacedName, value: number of in-flight requests
+	inFlight map[types.NamespacedName]int
+	// key: endpoint's types.NamespacedName, value: timer releasing the endpoint when the drain timeout expires
+	draining map[types.NamespacedName]*time.Timer
+	// remoteMu synchronizes access to the remote endpoints.
+	remoteMu sync.RWMutex
+	remote   []backendmetrics.PodMetrics
 }
 
 func (ds *datastore) Clear() {
@@ -103,11 +165,18 @@ func (ds *datastore) Clear() {
 	ds.pool = nil
 	ds.objectives = make(map[string]*v1alpha2.InferenceObjective)
 	// stop all pods go routines before clearing the pods map.
+	ds.drainMu.Lock()
+	for _, timer := range ds.draining {
+		timer.Stop()
+	}
+	ds.draining = make(map[types.NamespacedName]*time.Timer)
+	ds.drainMu.Unlock()
 	ds.pods.Range(func(_, v any) bool {
 		ds.epf.ReleaseEndpoint(v.(backendmetrics.PodMetrics))
 		return true
 	})
 	ds.pods.Clear()
+	ds.RemoteEndpointsSet(nil)
 }
 
 // /// InferencePool APIs ///
@@ -223,6 +292,13 @@ func (ds *datastore) PodUpdateOrAddIfNotExist(pod *corev1.Pod) bool {
 	for key, value := range pod.GetLabels() {
 		labels[key] = value
 	}
+	var annotations map[string]string
+	if len(pod.GetAnnotations()) > 0 {
+		annotations = make(map[string]string, len(pod.GetAnnotations()))
+		for key, value := range pod.GetAnnotations() {
+			annotations[key] = value
+		}
+	}
 
 	modelServerMetricsPort := 0
 	if len(ds.pool.Spec.TargetPorts) == 1 {
@@ -245,6 +321,7 @@ func (ds *datastore) PodUpdateOrAddIfNotExist(pod *corev1.Pod) bool {
 				Port:        strconv.Itoa(int(port.Number)),
 				MetricsHost: net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(metricsPort)),
 				Labels:      labels,
+				Annotations: annotations,
 			})
 	}
 
@@ -258,6 +335,13 @@ func (ds *datastore) PodUpdateOrAddIfNotExist(pod *corev1.Pod) bool {
 			result = false
 		} else {
 			pm = existing.(backendmetrics.PodMetrics)
+			// A draining pod added back to the pool, e.g. when it becomes ready again, keeps its endpoint.
+			ds.drainMu.Lock()
+			if timer, ok := ds.draining[podInfo.NamespacedName]; ok {
+				timer.Stop()
+				delete(ds.draining, podInfo.NamespacedName)
+			}
+			ds.drainMu.Unlock()
 		}
 		// Update pod properties if anything changed.
 		pm.UpdatePod(podInfo)
@@ -269,14 +353,103 @@ func (ds *datastore) PodDelete(podName string) {
 	ds.pods.Range(func(k, v any) bool {
 		pm := v.(backendmetrics.PodMetrics)
 		if pm.GetPod().PodName == podName {
-			ds.pods.Delete(k)
-			ds.epf.ReleaseEndpoint(pm)
+			ds.podDrainOrRelease(k.(types.NamespacedName), pm)
 		}
 		return true
 	})
 }
 
+// podDrainOrRelease drains the endpoint if it serves requests, and releases it otherwise.
+func (ds *datastore) podDrainOrRelease(name types.NamespacedName, pm backendmetrics.PodMetrics) {
+	ds.drainMu.Lock()
+	defer ds.drainMu.Unlock()
+	if _, ok := ds.draining[name]; ok {
+		return
+	}
+	if ds.drainTimeout <= 0 || ds.inFlight[name] == 0 {
+		ds.podRelease(name, pm)
+		return
+	}
+	log.FromContext(ds.parentCtx).V(logutil.DEFAULT).Info("Draining pod", "pod", name, "inFlightRequests", ds.inFlight[name])
+	pod := pm.GetPod().Clone()
+	pod.Draining = true
+	pm.UpdatePod(pod)
+	ds.draining[name] = time.AfterFunc(ds.drainTimeout, func() {
+		ds.drainMu.Lock()
+		defer ds.drainMu.Unlock()
+		if _, ok := ds.draining[name]; !ok {
+			return
+		}
+		log.FromContext(ds.parentCtx).V(logutil.DEFAULT).Info("Drain timeout expired, removing pod", "pod", name,
+			"inFlightRequests", ds.inFlight[name])
+		delete(ds.draining, name)
+		ds.podRelease(name, pm)
+	})
+}
+
+// podRelease removes the endpoint from the pool and stops its data collection. drainMu must be held.
+func (ds *datastore) podRelease(name types.NamespacedName, pm backendmetrics.PodMetrics) {
+	if ds.pods.CompareAndDelete(name, pm) {
+		ds.epf.ReleaseEndpoint(pm)
+	}
+}
+
+func (ds *datastore) PodRequestStarted(endpoint types.NamespacedName) {
+	ds.drainMu.Lock()
+	defer ds.drainMu.Unlock()
+	ds.inFlight[endpoint]++
+}
+
+// PodRequestFinished releases a draining endpoint once its last in-flight request finished.
+func (ds *datastore) PodRequestFinished(endpoint types.NamespacedName) {
+	ds.drainMu.Lock()
+	defer ds.drainMu.Unlock()
+	if ds.inFlight[endpoint] > 1 {
+		ds.inFlight[endpoint]--
+		return
+	}
+	delete(ds.inFlight, endpoint)
+	if timer, ok := ds.draining[endpoint]; ok {
+		timer.Stop()
+		delete(ds.draining, endpoint)
+		if existing, ok := ds.pods.Load(endpoint); ok {
+			log.FromContext(ds.parentCtx).V(logutil.DEFAULT).Info("Pod drained", "pod", endpoint)
+			ds.podRelease(endpoint, existing.(backendmetrics.PodMetrics))
+		}
+	}
+}
+
+func (ds *datastore) PodResyncAll(ctx context.Context, reader client.Reader) error {
+	ds.poolAndObjectivesMu.RLock()
+	defer ds.poolAndObjectivesMu.RUnlock()
+	if ds.pool == nil {
+		return errPoolNotSynced
+	}
+	return ds.podResyncAll(ctx, reader)
+}
+
+func (ds *datastore) EndpointSliceService() string {
+	ds.poolAndObjectivesMu.RLock()
+	defer ds.poolAndObjectivesMu.RUnlock()
+	if !ds.endpointSlices || ds.pool == nil {
+		return ""
+	}
+	return ds.serviceName()
+}
+
+// serviceName returns the name of the Service listing the endpoints of the pool, which must be synced.
+func (ds *datastore) serviceName() string {
+	if ds.endpointSliceService != "" {
+		return ds.endpointSliceService
+	}
+	return ds.pool.Name
+}
+
 func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) error {
+	if ds.endpointSlices {
+		return ds.endpointSliceResyncAll(ctx, reader)
+	}
+
 	logger := log.FromContext(ctx)
 	podList := &corev1.PodList{}
 	if err := reader.List(ctx, podList, &client.ListOptions{
@@ -291,16 +464,56 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 		if !podutil.IsPodReady(&pod) {
 			continue
 		}
-		namespacedName := types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}
 		activePods[pod.Name] = true
-		if !ds.PodUpdateOrAddIfNotExist(&pod) {
-			logger.V(logutil.DEFAULT).Info("Pod added", "name", namespacedName)
-		} else {
-			logger.V(logutil.DEFAULT).Info("Pod already exists", "name", namespacedName)
+		ds.podUpdateOrAdd(logger, &pod)
+	}
+
+	ds.podDeleteInactive(logger, activePods)
+	return nil
+}
+
+func (ds *datastore) endpointSliceResyncAll(ctx context.Context, reader client.Reader) error {
+	logger := log.FromContext(ctx)
+	sliceList := &discoveryv1.EndpointSliceList{}
+	if err := reader.List(ctx, sliceList, client.InNamespace(ds.pool.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: ds.serviceName()}); err != nil {
+		return fmt.Errorf("failed to list endpoint slices - %w", err)
+	}
+
+	activePods := make(map[string]bool)
+	for _, slice := range sliceList.Items {
+		if slice.AddressType == discoveryv1.AddressTypeFQDN {
+			continue
+		}
+		for _, endpoint := range slice.Endpoints {
+			if !podutil.IsEndpointReady(endpoint) || len(endpoint.Addresses) == 0 {
+				continue
+			}
+			pod := podFromEndpoint(ds.pool.Namespace, endpoint)
+			if activePods[pod.Name] {
+				// An endpoint may be listed by several slices while it moves between them.
+				continue
+			}
+			activePods[pod.Name] = true
+			ds.podUpdateOrAdd(logger, pod)
 		}
 	}
 
-	// Remove pods that don't belong to the pool or not ready any more.
+	ds.podDeleteInactive(logger, activePods)
+	return nil
+}
+
+func (ds *datastore) podUpdateOrAdd(logger logr.Logger, pod *corev1.Pod) {
+	namespacedName := types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}
+	if !ds.PodUpdateOrAddIfNotExist(pod) {
+		logger.V(logutil.DEFAULT).Info("Pod added", "name", namespacedName)
+	} else {
+		logger.V(logutil.DEFAULT).Info("Pod already exists", "name", namespacedName)
+	}
+}
+
+// podDeleteInactive removes the pods that don't belong to the pool or are not ready any more.
+func (ds *datastore) podDeleteInactive(logger logr.Logger, activePods map[string]bool) {
 	ds.pods.Range(func(k, v any) bool {
 		pm := v.(backendmetrics.PodMetrics)
 		if exist := activePods[pm.GetPod().PodName]; !exist {
@@ -309,8 +522,27 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 		}
 		return true
 	})
+}
 
-	return nil
+// podFromEndpoint returns the pod backing an EndpointSlice endpoint, holding its name and address. The name of the
+// pod referenced by the endpoint is used if any, otherwise the name is derived from the endpoint's address.
+func podFromEndpoint(namespace string, endpoint discoveryv1.Endpoint) *corev1.Pod {
+	address := endpoint.Addresses[0]
+	name := strings.NewReplacer(".", "-", ":", "-").Replace(address)
+	if ref := endpoint.TargetRef; ref != nil && ref.Kind == "Pod" && ref.Name != "" {
+		name = ref.Name
+	} else if endpoint.Hostname != nil && *endpoint.Hostname != "" {
+		name = *endpoint.Hostname
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Status: corev1.PodStatus{
+			PodIP: address,
+		},
+	}
 }
 
 func selectorFromInferencePoolSelector(selector map[v1.LabelKey]v1.LabelValue) labels.Selector {
@@ -324,3 +556,16 @@ func stripLabelKeyAliasFromLabelMap(labels map[v1.LabelKey]v1.LabelValue) map[st
 	}
 	return outMap
 }
+
+// /// Remote endpoints APIs ///
+func (ds *datastore) RemoteEndpointsSet(endpoints []backendmetrics.PodMetrics) {
+	ds.remoteMu.Lock()
+	defer ds.remoteMu.Unlock()
+	ds.remote = endpoints
+}
+
+func (ds *datastore) RemoteEndpointList() []backendmetrics.PodMetrics {
+	ds.remoteMu.RLock()
+	defer ds.remoteMu.RUnlock()
+	return append([]backendmetrics.PodMetrics{}, ds.remote...)
+}
@@ -28,10 +28,12 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
@@ -417,6 +419,223 @@ func TestPods(t *testing.T) {
 	}
 }
 
+func TestPodDraining(t *testing.T) {
+	// podStates returns whether each pod of the datastore is draining, by pod name.
+	podStates := func(ds Datastore) map[string]bool {
+		res := make(map[string]bool)
+		for _, pm := range ds.PodList(backendmetrics.AllPodsPredicate) {
+			res[pm.GetPod().PodName] = pm.GetPod().Draining
+		}
+		return res
+	}
+	newDatastore := func(t *testing.T, drainTimeout time.Duration) Datastore {
+		pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+		ds := NewDatastore(t.Context(), pmf, 0, WithDrainTimeout(drainTimeout))
+		if err := ds.PoolSet(context.Background(), fake.NewFakeClient(), inferencePool); err != nil {
+			t.Fatal(err)
+		}
+		ds.PodUpdateOrAddIfNotExist(pod1)
+		ds.PodUpdateOrAddIfNotExist(pod2)
+		return ds
+	}
+
+	t.Run("pod without in-flight requests is removed", func(t *testing.T) {
+		ds := newDatastore(t, time.Minute)
+		ds.PodDelete(pod2.Name)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+	})
+
+	t.Run("pod is drained until its last request finishes", func(t *testing.T) {
+		ds := newDatastore(t, time.Minute)
+		ds.PodRequestStarted(pod2NamespacedName)
+		ds.PodRequestStarted(pod2NamespacedName)
+		ds.PodDelete(pod2.Name)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false, pod2.Name: true}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+		if got := len(ds.PodList(backendmetrics.ServingPodsPredicate)); got != 1 {
+			t.Errorf("Expected 1 serving pod, got %d", got)
+		}
+
+		ds.PodRequestFinished(pod2NamespacedName)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false, pod2.Name: true}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+		ds.PodRequestFinished(pod2NamespacedName)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+	})
+
+	t.Run("pod is removed when the drain timeout expires", func(t *testing.T) {
+		ds := newDatastore(t, 10*time.Millisecond)
+		ds.PodRequestStarted(pod2NamespacedName)
+		ds.PodDelete(pod2.Name)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false, pod2.Name: true}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+		assert.Eventually(t, func() bool {
+			return len(podStates(ds)) == 1
+		}, time.Second, time.Millisecond)
+		// The request finishing after the timeout is ignored.
+		ds.PodRequestFinished(pod2NamespacedName)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+	})
+
+	t.Run("draining pod added back is served again", func(t *testing.T) {
+		ds := newDatastore(t, 10*time.Millisecond)
+		ds.PodRequestStarted(pod2NamespacedName)
+		ds.PodDelete(pod2.Name)
+		ds.PodUpdateOrAddIfNotExist(pod2)
+		time.Sleep(50 * time.Millisecond)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false, pod2.Name: false}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+		ds.PodRequestFinished(pod2NamespacedName)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false, pod2.Name: false}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+	})
+
+	t.Run("pod is removed right away without drain timeout", func(t *testing.T) {
+		ds := newDatastore(t, 0)
+		ds.PodRequestStarted(pod2NamespacedName)
+		ds.PodDelete(pod2.Name)
+		if diff := cmp.Diff(map[string]bool{pod1.Name: false}, podStates(ds)); diff != "" {
+			t.Errorf("Unexpected pods (-want +got): %s", diff)
+		}
+	})
+}
+
+func TestEndpointSlices(t *testing.T) {
+	pool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "ns"},
+		Spec: v1.InferencePoolSpec{
+			TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+		},
+	}
+	endpoint := func(pod, address string, ready, serving, terminating *bool) discoveryv1.Endpoint {
+		ep := discoveryv1.Endpoint{
+			Addresses: []string{address},
+			Conditions: discoveryv1.EndpointConditions{
+				Ready:       ready,
+				Serving:     serving,
+				Terminating: terminating,
+			},
+		}
+		if pod != "" {
+			ep.TargetRef = &corev1.ObjectReference{Kind: "Pod", Name: pod, Namespace: "ns"}
+		}
+		return ep
+	}
+	slice := func(name, service string, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints:   endpoints,
+		}
+	}
+	pod := func(name, address string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}, Status: corev1.PodStatus{PodIP: address}}
+	}
+
+	tests := []struct {
+		name     string
+		service  string
+		slices   []*discoveryv1.EndpointSlice
+		wantPods []*corev1.Pod
+	}{
+		{
+			name: "ready endpoints of the service named after the pool",
+			slices: []*discoveryv1.EndpointSlice{
+				slice("pool-1", "pool", endpoint("pod1", "10.0.0.1", ptr.To(true), ptr.To(true), ptr.To(false))),
+				slice("pool-2", "pool", endpoint("pod2", "10.0.0.2", nil, nil, nil)),
+				slice("other-1", "other", endpoint("pod3", "10.0.0.3", ptr.To(true), ptr.To(true), ptr.To(false))),
+			},
+			wantPods: []*corev1.Pod{pod("pod1", "10.0.0.1"), pod("pod2", "10.0.0.2")},
+		},
+		{
+			name:    "endpoints of a named service",
+			service: "other",
+			slices: []*discoveryv1.EndpointSlice{
+				slice("pool-1", "pool", endpoint("pod1", "10.0.0.1", ptr.To(true), ptr.To(true), ptr.To(false))),
+				slice("other-1", "other", endpoint("pod3", "10.0.0.3", ptr.To(true), ptr.To(true), ptr.To(false))),
+			},
+			wantPods: []*corev1.Pod{pod("pod3", "10.0.0.3")},
+		},
+		{
+			name: "not ready, not serving and terminating endpoints are excluded",
+			slices: []*discoveryv1.EndpointSlice{
+				slice("pool-1", "pool",
+					endpoint("pod1", "10.0.0.1", ptr.To(false), ptr.To(false), ptr.To(false)),
+					endpoint("pod2", "10.0.0.2", nil, ptr.To(false), nil),
+					endpoint("pod3", "10.0.0.3", ptr.To(false), ptr.To(true), ptr.To(true)),
+					endpoint("pod4", "10.0.0.4", ptr.To(true), ptr.To(true), ptr.To(false))),
+			},
+			wantPods: []*corev1.Pod{pod("pod4", "10.0.0.4")},
+		},
+		{
+			name: "endpoints without pod reference are named after their address",
+			slices: []*discoveryv1.EndpointSlice{
+				slice("pool-1", "pool", endpoint("", "10.0.0.1", ptr.To(true), nil, nil)),
+			},
+			wantPods: []*corev1.Pod{pod("10-0-0-1", "10.0.0.1")},
+		},
+		{
+			name: "endpoints listed by several slices are added once",
+			slices: []*discoveryv1.EndpointSlice{
+				slice("pool-1", "pool", endpoint("pod1", "10.0.0.1", ptr.To(true), nil, nil)),
+				slice("pool-2", "pool", endpoint("pod1", "10.0.0.1", ptr.To(true), nil, nil)),
+			},
+			wantPods: []*corev1.Pod{pod("pod1", "10.0.0.1")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			for _, slice := range test.slices {
+				builder = builder.WithObjects(slice)
+			}
+			fakeClient := builder.Build()
+
+			pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+			ds := NewDatastore(t.Context(), pmf, 0, WithEndpointSlices(test.service))
+			if got := ds.EndpointSliceService(); got != "" {
+				t.Errorf("Expected no service before the pool is synced, got %q", got)
+			}
+			if err := ds.PoolSet(ctx, fakeClient, pool); err != nil {
+				t.Fatal(err)
+			}
+			wantService := test.service
+			if wantService == "" {
+				wantService = pool.Name
+			}
+			if got := ds.EndpointSliceService(); got != wantService {
+				t.Errorf("EndpointSliceService() = %q, want %q", got, wantService)
+			}
+
+			var gotPods []*corev1.Pod
+			for _, pm := range ds.PodList(backendmetrics.AllPodsPredicate) {
+				gotPods = append(gotPods, pod(pm.GetPod().PodName, pm.GetPod().GetIPAddress()))
+			}
+			if diff := cmp.Diff(test.wantPods, gotPods, cmpopts.SortSlices(func(a, b *corev1.Pod) bool { return a.Name < b.Name })); diff != "" {
+				t.Errorf("Unexpected pods (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestPodInfo(t *testing.T) {
 	tests := []struct {
 		name         string
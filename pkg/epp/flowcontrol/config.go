@@ -18,6 +18,7 @@ package flowcontrol
 
 import (
 	"fmt"
+	"maps"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/controller"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/registry"
@@ -29,6 +30,12 @@ import (
 type Config struct {
 	Controller controller.Config
 	Registry   registry.Config
+
+	// FairnessWeights maps fairness IDs to their relative share of dispatch within a priority band, as applied by the
+	// weighted inter-flow dispatch policies. Flows with no entry fall back to the weight of their InferenceObjective, or
+	// to 1.
+	// Optional.
+	FairnessWeights map[string]int
 }
 
 // ValidateAndApplyDefaults checks the configuration for validity and populates any empty fields with system defaults.
@@ -43,8 +50,14 @@ func (c *Config) ValidateAndApplyDefaults() (*Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("registry config validation failed: %w", err)
 	}
+	for fairnessID, weight := range c.FairnessWeights {
+		if weight <= 0 {
+			return nil, fmt.Errorf("fairness weight of flow %q must be positive, got %d", fairnessID, weight)
+		}
+	}
 	return &Config{
-		Controller: *validatedControllerCfg,
-		Registry:   *validatedRegistryCfg,
+		Controller:      *validatedControllerCfg,
+		Registry:        *validatedRegistryCfg,
+		FairnessWeights: maps.Clone(c.FairnessWeights),
 	}, nil
 }
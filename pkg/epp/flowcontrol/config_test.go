@@ -60,6 +60,22 @@ func TestConfig_ValidateAndApplyDefaults(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "ShouldSucceed_WithFairnessWeights",
+			input: Config{
+				Registry:        validRegistryConfig,
+				FairnessWeights: map[string]int{"tenant-a": 3, "tenant-b": 1},
+			},
+			expectErr: false,
+		},
+		{
+			name: "ShouldFail_WhenFairnessWeightIsNotPositive",
+			input: Config{
+				Registry:        validRegistryConfig,
+				FairnessWeights: map[string]int{"tenant-a": 0},
+			},
+			expectErr: true,
+		},
 		{
 			name: "ShouldFail_WhenRegistryConfigIsInvalid",
 			input: Config{
@@ -83,6 +99,7 @@ func TestConfig_ValidateAndApplyDefaults(t *testing.T) {
 			} else {
 				require.NoError(t, err, "expected no error but got: %v", err)
 				require.NotNil(t, validatedCfg, "validatedCfg should not be nil on success")
+				assert.Equal(t, tc.input.FairnessWeights, validatedCfg.FairnessWeights, "fairness weights should be preserved")
 			}
 
 			assert.Equal(t, originalInput, tc.input, "input config should not be mutated")
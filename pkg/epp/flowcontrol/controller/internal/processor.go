@@ -281,7 +281,8 @@ func (sp *ShardProcessor) hasCapacity(priority int, itemByteSize uint64) bool {
 
 // dispatchCycle attempts to dispatch a single item by iterating through priority bands from highest to lowest.
 // It applies the configured policies for each band to select an item and then attempts to dispatch it.
-// It returns true if an item was successfully dispatched, and false otherwise.
+// It returns true if an item was successfully dispatched (or evicted early, see `framework.EarlyDropPolicy`), and false
+// otherwise.
 // It enforces Head-of-Line (HoL) blocking if the selected item is saturated.
 //
 // # Work Conservation and Head-of-Line (HoL) Blocking
@@ -308,6 +309,13 @@ func (sp *ShardProcessor) dispatchCycle(ctx context.Context) bool {
 			continue
 		}
 
+		// --- Early Drop ---
+		// An item that can no longer be served in time is evicted rather than dispatched, even under saturation, so that
+		// it releases its capacity and the items behind it get their turn.
+		if sp.dropIfUnreachable(item) {
+			return true
+		}
+
 		// --- Viability Check (Saturation/HoL Blocking) ---
 		req := item.OriginalRequest()
 		candidatePods := req.CandidatePodsForScheduling()
@@ -379,6 +387,42 @@ func (sp *ShardProcessor) dispatchItem(itemAcc types.QueueItemAccessor) error {
 	return nil
 }
 
+// dropIfUnreachable evicts the selected item if its flow's intra-flow dispatch policy implements
+// `framework.EarlyDropPolicy` and determines that the item can no longer be served in time.
+// It returns true if the item was evicted.
+func (sp *ShardProcessor) dropIfUnreachable(itemAcc types.QueueItemAccessor) bool {
+	req := itemAcc.OriginalRequest()
+	key := req.FlowKey()
+	intraP, err := sp.shard.IntraFlowDispatchPolicy(key)
+	if err != nil {
+		return false
+	}
+	dropper, ok := intraP.(framework.EarlyDropPolicy)
+	if !ok || !dropper.ShouldDrop(itemAcc, sp.clock.Now()) {
+		return false
+	}
+	managedQ, err := sp.shard.ManagedQueue(key)
+	if err != nil {
+		sp.logger.Error(err, "Failed to get ManagedQueue to evict an item that can no longer meet its deadline",
+			"flowKey", key, "reqID", req.ID())
+		return false
+	}
+
+	removedItemAcc, err := managedQ.Remove(itemAcc.Handle())
+	if err != nil {
+		// As in dispatchItem, this happens benignly if the item was already removed by the cleanup sweep loop.
+		sp.logger.V(logutil.DEBUG).Info("Failed to remove item during early drop (likely already finalized and swept).",
+			"flowKey", key, "reqID", req.ID(), "error", err)
+		return true
+	}
+
+	removedItem := removedItemAcc.(*FlowItem)
+	sp.logger.V(logutil.DEBUG).Info("Item can no longer meet its deadline; evicting.", "flowKey", key, "reqID", req.ID())
+	removedItem.FinalizeWithOutcome(types.QueueOutcomeEvictedDeadlineUnreachable,
+		fmt.Errorf("%w: %w", types.ErrEvicted, types.ErrDeadlineUnreachable))
+	return true
+}
+
 // runCleanupSweep starts a background goroutine that periodically scans all queues for externally finalized items
 // ("zombie" items) and removes them in batches.
 func (sp *ShardProcessor) runCleanupSweep(ctx context.Context) {
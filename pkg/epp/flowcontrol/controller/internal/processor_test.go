@@ -85,6 +85,18 @@ type testHarness struct {
 	// Customizable policy logic for tests to override.
 	interFlowPolicySelectQueue func(band framework.PriorityBandAccessor) (framework.FlowQueueAccessor, error)
 	intraFlowPolicySelectItem  func(fqa framework.FlowQueueAccessor) (types.QueueItemAccessor, error)
+	// intraFlowPolicyShouldDrop, if set, makes the intra-flow policy implement `framework.EarlyDropPolicy`.
+	intraFlowPolicyShouldDrop func(item types.QueueItemAccessor, now time.Time) bool
+}
+
+// earlyDropIntraFlowPolicy is a mock intra-flow policy that also implements `framework.EarlyDropPolicy`.
+type earlyDropIntraFlowPolicy struct {
+	*frameworkmocks.MockIntraFlowDispatchPolicy
+	shouldDrop func(item types.QueueItemAccessor, now time.Time) bool
+}
+
+func (p *earlyDropIntraFlowPolicy) ShouldDrop(item types.QueueItemAccessor, now time.Time) bool {
+	return p.shouldDrop(item, now)
 }
 
 // newTestHarness creates and wires up a complete testing harness.
@@ -284,6 +296,9 @@ func (h *testHarness) intraFlowDispatchPolicy(types.FlowKey) (framework.IntraFlo
 	policy.SelectItemFunc = func(fqa framework.FlowQueueAccessor) (types.QueueItemAccessor, error) {
 		return fqa.PeekHead()
 	}
+	if h.intraFlowPolicyShouldDrop != nil {
+		return &earlyDropIntraFlowPolicy{MockIntraFlowDispatchPolicy: policy, shouldDrop: h.intraFlowPolicyShouldDrop}, nil
+	}
 	return policy, nil
 }
 
@@ -894,6 +909,62 @@ func TestShardProcessor(t *testing.T) {
 			})
 		})
 
+		t.Run("early drop", func(t *testing.T) {
+			t.Parallel()
+
+			testCases := []struct {
+				name        string
+				shouldDrop  bool
+				expectCycle bool
+			}{
+				{
+					name:        "should evict an item that can no longer meet its deadline, even under saturation",
+					shouldDrop:  true,
+					expectCycle: true,
+				},
+				{
+					name:        "should keep an item that can still meet its deadline",
+					shouldDrop:  false,
+					expectCycle: false,
+				},
+			}
+
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					t.Parallel()
+					h := newTestHarness(t, testCleanupTick)
+					q := h.addQueue(testFlow)
+					item := h.newTestItem("item", testFlow, testTTL)
+					require.NoError(t, q.Add(item))
+					h.saturationDetector.IsSaturatedFunc = func(_ context.Context, _ []metrics.PodMetrics) bool {
+						return true
+					}
+					var droppedAt time.Time
+					h.intraFlowPolicyShouldDrop = func(_ types.QueueItemAccessor, now time.Time) bool {
+						droppedAt = now
+						return tc.shouldDrop
+					}
+
+					didWork := h.processor.dispatchCycle(context.Background())
+
+					assert.Equal(t, tc.expectCycle, didWork, "Dispatch cycle result should match expected value")
+					assert.Equal(t, h.clock.Now(), droppedAt, "The policy should be consulted with the processor's clock")
+					if !tc.shouldDrop {
+						assert.Nil(t, item.FinalState(), "Item should remain queued")
+						assert.Equal(t, 1, q.Len(), "Queue should still hold the item")
+						return
+					}
+					finalState := item.FinalState()
+					require.NotNil(t, finalState, "Item should be finalized")
+					assert.Equal(t, types.QueueOutcomeEvictedDeadlineUnreachable, finalState.Outcome,
+						"Item should be evicted as unable to meet its deadline")
+					assert.ErrorIs(t, finalState.Err, types.ErrEvicted, "Error should wrap ErrEvicted")
+					assert.ErrorIs(t, finalState.Err, types.ErrDeadlineUnreachable, "Error should wrap ErrDeadlineUnreachable")
+					assert.Equal(t, 0, q.Len(), "Item should be removed from the queue")
+				})
+			}
+		})
+
 		t.Run("dispatchItem", func(t *testing.T) {
 			t.Parallel()
 
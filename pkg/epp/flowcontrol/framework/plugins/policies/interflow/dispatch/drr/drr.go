@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drr provides a `framework.InterFlowDispatchPolicy` that shares dispatch within a priority band between flows
+// by request cost, using deficit round robin (DRR).
+//
+// Flows take turns in a fixed order. Each time a flow gets the turn, its deficit counter is credited with a quantum,
+// and the flow keeps the turn for as long as its deficit covers the cost of its head request, which is then deducted.
+// Unlike plain round robin, a flow sending large requests therefore gets proportionally fewer dispatches than a flow
+// sending small ones, so that each continuously backlogged flow receives an equal share of the dispatched cost. A flow
+// whose queue empties loses its deficit, so it cannot accumulate credit while idle.
+//
+// The cost of a request is its `EstimatedCost()` if it implements `types.CostEstimatingFlowControlRequest` (the EPP
+// estimates it in tokens, giving token-fair sharing), and its `ByteSize()` otherwise (giving byte-fair sharing). If the
+// request implements `types.WeightedFlowControlRequest`, the quantum of its flow is scaled by its fairness weight.
+package drr
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/interflow/dispatch"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+)
+
+// DeficitRoundRobinPolicyName is the name of the Deficit Round Robin policy implementation.
+const DeficitRoundRobinPolicyName = "DeficitRoundRobin"
+
+// defaultQuantum is the cost credited to a flow each time it gets the turn. Whatever its value, the long-run shares of
+// the flows are the same; it only sets how much cost a flow may dispatch in a single turn.
+const defaultQuantum = 1024
+
+func init() {
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(DeficitRoundRobinPolicyName),
+		func() (framework.InterFlowDispatchPolicy, error) {
+			return newDRR(defaultQuantum), nil
+		})
+}
+
+// costOf returns the cost charged to a flow for dispatching the given item, with a minimum of 1.
+func costOf(item types.QueueItemAccessor) uint64 {
+	req := item.OriginalRequest()
+	cost := req.ByteSize()
+	if estimating, ok := req.(types.CostEstimatingFlowControlRequest); ok {
+		cost = estimating.EstimatedCost()
+	}
+	return max(cost, 1)
+}
+
+// weightOf returns the fairness weight carried by the item's request, defaulting to 1.
+func weightOf(item types.QueueItemAccessor) uint64 {
+	if req, ok := item.OriginalRequest().(types.WeightedFlowControlRequest); ok && req.FairnessWeight() > 1 {
+		return uint64(req.FairnessWeight())
+	}
+	return 1
+}
+
+// flowState holds the deficit counter of a single backlogged flow.
+type flowState struct {
+	deficit uint64
+	// charged is the last item whose cost was deducted from the deficit, so that it is not charged again if it is
+	// re-selected before being dispatched.
+	charged types.QueueItemAccessor
+}
+
+// candidate is a non-empty queue of the band, along with its head item.
+type candidate struct {
+	id      string
+	queue   framework.FlowQueueAccessor
+	head    types.QueueItemAccessor
+	cost    uint64
+	quantum uint64
+}
+
+// drr implements the `framework.InterFlowDispatchPolicy` interface using deficit round robin.
+type drr struct {
+	quantum uint64
+
+	mu sync.Mutex
+	// current is the ID of the flow holding the turn, or empty if no flow holds it.
+	current string
+	flows   map[string]*flowState
+}
+
+func newDRR(quantum uint64) *drr {
+	return &drr{
+		quantum: quantum,
+		flows:   make(map[string]*flowState),
+	}
+}
+
+// Name returns the name of the policy.
+func (p *drr) Name() string {
+	return DeficitRoundRobinPolicyName
+}
+
+// SelectQueue selects the queue of the flow holding the turn if its deficit covers the cost of its head request, and
+// otherwise passes the turn on, in flow key order, until a flow's deficit does. It returns nil if all queues in the
+// band are empty.
+func (p *drr) SelectQueue(band framework.PriorityBandAccessor) (framework.FlowQueueAccessor, error) {
+	if band == nil {
+		return nil, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	candidates := p.candidates(band)
+	p.forgetIdleFlows(candidates)
+	if len(candidates) == 0 {
+		p.current = ""
+		return nil, nil
+	}
+
+	// Find where the turn is. If the flow holding it is no longer backlogged, the turn passes to the next flow in order.
+	start, held := slices.BinarySearchFunc(candidates, p.current, func(c candidate, id string) int {
+		return strings.Compare(c.id, id)
+	})
+	start %= len(candidates)
+
+	for visit := 0; ; visit++ {
+		c := candidates[(start+visit)%len(candidates)]
+		state := p.state(c.id)
+		if state.charged == c.head {
+			// The head was selected before but not dispatched; select it again without charging it twice.
+			p.current = c.id
+			return c.queue, nil
+		}
+		if visit > 0 || !held {
+			state.deficit += c.quantum
+		}
+		if state.deficit >= c.cost {
+			state.deficit -= c.cost
+			state.charged = c.head
+			p.current = c.id
+			return c.queue, nil
+		}
+		if (visit+1)%len(candidates) == 0 {
+			p.skipIdleRounds(candidates)
+		}
+	}
+}
+
+// candidates returns the non-empty queues of the band, sorted by flow ID.
+func (p *drr) candidates(band framework.PriorityBandAccessor) []candidate {
+	keys := band.FlowKeys()
+	candidates := make([]candidate, 0, len(keys))
+	for _, key := range keys {
+		queue := band.Queue(key.ID)
+		if queue == nil || queue.Len() == 0 {
+			continue
+		}
+		head, err := queue.PeekHead()
+		if err != nil || head == nil {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			id:      key.ID,
+			queue:   queue,
+			head:    head,
+			cost:    costOf(head),
+			quantum: p.quantum * weightOf(head),
+		})
+	}
+	// Sort for a deterministic turn order.
+	slices.SortFunc(candidates, func(a, b candidate) int { return strings.Compare(a.id, b.id) })
+	return candidates
+}
+
+// state returns the state of the flow, creating it if needed.
+func (p *drr) state(flowID string) *flowState {
+	state, ok := p.flows[flowID]
+	if !ok {
+		state = &flowState{}
+		p.flows[flowID] = state
+	}
+	return state
+}
+
+// forgetIdleFlows drops the state, and thus the deficit, of the flows that are no longer backlogged.
+func (p *drr) forgetIdleFlows(candidates []candidate) {
+	backlogged := make(map[string]struct{}, len(candidates))
+	for _, c := range candidates {
+		backlogged[c.id] = struct{}{}
+	}
+	for id := range p.flows {
+		if _, ok := backlogged[id]; !ok {
+			delete(p.flows, id)
+		}
+	}
+}
+
+// skipIdleRounds credits every candidate with the quanta of the rounds that would pass, after a round in which no
+// flow could dispatch, before some flow's deficit covers its head request. This bounds the work of a selection when
+// request costs are much larger than the quantum, without changing the outcome.
+func (p *drr) skipIdleRounds(candidates []candidate) {
+	rounds := uint64(0)
+	for i, c := range candidates {
+		missing := c.cost - p.flows[c.id].deficit // The deficit is below the cost, otherwise c would have been selected.
+		needed := (missing+c.quantum-1)/c.quantum - 1
+		if i == 0 || needed < rounds {
+			rounds = needed
+		}
+	}
+	for _, c := range candidates {
+		p.flows[c.id].deficit += rounds * c.quantum
+	}
+}
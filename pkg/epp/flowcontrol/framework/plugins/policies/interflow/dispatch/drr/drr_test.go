@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	frameworkmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	typesmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types/mocks"
+)
+
+var (
+	flow1Key = types.FlowKey{ID: "flow1", Priority: 0}
+	flow2Key = types.FlowKey{ID: "flow2", Priority: 0}
+	flow3Key = types.FlowKey{ID: "flow3", Priority: 0}
+)
+
+// costedRequest is a request carrying a cost estimate and a fairness weight.
+type costedRequest struct {
+	*typesmocks.MockFlowControlRequest
+	cost   uint64
+	weight int
+}
+
+func (r *costedRequest) EstimatedCost() uint64 { return r.cost }
+func (r *costedRequest) FairnessWeight() int   { return r.weight }
+
+var (
+	_ types.CostEstimatingFlowControlRequest = &costedRequest{}
+	_ types.WeightedFlowControlRequest       = &costedRequest{}
+)
+
+// newQueue returns a queue of the given flow whose head is a request of the given byte size.
+func newQueue(key types.FlowKey, byteSize uint64) *frameworkmocks.MockFlowQueueAccessor {
+	return &frameworkmocks.MockFlowQueueAccessor{
+		LenV:      1,
+		FlowKeyV:  key,
+		PeekHeadV: typesmocks.NewMockQueueItemAccessor(byteSize, key.ID, key),
+	}
+}
+
+// newCostedQueue returns a queue of the given flow whose head is a request of the given byte size, cost estimate and
+// fairness weight.
+func newCostedQueue(key types.FlowKey, byteSize, cost uint64, weight int) *frameworkmocks.MockFlowQueueAccessor {
+	queue := newQueue(key, byteSize)
+	queue.PeekHeadV = &typesmocks.MockQueueItemAccessor{OriginalRequestV: &costedRequest{
+		MockFlowControlRequest: typesmocks.NewMockFlowControlRequest(byteSize, key.ID, key),
+		cost:                   cost,
+		weight:                 weight,
+	}}
+	return queue
+}
+
+func newBand(queues ...*frameworkmocks.MockFlowQueueAccessor) framework.PriorityBandAccessor {
+	return &frameworkmocks.MockPriorityBandAccessor{
+		FlowKeysFunc: func() []types.FlowKey {
+			keys := make([]types.FlowKey, 0, len(queues))
+			for _, q := range queues {
+				keys = append(keys, q.FlowKeyV)
+			}
+			return keys
+		},
+		QueueFunc: func(id string) framework.FlowQueueAccessor {
+			for _, q := range queues {
+				if q.FlowKeyV.ID == id {
+					return q
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// dispatchN selects a queue n times and returns the IDs of the selected flows, in order. After each selection, the head
+// of the selected queue is replaced by a new item for the same request, keeping every flow backlogged.
+func dispatchN(t *testing.T, policy *drr, band framework.PriorityBandAccessor, n int) []string {
+	t.Helper()
+	selected := make([]string, 0, n)
+	for range n {
+		queue, err := policy.SelectQueue(band)
+		require.NoError(t, err, "SelectQueue should not error on a valid band")
+		require.NotNil(t, queue, "SelectQueue should select a queue from a backlogged band")
+		mock := queue.(*frameworkmocks.MockFlowQueueAccessor)
+		mock.PeekHeadV = &typesmocks.MockQueueItemAccessor{OriginalRequestV: mock.PeekHeadV.OriginalRequest()}
+		selected = append(selected, mock.FlowKeyV.ID)
+	}
+	return selected
+}
+
+// shares returns the fraction of the total of the given per-flow amount dispatched by each flow.
+func shares(selected []string, amount map[string]uint64) map[string]float64 {
+	totals := make(map[string]uint64)
+	var total uint64
+	for _, id := range selected {
+		totals[id] += amount[id]
+		total += amount[id]
+	}
+	shares := make(map[string]float64, len(totals))
+	for id, t := range totals {
+		shares[id] = float64(t) / float64(total)
+	}
+	return shares
+}
+
+func TestDRR_Name(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(defaultQuantum)
+	assert.Equal(t, DeficitRoundRobinPolicyName, policy.Name(), "Name should match the policy's constant")
+}
+
+func TestDRR_SelectQueue_ByteShareConverges(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(defaultQuantum)
+	band := newBand(newQueue(flow1Key, 4000), newQueue(flow2Key, 100), newQueue(flow3Key, 1000))
+
+	byteShares := shares(dispatchN(t, policy, band, 20000), map[string]uint64{"flow1": 4000, "flow2": 100, "flow3": 1000})
+	for _, id := range []string{"flow1", "flow2", "flow3"} {
+		assert.InDelta(t, 1.0/3, byteShares[id], 0.01, "%s should receive a third of the dispatched bytes", id)
+	}
+}
+
+func TestDRR_SelectQueue_EstimatedCostOverridesByteSize(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(defaultQuantum)
+	band := newBand(newCostedQueue(flow1Key, 100, 1000, 0), newCostedQueue(flow2Key, 1000, 100, 0))
+
+	selected := dispatchN(t, policy, band, 11000)
+	costShares := shares(selected, map[string]uint64{"flow1": 1000, "flow2": 100})
+	assert.InDelta(t, 0.5, costShares["flow1"], 0.01, "flow1 should receive half of the dispatched cost")
+	assert.InDelta(t, 0.5, costShares["flow2"], 0.01, "flow2 should receive half of the dispatched cost")
+	requestShares := shares(selected, map[string]uint64{"flow1": 1, "flow2": 1})
+	assert.InDelta(t, 10.0/11, requestShares["flow2"], 0.01,
+		"flow2 should dispatch ten times more requests, despite their larger byte size")
+}
+
+func TestDRR_SelectQueue_WeightScalesShare(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(defaultQuantum)
+	band := newBand(newCostedQueue(flow1Key, 500, 500, 1), newCostedQueue(flow2Key, 500, 500, 3))
+
+	costShares := shares(dispatchN(t, policy, band, 8000), map[string]uint64{"flow1": 500, "flow2": 500})
+	assert.InDelta(t, 0.25, costShares["flow1"], 0.01, "flow1 should receive a quarter of the dispatched cost")
+	assert.InDelta(t, 0.75, costShares["flow2"], 0.01, "flow2 should receive three quarters of the dispatched cost")
+}
+
+func TestDRR_SelectQueue_FlowKeepsTurnWhileDeficitLasts(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(1000)
+	band := newBand(newQueue(flow1Key, 250), newQueue(flow2Key, 500))
+
+	// Each turn credits a quantum of 1000, which covers four requests of flow1 or two of flow2.
+	expected := []string{"flow1", "flow1", "flow1", "flow1", "flow2", "flow2", "flow1", "flow1", "flow1", "flow1"}
+	assert.Equal(t, expected, dispatchN(t, policy, band, len(expected)), "Flows should take turns by deficit")
+}
+
+func TestDRR_SelectQueue_RequestsLargerThanQuantum(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(1)
+	band := newBand(newQueue(flow1Key, 1_000_000), newQueue(flow2Key, 500_000))
+
+	// flow2 accumulates enough deficit for its head first, then both flows dispatch the same number of bytes.
+	expected := []string{"flow2", "flow1", "flow2", "flow2", "flow1", "flow2"}
+	assert.Equal(t, expected, dispatchN(t, policy, band, len(expected)),
+		"Turns should be skipped until a flow can dispatch")
+}
+
+func TestDRR_SelectQueue_ReselectionKeepsDeficit(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(1000)
+	band := newBand(newQueue(flow1Key, 400), newQueue(flow2Key, 400))
+
+	for range 3 {
+		selected, err := policy.SelectQueue(band)
+		require.NoError(t, err, "SelectQueue should not error on a valid band")
+		require.Equal(t, "flow1", selected.FlowKey().ID, "The undispatched head of flow1 should be re-selected")
+		assert.Equal(t, uint64(600), policy.flows["flow1"].deficit,
+			"The cost of the head of flow1 should be deducted a single time")
+	}
+}
+
+func TestDRR_SelectQueue_IdleFlowLosesDeficit(t *testing.T) {
+	t.Parallel()
+	policy := newDRR(1000)
+	queue1 := newQueue(flow1Key, 300)
+	band := newBand(queue1, newQueue(flow2Key, 300))
+
+	// flow1 gets the turn and is left with a deficit of 100 after three requests.
+	assert.Equal(t, []string{"flow1", "flow1", "flow1"}, dispatchN(t, policy, band, 3), "flow1 should hold the turn")
+
+	// flow1 empties, so its leftover deficit is dropped and flow2 gets the turn.
+	queue1.LenV = 0
+	assert.Equal(t, []string{"flow2"}, dispatchN(t, policy, band, 1), "flow2 should get the turn")
+	assert.NotContains(t, policy.flows, "flow1", "The state of an idle flow should be dropped")
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wfq provides a `framework.InterFlowDispatchPolicy` that divides dispatch within a priority band between
+// flows in proportion to their fairness weights, using weighted fair queuing (WFQ).
+//
+// The policy tracks a virtual clock for the band. When a request first reaches the head of its flow's queue, it is
+// charged to the flow by assigning it a virtual start tag (the later of the flow's previous finish tag and the band's
+// virtual time) and a virtual finish tag (the start tag plus the request's cost divided by the flow's weight). The flow
+// with the earliest finish tag is selected, and the band's virtual time advances to the start tag of the selected flow.
+// Over time, every continuously backlogged flow receives a share of dispatched cost proportional to its weight, and a
+// flow that becomes active after being idle re-enters at the current virtual time instead of redeeming credit for the
+// time it was idle.
+//
+// Two variants are registered:
+//   - "WeightedFairQueuing" charges each request a cost of 1, dividing the number of dispatched requests.
+//   - "WeightedFairQueuingByTokens" charges each request its estimated token count, dividing the volume of
+//     dispatched tokens. The estimate is the request's `EstimatedCost()` if it implements
+//     `types.CostEstimatingFlowControlRequest`, and is derived from its byte size otherwise.
+//
+// A flow's weight is taken from its head request if the request implements `types.WeightedFlowControlRequest`, and
+// defaults to 1 otherwise.
+package wfq
+
+import (
+	"math"
+	"slices"
+	"sync"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/interflow/dispatch"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+const (
+	// WeightedFairQueuingPolicyName is the name of the WFQ policy variant that divides dispatch by request count.
+	WeightedFairQueuingPolicyName = "WeightedFairQueuing"
+	// WeightedFairQueuingByTokensPolicyName is the name of the WFQ policy variant that divides dispatch by estimated
+	// token count.
+	WeightedFairQueuingByTokensPolicyName = "WeightedFairQueuingByTokens"
+)
+
+func init() {
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(WeightedFairQueuingPolicyName),
+		func() (framework.InterFlowDispatchPolicy, error) {
+			return newWFQ(WeightedFairQueuingPolicyName, requestCost), nil
+		})
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(WeightedFairQueuingByTokensPolicyName),
+		func() (framework.InterFlowDispatchPolicy, error) {
+			return newWFQ(WeightedFairQueuingByTokensPolicyName, tokenCost), nil
+		})
+}
+
+// costFunc returns the cost charged to a flow for dispatching the given item. It must return a positive value.
+type costFunc func(item types.QueueItemAccessor) float64
+
+// requestCost charges every request the same unit cost.
+func requestCost(types.QueueItemAccessor) float64 {
+	return 1
+}
+
+// tokenCost charges a request its estimated token count, with a minimum of 1.
+func tokenCost(item types.QueueItemAccessor) float64 {
+	req := item.OriginalRequest()
+	if estimating, ok := req.(types.CostEstimatingFlowControlRequest); ok {
+		return max(float64(estimating.EstimatedCost()), 1)
+	}
+	tokens := math.Ceil(float64(req.ByteSize()) / requtil.DefaultCharactersPerToken)
+	return max(tokens, 1)
+}
+
+// weightOf returns the fairness weight carried by the item's request, defaulting to 1.
+func weightOf(item types.QueueItemAccessor) float64 {
+	if req, ok := item.OriginalRequest().(types.WeightedFlowControlRequest); ok && req.FairnessWeight() > 1 {
+		return float64(req.FairnessWeight())
+	}
+	return 1
+}
+
+// flowState holds the virtual-time tags of a single flow.
+type flowState struct {
+	start  float64
+	finish float64
+	// charged is the head item the tags were computed for. The next item to reach the head starts from this item's
+	// finish tag.
+	charged types.QueueItemAccessor
+}
+
+// wfq implements the `framework.InterFlowDispatchPolicy` interface using weighted fair queuing.
+type wfq struct {
+	name string
+	cost costFunc
+
+	mu          sync.Mutex
+	virtualTime float64
+	flows       map[string]*flowState
+}
+
+func newWFQ(name string, cost costFunc) *wfq {
+	return &wfq{
+		name:  name,
+		cost:  cost,
+		flows: make(map[string]*flowState),
+	}
+}
+
+// Name returns the name of the policy.
+func (p *wfq) Name() string {
+	return p.name
+}
+
+// SelectQueue selects the non-empty queue whose head request has the earliest virtual finish tag. Ties are broken by
+// flow key to keep the selection deterministic. It returns nil if all queues in the band are empty.
+func (p *wfq) SelectQueue(band framework.PriorityBandAccessor) (framework.FlowQueueAccessor, error) {
+	if band == nil {
+		return nil, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keys := band.FlowKeys()
+	// Sort for deterministic tie-breaking.
+	slices.SortFunc(keys, func(a, b types.FlowKey) int { return a.Compare(b) })
+
+	var (
+		bestQueue             framework.FlowQueueAccessor
+		bestStart, bestFinish float64
+		active                = make(map[string]struct{}, len(keys))
+	)
+	for _, key := range keys {
+		queue := band.Queue(key.ID)
+		if queue == nil || queue.Len() == 0 {
+			continue
+		}
+		head, err := queue.PeekHead()
+		if err != nil || head == nil {
+			continue
+		}
+		active[key.ID] = struct{}{}
+
+		state := p.charge(key.ID, head)
+		if bestQueue == nil || state.finish < bestFinish {
+			bestQueue, bestStart, bestFinish = queue, state.start, state.finish
+		}
+	}
+
+	if bestQueue != nil {
+		p.virtualTime = max(p.virtualTime, bestStart)
+	}
+	p.pruneIdleFlows(active)
+	return bestQueue, nil
+}
+
+// charge returns the state of the flow with its tags computed for the given head item. The item is charged to the flow
+// only the first time it is observed at the head, so that a head that is selected but cannot be dispatched (e.g.,
+// because the backends are saturated) is not charged again when it is re-selected.
+func (p *wfq) charge(flowID string, head types.QueueItemAccessor) *flowState {
+	state, ok := p.flows[flowID]
+	if !ok {
+		state = &flowState{finish: p.virtualTime}
+		p.flows[flowID] = state
+	}
+	if state.charged != head {
+		state.start = max(p.virtualTime, state.finish)
+		state.finish = state.start + p.cost(head)/weightOf(head)
+		state.charged = head
+	}
+	return state
+}
+
+// pruneIdleFlows forgets flows that have no queued work and whose finish tag has been reached by the band's virtual
+// time. Such flows hold no outstanding credit or debt, so they would re-enter at the current virtual time regardless.
+func (p *wfq) pruneIdleFlows(active map[string]struct{}) {
+	for id, state := range p.flows {
+		if _, ok := active[id]; !ok && state.finish <= p.virtualTime {
+			delete(p.flows, id)
+		}
+	}
+}
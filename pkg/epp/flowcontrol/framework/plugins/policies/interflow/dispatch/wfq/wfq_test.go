@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	frameworkmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	typesmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types/mocks"
+)
+
+var (
+	flow1Key = types.FlowKey{ID: "flow1", Priority: 0}
+	flow2Key = types.FlowKey{ID: "flow2", Priority: 0}
+	flow3Key = types.FlowKey{ID: "flow3", Priority: 0}
+)
+
+// weightedRequest is a `types.WeightedFlowControlRequest` used to give test flows a fairness weight.
+type weightedRequest struct {
+	*typesmocks.MockFlowControlRequest
+	weight int
+}
+
+func (r *weightedRequest) FairnessWeight() int { return r.weight }
+
+// estimatingRequest is a `types.CostEstimatingFlowControlRequest` carrying a fixed cost estimate.
+type estimatingRequest struct {
+	*typesmocks.MockFlowControlRequest
+	cost uint64
+}
+
+func (r *estimatingRequest) EstimatedCost() uint64 { return r.cost }
+
+// newQueue returns a queue of the given flow whose head is a request of the given size and weight. A weight of zero
+// means the request carries no weight at all.
+func newQueue(key types.FlowKey, byteSize uint64, weight int) *frameworkmocks.MockFlowQueueAccessor {
+	head := typesmocks.NewMockQueueItemAccessor(byteSize, key.ID, key)
+	if weight != 0 {
+		head.OriginalRequestV = &weightedRequest{
+			MockFlowControlRequest: head.OriginalRequestV.(*typesmocks.MockFlowControlRequest),
+			weight:                 weight,
+		}
+	}
+	return &frameworkmocks.MockFlowQueueAccessor{LenV: 1, FlowKeyV: key, PeekHeadV: head}
+}
+
+func newBand(queues ...*frameworkmocks.MockFlowQueueAccessor) framework.PriorityBandAccessor {
+	return &frameworkmocks.MockPriorityBandAccessor{
+		FlowKeysFunc: func() []types.FlowKey {
+			keys := make([]types.FlowKey, 0, len(queues))
+			for _, q := range queues {
+				keys = append(keys, q.FlowKeyV)
+			}
+			return keys
+		},
+		QueueFunc: func(id string) framework.FlowQueueAccessor {
+			for _, q := range queues {
+				if q.FlowKeyV.ID == id {
+					return q
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// dispatchN selects a queue n times and returns the number of selections of each flow. After each selection, the head
+// of the selected queue is replaced by a new item for the same request, keeping every flow backlogged.
+func dispatchN(t *testing.T, policy *wfq, n int, queues ...*frameworkmocks.MockFlowQueueAccessor) map[string]int {
+	t.Helper()
+	band := newBand(queues...)
+	selections := make(map[string]int)
+	for range n {
+		selected, err := policy.SelectQueue(band)
+		require.NoError(t, err, "SelectQueue should not error on a valid band")
+		require.NotNil(t, selected, "SelectQueue should select a queue from a backlogged band")
+		queue := selected.(*frameworkmocks.MockFlowQueueAccessor)
+		queue.PeekHeadV = &typesmocks.MockQueueItemAccessor{OriginalRequestV: queue.PeekHeadV.OriginalRequest()}
+		selections[queue.FlowKeyV.ID]++
+	}
+	return selections
+}
+
+func TestWFQ_Name(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, WeightedFairQueuingPolicyName, newWFQ(WeightedFairQueuingPolicyName, requestCost).Name(),
+		"Name should match the policy's constant")
+	assert.Equal(t, WeightedFairQueuingByTokensPolicyName,
+		newWFQ(WeightedFairQueuingByTokensPolicyName, tokenCost).Name(), "Name should match the policy's constant")
+}
+
+func TestWFQ_SelectQueue_RequestShareConvergesToWeights(t *testing.T) {
+	t.Parallel()
+	policy := newWFQ(WeightedFairQueuingPolicyName, requestCost)
+
+	const total = 8000
+	// Request sizes do not matter when dispatch is divided by request count.
+	selections := dispatchN(t, policy, total,
+		newQueue(flow1Key, 100, 1), newQueue(flow2Key, 100, 3), newQueue(flow3Key, 4000, 4))
+
+	assert.InDelta(t, 1.0/8, float64(selections["flow1"])/total, 0.01, "flow1 should receive 1/8 of dispatches")
+	assert.InDelta(t, 3.0/8, float64(selections["flow2"])/total, 0.01, "flow2 should receive 3/8 of dispatches")
+	assert.InDelta(t, 4.0/8, float64(selections["flow3"])/total, 0.01, "flow3 should receive 4/8 of dispatches")
+}
+
+func TestWFQ_SelectQueue_TokenShareConvergesToWeights(t *testing.T) {
+	t.Parallel()
+	policy := newWFQ(WeightedFairQueuingByTokensPolicyName, tokenCost)
+
+	// The requests of the flows are estimated at 100, 10 and 50 tokens respectively.
+	selections := dispatchN(t, policy, 10000,
+		newQueue(flow1Key, 400, 1), newQueue(flow2Key, 40, 1), newQueue(flow3Key, 200, 2))
+
+	tokens := map[string]float64{
+		"flow1": 100 * float64(selections["flow1"]),
+		"flow2": 10 * float64(selections["flow2"]),
+		"flow3": 50 * float64(selections["flow3"]),
+	}
+	total := tokens["flow1"] + tokens["flow2"] + tokens["flow3"]
+	assert.InDelta(t, 0.25, tokens["flow1"]/total, 0.01, "flow1 should receive 1/4 of tokens")
+	assert.InDelta(t, 0.25, tokens["flow2"]/total, 0.01, "flow2 should receive 1/4 of tokens")
+	assert.InDelta(t, 0.5, tokens["flow3"]/total, 0.01, "flow3 should receive 1/2 of tokens")
+}
+
+func TestWFQ_TokenCost(t *testing.T) {
+	t.Parallel()
+	key := types.FlowKey{ID: "flow1"}
+
+	testCases := []struct {
+		name     string
+		request  types.FlowControlRequest
+		expected float64
+	}{
+		{
+			name:     "derived from byte size",
+			request:  typesmocks.NewMockFlowControlRequest(401, "req", key),
+			expected: 101,
+		},
+		{
+			name:     "empty request costs one token",
+			request:  typesmocks.NewMockFlowControlRequest(0, "req", key),
+			expected: 1,
+		},
+		{
+			name: "estimated cost overrides byte size",
+			request: &estimatingRequest{
+				MockFlowControlRequest: typesmocks.NewMockFlowControlRequest(400, "req", key),
+				cost:                   356,
+			},
+			expected: 356,
+		},
+		{
+			name: "zero estimated cost costs one token",
+			request: &estimatingRequest{
+				MockFlowControlRequest: typesmocks.NewMockFlowControlRequest(400, "req", key),
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			item := &typesmocks.MockQueueItemAccessor{OriginalRequestV: tc.request}
+			assert.Equal(t, tc.expected, tokenCost(item), "tokenCost should match the expected cost")
+		})
+	}
+}
+
+func TestWFQ_SelectQueue_ChargesHeadOnce(t *testing.T) {
+	t.Parallel()
+	policy := newWFQ(WeightedFairQueuingPolicyName, requestCost)
+	band := newBand(newQueue(flow1Key, 100, 1), newQueue(flow2Key, 100, 1))
+
+	for range 5 {
+		selected, err := policy.SelectQueue(band)
+		require.NoError(t, err, "SelectQueue should not error on a valid band")
+		require.Equal(t, "flow1", selected.FlowKey().ID, "The undispatched head of flow1 should be re-selected")
+		assert.Equal(t, 1.0, policy.flows["flow1"].finish, "The head of flow1 should be charged a single time")
+		assert.Equal(t, 0.0, policy.virtualTime, "Re-selecting the same head should not advance the virtual time")
+	}
+}
+
+func TestWFQ_SelectQueue_IdleFlowDoesNotBankCredit(t *testing.T) {
+	t.Parallel()
+	policy := newWFQ(WeightedFairQueuingPolicyName, requestCost)
+	queue1 := newQueue(flow1Key, 100, 1)
+
+	dispatchN(t, policy, 100, queue1)
+
+	// flow2 becomes active after flow1 has had the band to itself. It should share the band from now on rather than
+	// being compensated for the time it was idle.
+	selections := dispatchN(t, policy, 20, queue1, newQueue(flow2Key, 100, 1))
+	assert.InDelta(t, 10, selections["flow1"], 1, "flow1 should keep receiving half of the dispatches")
+	assert.InDelta(t, 10, selections["flow2"], 1, "flow2 should receive half of the dispatches")
+}
+
+func TestWFQ_SelectQueue_DefaultsToUnitWeight(t *testing.T) {
+	t.Parallel()
+	policy := newWFQ(WeightedFairQueuingPolicyName, requestCost)
+
+	selections := dispatchN(t, policy, 99,
+		newQueue(flow1Key, 100, -2), newQueue(flow2Key, 100, 0), newQueue(flow3Key, 100, 1))
+	assert.Equal(t, map[string]int{"flow1": 33, "flow2": 33, "flow3": 33}, selections,
+		"Unweighted requests and non-positive weights should both count as a weight of 1")
+}
+
+func TestWFQ_SelectQueue_PrunesIdleFlows(t *testing.T) {
+	t.Parallel()
+	policy := newWFQ(WeightedFairQueuingPolicyName, requestCost)
+	queue1 := newQueue(flow1Key, 100, 1)
+	queue2 := newQueue(flow2Key, 100, 1)
+
+	dispatchN(t, policy, 10, queue1, queue2)
+	require.Contains(t, policy.flows, "flow2", "The state of a backlogged flow should be kept")
+
+	queue2.LenV = 0
+	queue2.PeekHeadV = nil
+	queue2.PeekHeadErrV = framework.ErrQueueEmpty
+	dispatchN(t, policy, 2, queue1, queue2)
+	assert.NotContains(t, policy.flows, "flow2", "The state of an idle flow should be dropped once it holds no credit")
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package edf provides an Earliest-Deadline-First implementation of the `framework.IntraFlowDispatchPolicy`.
+package edf
+
+import (
+	"errors"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/intraflow/dispatch"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+)
+
+// EDFPolicyName is the name of the EDF policy implementation.
+//
+// This policy implements an Earliest-Deadline-First (EDF) strategy by selecting the item with the earliest absolute
+// deadline. The deadline of an item is its logical enqueue time plus its effective TTL, which the
+// `controller.FlowController` derives from the request's `InitialEffectiveTTL()`, the deadline of the request's
+// context, or its default TTL. Items without a deadline (a zero effective TTL) are dispatched after all the items that
+// have one. Items with the same deadline are dispatched in FCFS order.
+//
+// # Queue Pairing
+//
+// Unlike FCFS, the dispatch order of this policy does not follow the arrival order, so it requires a queue with the
+// `CapabilityPriorityConfigurable` capability (like "MaxMinHeap") to keep its items ordered by deadline.
+const EDFPolicyName = "EDF"
+
+// EDFWithEarlyDropPolicyName is the name of the EDF policy variant that evicts items which can no longer meet their
+// deadline.
+//
+// It orders items like the `EDFPolicyName` policy, but implements `framework.EarlyDropPolicy` to evict the selected
+// item instead of dispatching it when less than `minimumSlack` remains before its deadline. Such an item would most
+// likely miss its deadline anyway, and evicting it early releases its capacity and lets the items behind it through.
+// The inter-flow policy of the band has already charged the item to its flow when it is evicted, and the charge is
+// not refunded.
+const EDFWithEarlyDropPolicyName = "EDFWithEarlyDrop"
+
+// minimumSlack is the minimum time that must remain before an item's deadline for the `EDFWithEarlyDropPolicyName`
+// policy to dispatch it.
+const minimumSlack = time.Second
+
+func init() {
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(EDFPolicyName),
+		func() (framework.IntraFlowDispatchPolicy, error) {
+			return newEDF(), nil
+		})
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(EDFWithEarlyDropPolicyName),
+		func() (framework.IntraFlowDispatchPolicy, error) {
+			return newEDFWithEarlyDrop(minimumSlack), nil
+		})
+}
+
+// edf is the internal implementation of the EDF policy.
+// See the documentation for the exported `EDFPolicyName` constant for detailed user-facing information about its
+// behavior.
+type edf struct {
+	comparator framework.ItemComparator
+}
+
+// newEDF creates a new `edf` policy instance.
+func newEDF() *edf {
+	return &edf{
+		comparator: &deadlineComparator{},
+	}
+}
+
+// Name returns the name of the policy.
+func (p *edf) Name() string {
+	return EDFPolicyName
+}
+
+// SelectItem selects the next item from the queue by peeking its head. This implementation relies on the queue being
+// ordered by this policy's comparator, as indicated by its `RequiredQueueCapabilities`.
+func (p *edf) SelectItem(queue framework.FlowQueueAccessor) (types.QueueItemAccessor, error) {
+	if queue == nil {
+		return nil, nil
+	}
+	item, err := queue.PeekHead()
+	if errors.Is(err, framework.ErrQueueEmpty) {
+		return nil, nil
+	}
+	return item, err
+}
+
+// Comparator returns a `framework.ItemComparator` based on the items' deadlines.
+func (p *edf) Comparator() framework.ItemComparator {
+	return p.comparator
+}
+
+// RequiredQueueCapabilities returns `CapabilityPriorityConfigurable`, as the queue must order items by deadline.
+func (p *edf) RequiredQueueCapabilities() []framework.QueueCapability {
+	return []framework.QueueCapability{framework.CapabilityPriorityConfigurable}
+}
+
+// edfWithEarlyDrop is the internal implementation of the EDF policy variant that evicts items which can no longer meet
+// their deadline.
+// See the documentation for the exported `EDFWithEarlyDropPolicyName` constant for details.
+type edfWithEarlyDrop struct {
+	*edf
+	minimumSlack time.Duration
+}
+
+var _ framework.EarlyDropPolicy = &edfWithEarlyDrop{}
+
+// newEDFWithEarlyDrop creates a new `edfWithEarlyDrop` policy instance.
+func newEDFWithEarlyDrop(minimumSlack time.Duration) *edfWithEarlyDrop {
+	return &edfWithEarlyDrop{
+		edf:          newEDF(),
+		minimumSlack: minimumSlack,
+	}
+}
+
+// Name returns the name of the policy.
+func (p *edfWithEarlyDrop) Name() string {
+	return EDFWithEarlyDropPolicyName
+}
+
+// ShouldDrop reports whether less than the minimum slack remains before the item's deadline. Items without a deadline
+// are never dropped.
+func (p *edfWithEarlyDrop) ShouldDrop(item types.QueueItemAccessor, now time.Time) bool {
+	deadline, ok := deadlineOf(item)
+	return ok && deadline.Sub(now) < p.minimumSlack
+}
+
+// deadlineOf returns the absolute deadline of the item, and false if the item has none.
+func deadlineOf(item types.QueueItemAccessor) (time.Time, bool) {
+	if item.EffectiveTTL() <= 0 {
+		return time.Time{}, false
+	}
+	return item.EnqueueTime().Add(item.EffectiveTTL()), true
+}
+
+// --- deadlineComparator ---
+
+// deadlineComparator implements `framework.ItemComparator` for EDF logic.
+// It prioritizes items with earlier deadlines, then items with earlier enqueue times.
+type deadlineComparator struct{}
+
+// Func returns the comparison logic.
+// It returns true if item 'a' should be dispatched before item 'b'.
+func (c *deadlineComparator) Func() framework.ItemComparatorFunc {
+	return func(a, b types.QueueItemAccessor) bool {
+		if a == nil && b == nil {
+			return false
+		}
+		if a == nil { // Treat nil as lowest priority
+			return false
+		}
+		if b == nil { // Treat non-nil 'a' as higher priority than nil 'b'
+			return true
+		}
+		deadlineA, okA := deadlineOf(a)
+		deadlineB, okB := deadlineOf(b)
+		switch {
+		case okA && !okB: // An item with a deadline goes before an item without one.
+			return true
+		case !okA && okB:
+			return false
+		case okA && okB && !deadlineA.Equal(deadlineB):
+			return deadlineA.Before(deadlineB)
+		}
+		return a.EnqueueTime().Before(b.EnqueueTime())
+	}
+}
+
+// ScoreType returns a string descriptor for the comparison logic.
+func (c *deadlineComparator) ScoreType() string {
+	return string(framework.DeadlinePriorityScoreType)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	frameworkmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	typesmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types/mocks"
+)
+
+var testFlowKey = types.FlowKey{ID: "test-flow", Priority: 0}
+
+func newItem(id string, enqueueTime time.Time, ttl time.Duration) *typesmocks.MockQueueItemAccessor {
+	item := typesmocks.NewMockQueueItemAccessor(10, id, testFlowKey)
+	item.EnqueueTimeV = enqueueTime
+	item.EffectiveTTLV = ttl
+	return item
+}
+
+func TestEDF_Name(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, EDFPolicyName, newEDF().Name())
+	assert.Equal(t, EDFWithEarlyDropPolicyName, newEDFWithEarlyDrop(minimumSlack).Name())
+}
+
+func TestEDF_RequiredQueueCapabilities(t *testing.T) {
+	t.Parallel()
+	policy := newEDF()
+	assert.Equal(t, []framework.QueueCapability{framework.CapabilityPriorityConfigurable},
+		policy.RequiredQueueCapabilities(), "The queue must be ordered by the policy's comparator")
+}
+
+func TestEDF_SelectItem(t *testing.T) {
+	t.Parallel()
+	// Note: The conformance suite validates the policy's contract for nil and empty queues.
+	// This unit test focuses on the policy-specific success path.
+	policy := newEDF()
+
+	mockItem := typesmocks.NewMockQueueItemAccessor(1, "item1", testFlowKey)
+	mockQueue := &frameworkmocks.MockFlowQueueAccessor{
+		PeekHeadV: mockItem,
+		LenV:      1,
+	}
+
+	item, err := policy.SelectItem(mockQueue)
+	require.NoError(t, err)
+	assert.Equal(t, mockItem, item, "Should return the item from the head of the queue")
+}
+
+func TestDeadlineComparator_Func(t *testing.T) {
+	t.Parallel()
+	comparator := &deadlineComparator{} // Test the internal comparator directly
+	compareFunc := comparator.Func()
+	require.NotNil(t, compareFunc)
+
+	now := time.Now()
+	// A arrives first but has a later deadline than B.
+	itemA := newItem("itemA", now, 10*time.Second)
+	itemB := newItem("itemB", now.Add(time.Second), 5*time.Second)
+	// C has the same deadline as B but arrives later.
+	itemC := newItem("itemC", now.Add(2*time.Second), 4*time.Second)
+	// D and E have no deadline.
+	itemD := newItem("itemD", now, 0)
+	itemE := newItem("itemE", now.Add(time.Second), 0)
+
+	testCases := []struct {
+		name     string
+		item1    types.QueueItemAccessor
+		item2    types.QueueItemAccessor
+		expected bool // true if item1 is higher priority (earlier deadline) than item2
+	}{
+		{"B (earlier deadline) before A", itemB, itemA, true},
+		{"A (later deadline) after B", itemA, itemB, false},
+		{"B before C (same deadline, earlier enqueue)", itemB, itemC, true},
+		{"C after B (same deadline, later enqueue)", itemC, itemB, false},
+		{"A (deadline) before D (no deadline)", itemA, itemD, true},
+		{"D (no deadline) after A (deadline)", itemD, itemA, false},
+		{"D before E (no deadlines, earlier enqueue)", itemD, itemE, true},
+		{"E after D (no deadlines, later enqueue)", itemE, itemD, false},
+		{"A not strictly before itself", itemA, itemA, false},
+		{"A vs nil B (A is preferred)", itemA, nil, true},
+		{"nil A vs B (B is preferred)", nil, itemB, false},
+		{"nil A vs nil B (no preference)", nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, compareFunc(tc.item1, tc.item2))
+		})
+	}
+}
+
+func TestDeadlineComparator_ScoreType(t *testing.T) {
+	t.Parallel()
+	comparator := &deadlineComparator{}
+	assert.Equal(t, string(framework.DeadlinePriorityScoreType), comparator.ScoreType())
+}
+
+func TestEDF_IsNotEarlyDropPolicy(t *testing.T) {
+	t.Parallel()
+	var policy framework.IntraFlowDispatchPolicy = newEDF()
+	_, ok := policy.(framework.EarlyDropPolicy)
+	assert.False(t, ok, "The plain EDF policy must never drop items early")
+}
+
+func TestEDFWithEarlyDrop_ShouldDrop(t *testing.T) {
+	t.Parallel()
+	policy := newEDFWithEarlyDrop(time.Second)
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		item     types.QueueItemAccessor
+		expected bool
+	}{
+		{"ample slack", newItem("item", now.Add(-time.Second), 10*time.Second), false},
+		{"exactly the minimum slack", newItem("item", now, time.Second), false},
+		{"less than the minimum slack", newItem("item", now.Add(-9500*time.Millisecond), 10*time.Second), true},
+		{"deadline passed", newItem("item", now.Add(-time.Minute), 10*time.Second), true},
+		{"no deadline", newItem("item", now.Add(-time.Hour), 0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, policy.ShouldDrop(tc.item, now))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sjf provides a Shortest-Job-First implementation of the `framework.IntraFlowDispatchPolicy`.
+package sjf
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/policies/intraflow/dispatch"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+)
+
+// SJFPolicyName is the name of the SJF policy implementation.
+//
+// This policy implements a Shortest-Job-First (SJF) strategy by selecting the item with the smallest predicted service
+// cost, which minimizes the average latency of the flow. The predicted cost of an item is the `EstimatedCost()` of its
+// request if it implements `types.CostEstimatingFlowControlRequest` (the EPP estimates it from the prompt size and
+// `max_tokens`), and its `ByteSize()` otherwise. Items with the same predicted cost are dispatched in FCFS order.
+//
+// Under sustained load, a large request can be overtaken by smaller ones indefinitely. The `SJFWithAgingPolicyName`
+// variant bounds this starvation.
+//
+// # Queue Pairing
+//
+// Unlike FCFS, the dispatch order of this policy does not follow the arrival order, so it requires a queue with the
+// `CapabilityPriorityConfigurable` capability (like "MaxMinHeap") to keep its items ordered by predicted cost.
+const SJFPolicyName = "SJF"
+
+// SJFWithAgingPolicyName is the name of the SJF policy variant that ages items to prevent starvation.
+//
+// It orders items like the `SJFPolicyName` policy, except that each `agingInterval` an item waits offsets one unit of
+// its predicted cost. An item therefore overtakes any item enqueued later whose predicted cost is not larger than its
+// own by more than the time between their enqueue times divided by `agingInterval`. As this ordering does not change
+// over time, it can still be maintained by a priority queue.
+const SJFWithAgingPolicyName = "SJFWithAging"
+
+// agingInterval is the waiting time that offsets one unit of predicted cost (i.e., one token for the requests of the
+// EPP) for the `SJFWithAgingPolicyName` policy. With 1ms, a request that waited for a second is dispatched before a
+// request enqueued now whose predicted cost is up to 1000 tokens smaller.
+const agingInterval = time.Millisecond
+
+func init() {
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(SJFPolicyName),
+		func() (framework.IntraFlowDispatchPolicy, error) {
+			return newSJF(), nil
+		})
+	dispatch.MustRegisterPolicy(dispatch.RegisteredPolicyName(SJFWithAgingPolicyName),
+		func() (framework.IntraFlowDispatchPolicy, error) {
+			return newSJFWithAging(agingInterval), nil
+		})
+}
+
+// sjf is the internal implementation of the SJF policy and its aging variant.
+// See the documentation for the exported `SJFPolicyName` and `SJFWithAgingPolicyName` constants for detailed
+// user-facing information about their behavior.
+type sjf struct {
+	name       string
+	comparator framework.ItemComparator
+}
+
+// newSJF creates a new `sjf` policy instance without aging.
+func newSJF() *sjf {
+	return &sjf{
+		name:       SJFPolicyName,
+		comparator: &predictedCostComparator{},
+	}
+}
+
+// newSJFWithAging creates a new `sjf` policy instance in which each `interval` of waiting offsets one unit of
+// predicted cost.
+func newSJFWithAging(interval time.Duration) *sjf {
+	return &sjf{
+		name:       SJFWithAgingPolicyName,
+		comparator: &agedPredictedCostComparator{interval: interval},
+	}
+}
+
+// Name returns the name of the policy.
+func (p *sjf) Name() string {
+	return p.name
+}
+
+// SelectItem selects the next item from the queue by peeking its head. This implementation relies on the queue being
+// ordered by this policy's comparator, as indicated by its `RequiredQueueCapabilities`.
+func (p *sjf) SelectItem(queue framework.FlowQueueAccessor) (types.QueueItemAccessor, error) {
+	if queue == nil {
+		return nil, nil
+	}
+	item, err := queue.PeekHead()
+	if errors.Is(err, framework.ErrQueueEmpty) {
+		return nil, nil
+	}
+	return item, err
+}
+
+// Comparator returns a `framework.ItemComparator` based on the items' predicted costs.
+func (p *sjf) Comparator() framework.ItemComparator {
+	return p.comparator
+}
+
+// RequiredQueueCapabilities returns `CapabilityPriorityConfigurable`, as the queue must order items by predicted cost.
+func (p *sjf) RequiredQueueCapabilities() []framework.QueueCapability {
+	return []framework.QueueCapability{framework.CapabilityPriorityConfigurable}
+}
+
+// predictedCostOf returns the predicted service cost of the item's request.
+func predictedCostOf(item types.QueueItemAccessor) uint64 {
+	req := item.OriginalRequest()
+	if estimating, ok := req.(types.CostEstimatingFlowControlRequest); ok {
+		return estimating.EstimatedCost()
+	}
+	return req.ByteSize()
+}
+
+// compareNil orders nil items after non-nil ones. It reports whether either item is nil and, if so, whether 'a' should
+// be dispatched before 'b'.
+func compareNil(a, b types.QueueItemAccessor) (less, isNil bool) {
+	switch {
+	case a == nil: // Treat nil as lowest priority
+		return false, true
+	case b == nil: // Treat non-nil 'a' as higher priority than nil 'b'
+		return true, true
+	}
+	return false, false
+}
+
+// --- predictedCostComparator ---
+
+// predictedCostComparator implements `framework.ItemComparator` for SJF logic.
+// It prioritizes items with smaller predicted costs, then items with earlier enqueue times.
+type predictedCostComparator struct{}
+
+// Func returns the comparison logic.
+// It returns true if item 'a' should be dispatched before item 'b'.
+func (c *predictedCostComparator) Func() framework.ItemComparatorFunc {
+	return func(a, b types.QueueItemAccessor) bool {
+		if less, isNil := compareNil(a, b); isNil {
+			return less
+		}
+		costA, costB := predictedCostOf(a), predictedCostOf(b)
+		if costA != costB {
+			return costA < costB
+		}
+		return a.EnqueueTime().Before(b.EnqueueTime())
+	}
+}
+
+// ScoreType returns a string descriptor for the comparison logic.
+func (c *predictedCostComparator) ScoreType() string {
+	return string(framework.PredictedCostPriorityScoreType)
+}
+
+// --- agedPredictedCostComparator ---
+
+// agedPredictedCostComparator implements `framework.ItemComparator` for SJF logic with aging.
+// It prioritizes items with the earliest enqueue time plus predicted cost, converted to a duration at one `interval`
+// per unit of cost.
+type agedPredictedCostComparator struct {
+	interval time.Duration
+}
+
+// agedScore returns the enqueue time of the item delayed by its predicted cost.
+func (c *agedPredictedCostComparator) agedScore(item types.QueueItemAccessor) time.Time {
+	cost := min(predictedCostOf(item), uint64(math.MaxInt64/int64(c.interval)))
+	return item.EnqueueTime().Add(time.Duration(cost) * c.interval)
+}
+
+// Func returns the comparison logic.
+// It returns true if item 'a' should be dispatched before item 'b'.
+func (c *agedPredictedCostComparator) Func() framework.ItemComparatorFunc {
+	return func(a, b types.QueueItemAccessor) bool {
+		if less, isNil := compareNil(a, b); isNil {
+			return less
+		}
+		scoreA, scoreB := c.agedScore(a), c.agedScore(b)
+		if !scoreA.Equal(scoreB) {
+			return scoreA.Before(scoreB)
+		}
+		return a.EnqueueTime().Before(b.EnqueueTime())
+	}
+}
+
+// ScoreType returns a string descriptor for the comparison logic.
+func (c *agedPredictedCostComparator) ScoreType() string {
+	return string(framework.AgedPredictedCostPriorityScoreType)
+}
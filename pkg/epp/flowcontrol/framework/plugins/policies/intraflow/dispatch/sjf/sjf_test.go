@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sjf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework"
+	frameworkmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+	typesmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types/mocks"
+)
+
+var testFlowKey = types.FlowKey{ID: "test-flow", Priority: 0}
+
+// costedRequest is a request carrying a cost estimate.
+type costedRequest struct {
+	*typesmocks.MockFlowControlRequest
+	cost uint64
+}
+
+func (r *costedRequest) EstimatedCost() uint64 { return r.cost }
+
+var _ types.CostEstimatingFlowControlRequest = &costedRequest{}
+
+// newItem creates an item enqueued at the given time whose request has the given estimated cost.
+func newItem(id string, enqueueTime time.Time, cost uint64) *typesmocks.MockQueueItemAccessor {
+	item := typesmocks.NewMockQueueItemAccessor(10, id, testFlowKey)
+	item.EnqueueTimeV = enqueueTime
+	item.OriginalRequestV = &costedRequest{
+		MockFlowControlRequest: typesmocks.NewMockFlowControlRequest(10, id, testFlowKey),
+		cost:                   cost,
+	}
+	return item
+}
+
+func TestSJF_Name(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, SJFPolicyName, newSJF().Name())
+	assert.Equal(t, SJFWithAgingPolicyName, newSJFWithAging(agingInterval).Name())
+}
+
+func TestSJF_RequiredQueueCapabilities(t *testing.T) {
+	t.Parallel()
+	policy := newSJF()
+	assert.Equal(t, []framework.QueueCapability{framework.CapabilityPriorityConfigurable},
+		policy.RequiredQueueCapabilities(), "The queue must be ordered by the policy's comparator")
+}
+
+func TestSJF_SelectItem(t *testing.T) {
+	t.Parallel()
+	// Note: The conformance suite validates the policy's contract for nil and empty queues.
+	// This unit test focuses on the policy-specific success path.
+	policy := newSJF()
+
+	mockItem := typesmocks.NewMockQueueItemAccessor(1, "item1", testFlowKey)
+	mockQueue := &frameworkmocks.MockFlowQueueAccessor{
+		PeekHeadV: mockItem,
+		LenV:      1,
+	}
+
+	item, err := policy.SelectItem(mockQueue)
+	require.NoError(t, err)
+	assert.Equal(t, mockItem, item, "Should return the item from the head of the queue")
+}
+
+func TestPredictedCostComparator_Func(t *testing.T) {
+	t.Parallel()
+	comparator := &predictedCostComparator{} // Test the internal comparator directly
+	compareFunc := comparator.Func()
+	require.NotNil(t, compareFunc)
+
+	now := time.Now()
+	// A arrives first but is larger than B.
+	itemA := newItem("itemA", now, 1000)
+	itemB := newItem("itemB", now.Add(time.Hour), 10)
+	// C has the same cost as B but arrives later.
+	itemC := newItem("itemC", now.Add(2*time.Hour), 10)
+	// D does not estimate its cost, so its byte size is used.
+	itemD := typesmocks.NewMockQueueItemAccessor(100, "itemD", testFlowKey)
+	itemD.EnqueueTimeV = now
+
+	testCases := []struct {
+		name     string
+		item1    types.QueueItemAccessor
+		item2    types.QueueItemAccessor
+		expected bool // true if item1 is higher priority (smaller) than item2
+	}{
+		{"B (smaller) before A", itemB, itemA, true},
+		{"A (larger) after B", itemA, itemB, false},
+		{"B before C (same cost, earlier enqueue)", itemB, itemC, true},
+		{"C after B (same cost, later enqueue)", itemC, itemB, false},
+		{"D (byte size) before A", itemD, itemA, true},
+		{"B before D (byte size)", itemB, itemD, true},
+		{"A not strictly before itself", itemA, itemA, false},
+		{"A vs nil B (A is preferred)", itemA, nil, true},
+		{"nil A vs B (B is preferred)", nil, itemB, false},
+		{"nil A vs nil B (no preference)", nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, compareFunc(tc.item1, tc.item2))
+		})
+	}
+}
+
+func TestPredictedCostComparator_ScoreType(t *testing.T) {
+	t.Parallel()
+	comparator := &predictedCostComparator{}
+	assert.Equal(t, string(framework.PredictedCostPriorityScoreType), comparator.ScoreType())
+}
+
+func TestAgedPredictedCostComparator_Func(t *testing.T) {
+	t.Parallel()
+	comparator := &agedPredictedCostComparator{interval: time.Millisecond}
+	compareFunc := comparator.Func()
+	require.NotNil(t, compareFunc)
+
+	now := time.Now()
+	// A waited long enough to overtake B, which is 1000 units smaller but arrived 2s later.
+	itemA := newItem("itemA", now, 1100)
+	itemB := newItem("itemB", now.Add(2*time.Second), 100)
+	// C is 1000 units smaller than A but arrived only 500ms later.
+	itemC := newItem("itemC", now.Add(500*time.Millisecond), 100)
+	// D arrived 1s after E and is 1000 units smaller, which exactly offsets the wait.
+	itemD := newItem("itemD", now.Add(time.Second), 100)
+	itemE := newItem("itemE", now, 1100)
+	// F has a cost that would overflow the conversion to a duration.
+	itemF := newItem("itemF", now, ^uint64(0))
+
+	testCases := []struct {
+		name     string
+		item1    types.QueueItemAccessor
+		item2    types.QueueItemAccessor
+		expected bool // true if item1 is higher priority than item2
+	}{
+		{"A (aged) before B", itemA, itemB, true},
+		{"B after A (aged)", itemB, itemA, false},
+		{"C (smaller) before A", itemC, itemA, true},
+		{"A after C (smaller)", itemA, itemC, false},
+		{"E before D (same score, earlier enqueue)", itemE, itemD, true},
+		{"D after E (same score, later enqueue)", itemD, itemE, false},
+		{"A before F (overflowing cost)", itemA, itemF, true},
+		{"A not strictly before itself", itemA, itemA, false},
+		{"A vs nil B (A is preferred)", itemA, nil, true},
+		{"nil A vs B (B is preferred)", nil, itemB, false},
+		{"nil A vs nil B (no preference)", nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, compareFunc(tc.item1, tc.item2))
+		})
+	}
+}
+
+func TestAgedPredictedCostComparator_ScoreType(t *testing.T) {
+	t.Parallel()
+	comparator := &agedPredictedCostComparator{interval: time.Millisecond}
+	assert.Equal(t, string(framework.AgedPredictedCostPriorityScoreType), comparator.ScoreType())
+}
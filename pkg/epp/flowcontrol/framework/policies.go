@@ -16,7 +16,11 @@ limitations under the License.
 
 package framework
 
-import "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+import (
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/types"
+)
 
 // PriorityScoreType is a descriptor for the domain of a policy's item comparator.
 type PriorityScoreType string
@@ -25,6 +29,18 @@ const (
 	// EnqueueTimePriorityScoreType indicates that the priority is based on the item's enqueue time, with earlier times
 	// being higher priority.
 	EnqueueTimePriorityScoreType PriorityScoreType = "enqueue_time_ns_asc"
+
+	// DeadlinePriorityScoreType indicates that the priority is based on the item's absolute deadline, with earlier
+	// deadlines being higher priority.
+	DeadlinePriorityScoreType PriorityScoreType = "deadline_ns_asc"
+
+	// PredictedCostPriorityScoreType indicates that the priority is based on the predicted service cost of the item's
+	// request, with smaller costs being higher priority.
+	PredictedCostPriorityScoreType PriorityScoreType = "predicted_cost_asc"
+
+	// AgedPredictedCostPriorityScoreType indicates that the priority is based on the predicted service cost of the
+	// item's request, converted to a duration and added to its enqueue time, with earlier results being higher priority.
+	AgedPredictedCostPriorityScoreType PriorityScoreType = "aged_predicted_cost_ns_asc"
 )
 
 // ItemComparatorFunc defines the function signature for comparing two `types.QueueItemAccessor` instances to determine
@@ -119,6 +135,17 @@ type IntraFlowDispatchPolicy interface {
 	RequiredQueueCapabilities() []QueueCapability
 }
 
+// EarlyDropPolicy is an optional interface for `IntraFlowDispatchPolicy` implementations that evict items which can no
+// longer be served in time, rather than dispatching them.
+type EarlyDropPolicy interface {
+	// ShouldDrop reports whether the item selected by `SelectItem` should be evicted instead of dispatched, given the
+	// current time. The `controller.FlowController` evicts such items with `types.ErrDeadlineUnreachable`, regardless of
+	// whether the backends are saturated.
+	//
+	// Conformance: Implementations MUST be goroutine-safe.
+	ShouldDrop(item types.QueueItemAccessor, now time.Time) bool
+}
+
 // InterFlowDispatchPolicy selects which flow's queue to service next from a given priority band.
 // Implementations define the fairness or dispatch ordering logic between different flows that share the same priority
 // level.
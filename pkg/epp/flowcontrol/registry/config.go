@@ -19,6 +19,7 @@ package registry
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
@@ -73,9 +74,16 @@ type Config struct {
 	// PriorityBands defines the set of priority band templates managed by the `FlowRegistry`.
 	// The configuration for each band, including its default policies and queue types, is specified here.
 	// These templates are used to generate partitioned `ShardPriorityBandConfig`s.
-	// Required: At least one `PriorityBandConfig` must be provided for a functional registry.
+	// Required: At least one `PriorityBandConfig` must be provided for a functional registry, unless a
+	// `PriorityBandTemplate` is set.
 	PriorityBands []PriorityBandConfig
 
+	// PriorityBandTemplate is the template of the priority bands provisioned at runtime through
+	// `FlowRegistry.SyncPriorityBands`, for priority levels that have no band in `PriorityBands`. Its `Priority` and
+	// `PriorityName` are ignored: a provisioned band is named after its priority level.
+	// Optional: When nil, the registry only manages the bands of `PriorityBands`.
+	PriorityBandTemplate *PriorityBandConfig
+
 	// InitialShardCount specifies the number of parallel shards to create when the registry is initialized.
 	// This value must be greater than zero.
 	// Optional: Defaults to `defaultInitialShardCount` (1).
@@ -219,7 +227,7 @@ func (c *Config) ValidateAndApplyDefaults() (*Config, error) {
 		cfg.queueFactory = queue.NewQueueFromName
 	}
 
-	if len(cfg.PriorityBands) == 0 {
+	if len(cfg.PriorityBands) == 0 && cfg.PriorityBandTemplate == nil {
 		return nil, errors.New("config validation failed: at least one priority band must be defined")
 	}
 
@@ -243,27 +251,70 @@ func (c *Config) ValidateAndApplyDefaults() (*Config, error) {
 		}
 		priorityNames[band.PriorityName] = struct{}{}
 
-		if band.IntraFlowDispatchPolicy == "" {
-			band.IntraFlowDispatchPolicy = defaultIntraFlowDispatchPolicy
-		}
-		if band.InterFlowDispatchPolicy == "" {
-			band.InterFlowDispatchPolicy = defaultInterFlowDispatchPolicy
-		}
-		if band.Queue == "" {
-			band.Queue = defaultQueue
-		}
-		if band.MaxBytes == 0 {
-			band.MaxBytes = defaultPriorityBandMaxBytes
-		}
-
+		applyBandDefaults(band)
 		if err := cfg.validateBandCompatibility(*band); err != nil {
 			return nil, err
 		}
 		cfg.priorityBandMap[band.Priority] = band
 	}
+
+	if cfg.PriorityBandTemplate != nil {
+		applyBandDefaults(cfg.PriorityBandTemplate)
+		if err := cfg.validateBandCompatibility(*cfg.PriorityBandTemplate); err != nil {
+			return nil, fmt.Errorf("invalid priority band template: %w", err)
+		}
+	}
 	return cfg, nil
 }
 
+// applyBandDefaults populates the empty fields of a priority band with system defaults.
+func applyBandDefaults(band *PriorityBandConfig) {
+	if band.IntraFlowDispatchPolicy == "" {
+		band.IntraFlowDispatchPolicy = defaultIntraFlowDispatchPolicy
+	}
+	if band.InterFlowDispatchPolicy == "" {
+		band.InterFlowDispatchPolicy = defaultInterFlowDispatchPolicy
+	}
+	if band.Queue == "" {
+		band.Queue = defaultQueue
+	}
+	if band.MaxBytes == 0 {
+		band.MaxBytes = defaultPriorityBandMaxBytes
+	}
+}
+
+// newProvisionedBand creates the configuration of a priority band provisioned at runtime from the template.
+// Expects the template to be set.
+func (c *Config) newProvisionedBand(priority int) PriorityBandConfig {
+	band := *c.PriorityBandTemplate
+	band.Priority = priority
+	band.PriorityName = fmt.Sprintf("priority-%d", priority)
+	return band
+}
+
+// addBand adds a validated priority band to the configuration.
+func (c *Config) addBand(band PriorityBandConfig) {
+	c.PriorityBands = append(c.PriorityBands, band)
+	c.rebuildPriorityBandMap()
+}
+
+// removeBand removes the priority band of the given priority level from the configuration.
+func (c *Config) removeBand(priority int) {
+	c.PriorityBands = slices.DeleteFunc(c.PriorityBands, func(band PriorityBandConfig) bool {
+		return band.Priority == priority
+	})
+	c.rebuildPriorityBandMap()
+}
+
+// rebuildPriorityBandMap rebuilds the lookup map, whose pointers are invalidated when the `PriorityBands` slice changes.
+func (c *Config) rebuildPriorityBandMap() {
+	c.priorityBandMap = make(map[int]*PriorityBandConfig, len(c.PriorityBands))
+	for i := range c.PriorityBands {
+		band := &c.PriorityBands[i]
+		c.priorityBandMap[band.Priority] = band
+	}
+}
+
 // validateBandCompatibility verifies that a band's configured queue type has the necessary capabilities.
 func (c *Config) validateBandCompatibility(band PriorityBandConfig) error {
 	policy, err := c.intraFlowDispatchPolicyFactory(band.IntraFlowDispatchPolicy)
@@ -319,14 +370,7 @@ func (c *Config) partition(shardIndex, totalShards int) *ShardConfig {
 	}
 
 	for i, template := range c.PriorityBands {
-		shardBandCfg := ShardPriorityBandConfig{
-			Priority:                template.Priority,
-			PriorityName:            template.PriorityName,
-			IntraFlowDispatchPolicy: template.IntraFlowDispatchPolicy,
-			InterFlowDispatchPolicy: template.InterFlowDispatchPolicy,
-			Queue:                   template.Queue,
-			MaxBytes:                partitionUint64(template.MaxBytes, shardIndex, totalShards),
-		}
+		shardBandCfg := partitionBand(template, shardIndex, totalShards)
 		shardCfg.PriorityBands[i] = shardBandCfg
 
 		// Crucial: We must take the address of the element within the new slice (`shardCfg.PriorityBands`) to ensure the
@@ -336,6 +380,18 @@ func (c *Config) partition(shardIndex, totalShards int) *ShardConfig {
 	return shardCfg
 }
 
+// partitionBand derives the `ShardPriorityBandConfig` of a priority band for a specific shard index.
+func partitionBand(template PriorityBandConfig, shardIndex, totalShards int) ShardPriorityBandConfig {
+	return ShardPriorityBandConfig{
+		Priority:                template.Priority,
+		PriorityName:            template.PriorityName,
+		IntraFlowDispatchPolicy: template.IntraFlowDispatchPolicy,
+		InterFlowDispatchPolicy: template.InterFlowDispatchPolicy,
+		Queue:                   template.Queue,
+		MaxBytes:                partitionUint64(template.MaxBytes, shardIndex, totalShards),
+	}
+}
+
 // partitionUint64 distributes a total uint64 value across a number of partitions.
 // It distributes the remainder of the division one by one to the first few partitions.
 func partitionUint64(total uint64, partitionIndex, totalPartitions int) uint64 {
@@ -444,6 +500,10 @@ func (c *Config) deepCopy() *Config {
 
 	// PriorityBandConfig contains only value types, so a slice copy is sufficient for a deep copy.
 	copy(newCfg.PriorityBands, c.PriorityBands)
+	if c.PriorityBandTemplate != nil {
+		template := *c.PriorityBandTemplate
+		newCfg.PriorityBandTemplate = &template
+	}
 
 	if c.priorityBandMap != nil {
 		newCfg.priorityBandMap = make(map[int]*PriorityBandConfig, len(c.PriorityBands))
@@ -156,6 +156,24 @@ func TestConfig_ValidateAndApplyDefaults(t *testing.T) {
 					"Original config should not be mutated by changes to the new config")
 			},
 		},
+		{
+			name: "ShouldApplyTemplateDefaults_WhenOnlyTemplateIsDefined",
+			input: Config{
+				PriorityBands:        []PriorityBandConfig{},
+				PriorityBandTemplate: &PriorityBandConfig{MaxBytes: 500},
+			},
+			assertion: func(t *testing.T, originalCfg Config, newCfg *Config) {
+				require.NotNil(t, newCfg.PriorityBandTemplate, "PriorityBandTemplate should be kept")
+				assert.Equal(t, defaultIntraFlowDispatchPolicy, newCfg.PriorityBandTemplate.IntraFlowDispatchPolicy,
+					"Template IntraFlowDispatchPolicy should be defaulted")
+				assert.Equal(t, defaultInterFlowDispatchPolicy, newCfg.PriorityBandTemplate.InterFlowDispatchPolicy,
+					"Template InterFlowDispatchPolicy should be defaulted")
+				assert.Equal(t, defaultQueue, newCfg.PriorityBandTemplate.Queue, "Template Queue should be defaulted")
+				assert.Equal(t, uint64(500), newCfg.PriorityBandTemplate.MaxBytes,
+					"Template MaxBytes should retain its specified value")
+				assert.Empty(t, originalCfg.PriorityBandTemplate.Queue, "Original template should not be mutated")
+			},
+		},
 		// --- Input Validation Errors ---
 		{
 			name:      "ShouldError_WhenNoPriorityBandsAreDefined",
@@ -207,6 +225,24 @@ func TestConfig_ValidateAndApplyDefaults(t *testing.T) {
 			expectErr:     true,
 			expectedErrIs: contracts.ErrPolicyQueueIncompatible,
 		},
+		{
+			name: "ShouldError_WhenTemplatePolicyAndQueueAreIncompatible",
+			input: Config{
+				PriorityBands: []PriorityBandConfig{},
+				PriorityBandTemplate: &PriorityBandConfig{
+					IntraFlowDispatchPolicy: intra.RegisteredPolicyName("policy-with-req"),
+					Queue:                   listqueue.ListQueueName,
+				},
+			},
+			opts: []configOption{withIntraFlowDispatchPolicyFactory(
+				func(_ intra.RegisteredPolicyName) (framework.IntraFlowDispatchPolicy, error) {
+					return &mocks.MockIntraFlowDispatchPolicy{
+						RequiredQueueCapabilitiesV: []framework.QueueCapability{framework.QueueCapability("required-capability")},
+					}, nil
+				})},
+			expectErr:     true,
+			expectedErrIs: contracts.ErrPolicyQueueIncompatible,
+		},
 		{
 			name: "ShouldError_WhenQueueFactoryFails",
 			input: Config{
@@ -19,7 +19,9 @@ package registry
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -86,6 +88,13 @@ type flowState struct {
 //  3. `mu (sync.RWMutex)` for Global Topology: A single registry-wide mutex protects the overall shard topology during
 //     infrequent administrative operations like scaling.
 //
+// # Priority Band Lifecycle: Provisioned on Demand
+//
+// The priority bands of the configuration exist for the lifetime of the registry. When the configuration has a
+// `PriorityBandTemplate`, `SyncPriorityBands` provisions a band from the template for each priority level in use that
+// has no band yet, on every shard. A provisioned band whose priority level is no longer in use is removed by the
+// background GC once it is empty, meaning that all its flows were collected and it has no queued items.
+//
 // # Flow Lifecycle: Lease-Based with Surgical GC
 //
 // A flow's lifecycle is managed by a lease-based reference count.
@@ -120,7 +129,7 @@ type FlowRegistry struct {
 	// Globally aggregated statistics, updated atomically via lock-free propagation.
 	totalByteSize        atomic.Int64
 	totalLen             atomic.Int64
-	perPriorityBandStats map[int]*bandStats // Keyed by priority.
+	perPriorityBandStats sync.Map // stores priority (int) -> *bandStats
 
 	// --- Administrative state (protected by `mu`) ---
 
@@ -129,6 +138,11 @@ type FlowRegistry struct {
 	drainingShards map[string]*registryShard
 	allShards      []*registryShard // Cached, sorted combination of Active and Draining shards
 	nextShardID    uint64
+
+	// provisionedPriorities holds the priority levels of the bands provisioned from the `PriorityBandTemplate`.
+	provisionedPriorities map[int]struct{}
+	// inUsePriorities holds the priority levels in use, as last reported to `SyncPriorityBands`.
+	inUsePriorities map[int]struct{}
 }
 
 var _ contracts.FlowRegistry = &FlowRegistry{}
@@ -150,11 +164,12 @@ func withClock(clk clock.WithTickerAndDelayedExecution) RegistryOption {
 func NewFlowRegistry(config Config, logger logr.Logger, opts ...RegistryOption) (*FlowRegistry, error) {
 	cfg := config.deepCopy()
 	fr := &FlowRegistry{
-		config:               cfg,
-		logger:               logger.WithName("flow-registry"),
-		activeShards:         []*registryShard{},
-		drainingShards:       make(map[string]*registryShard),
-		perPriorityBandStats: make(map[int]*bandStats, len(cfg.PriorityBands)),
+		config:                cfg,
+		logger:                logger.WithName("flow-registry"),
+		activeShards:          []*registryShard{},
+		drainingShards:        make(map[string]*registryShard),
+		provisionedPriorities: make(map[int]struct{}),
+		inUsePriorities:       make(map[int]struct{}),
 	}
 
 	for _, opt := range opts {
@@ -166,7 +181,7 @@ func NewFlowRegistry(config Config, logger logr.Logger, opts ...RegistryOption)
 
 	for i := range config.PriorityBands {
 		band := &config.PriorityBands[i]
-		fr.perPriorityBandStats[band.Priority] = &bandStats{}
+		fr.perPriorityBandStats.Store(band.Priority, &bandStats{})
 	}
 
 	if err := fr.updateShardCount(cfg.InitialShardCount); err != nil {
@@ -271,6 +286,121 @@ func (fr *FlowRegistry) prepareNewFlow(key types.FlowKey) (*flowState, error) {
 	return &flowState{key: key}, nil
 }
 
+// --- Priority Band Provisioning ---
+
+// SyncPriorityBands reports the priority levels in use, e.g., those of the InferenceObjectives.
+// It provisions a priority band from the `PriorityBandTemplate` for each of them that has no band yet. The provisioned
+// bands of the priority levels that are no longer in use are removed by the background GC once they are empty.
+// It is a no-op if the configuration has no `PriorityBandTemplate`.
+func (fr *FlowRegistry) SyncPriorityBands(priorities []int) error {
+	// The template is immutable after construction.
+	if fr.config.PriorityBandTemplate == nil {
+		return nil
+	}
+	inUse := make(map[int]struct{}, len(priorities))
+	for _, priority := range priorities {
+		inUse[priority] = struct{}{}
+	}
+
+	// Fast path: the priorities in use are unchanged, and all have a band.
+	fr.mu.RLock()
+	unchanged := maps.Equal(inUse, fr.inUsePriorities)
+	for priority := range inUse {
+		if _, err := fr.config.getBandConfig(priority); err != nil {
+			unchanged = false
+		}
+	}
+	fr.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	// Use a full write lock as provisioning a band changes the topology of every shard.
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	fr.inUsePriorities = inUse
+	var errs []error
+	for _, priority := range slices.Sorted(maps.Keys(inUse)) {
+		if _, err := fr.config.getBandConfig(priority); err == nil {
+			continue
+		}
+		if err := fr.provisionPriorityBandLocked(priority); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// provisionPriorityBandLocked adds a priority band created from the template to the configuration and every shard.
+// It uses a "prepare-then-commit" pattern, so that a failure leaves the topology unchanged.
+// Expects the registry's write lock to be held.
+func (fr *FlowRegistry) provisionPriorityBandLocked(priority int) error {
+	band := fr.config.newProvisionedBand(priority)
+
+	// Prepare (Fallible):
+	policies := make([]framework.InterFlowDispatchPolicy, len(fr.allShards))
+	for i := range fr.allShards {
+		policy, err := fr.config.interFlowDispatchPolicyFactory(band.InterFlowDispatchPolicy)
+		if err != nil {
+			return fmt.Errorf("failed to create inter-flow policy %q for priority band %d: %w",
+				band.InterFlowDispatchPolicy, priority, err)
+		}
+		policies[i] = policy
+	}
+
+	// Commit (Infallible):
+	fr.config.addBand(band)
+	fr.perPriorityBandStats.Store(priority, &bandStats{})
+	for i, shard := range fr.allShards {
+		// Draining shards get the band too, as flows are synchronized on all shards. They never accept new work, so the
+		// capacity of their band is irrelevant and is repartitioned below for the Active shards only.
+		shard.addPriorityBand(partitionBand(band, 0, 1), policies[i])
+	}
+	fr.repartitionShardConfigsLocked()
+	fr.provisionedPriorities[priority] = struct{}{}
+	fr.logger.Info("Provisioned priority band", "priority", priority, "priorityName", band.PriorityName)
+	return nil
+}
+
+// sweepUnusedPriorityBands removes the provisioned priority bands that are no longer in use and are empty on every
+// shard.
+func (fr *FlowRegistry) sweepUnusedPriorityBands() {
+	// Acquire a full write lock, which prevents flows from being registered on a band while it is removed.
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+
+	var removed []int
+	for priority := range fr.provisionedPriorities {
+		if _, ok := fr.inUsePriorities[priority]; ok {
+			continue
+		}
+		empty := true
+		for _, shard := range fr.allShards {
+			if !shard.isPriorityBandEmpty(priority) {
+				empty = false
+				break
+			}
+		}
+		if !empty {
+			continue
+		}
+
+		for _, shard := range fr.allShards {
+			shard.removePriorityBand(priority)
+		}
+		fr.config.removeBand(priority)
+		fr.perPriorityBandStats.Delete(priority)
+		delete(fr.provisionedPriorities, priority)
+		removed = append(removed, priority)
+	}
+
+	if len(removed) > 0 {
+		fr.repartitionShardConfigsLocked()
+		fr.logger.Info("Garbage collected unused priority bands", "priorities", removed)
+	}
+}
+
 // --- `contracts.FlowRegistryObserver` Implementation ---
 
 // Stats returns globally aggregated statistics for the entire `FlowRegistry`.
@@ -279,6 +409,10 @@ func (fr *FlowRegistry) prepareNewFlow(key types.FlowKey) (*flowState, error) {
 // The returned stats represent a near-consistent snapshot of the system's state.
 // It is not perfectly atomic because the various counters are loaded independently without a global lock.
 func (fr *FlowRegistry) Stats() contracts.AggregateStats {
+	// Acquire `RLock` only to ensure the set of priority bands isn't changing during iteration.
+	fr.mu.RLock()
+	defer fr.mu.RUnlock()
+
 	// Casts from `int64` to `uint64` are safe because the non-negativity invariant is strictly enforced at the
 	// `managedQueue` level.
 	stats := contracts.AggregateStats{
@@ -288,7 +422,8 @@ func (fr *FlowRegistry) Stats() contracts.AggregateStats {
 		PerPriorityBandStats: make(map[int]contracts.PriorityBandStats, len(fr.config.PriorityBands)),
 	}
 
-	for p, s := range fr.perPriorityBandStats {
+	fr.perPriorityBandStats.Range(func(key, value any) bool {
+		p, s := key.(int), value.(*bandStats)
 		bandCfg, err := fr.config.getBandConfig(p)
 		if err != nil {
 			panic(fmt.Sprintf("invariant violation: priority band config (%d) missing during stats aggregation: %v", p, err))
@@ -300,7 +435,8 @@ func (fr *FlowRegistry) Stats() contracts.AggregateStats {
 			ByteSize:      uint64(s.byteSize.Load()),
 			Len:           uint64(s.len.Load()),
 		}
-	}
+		return true
+	})
 	return stats
 }
 
@@ -339,6 +475,7 @@ func (fr *FlowRegistry) executeGCCycle() {
 		fr.verifyAndSweepFlows(flowCandidates)
 	}
 	fr.sweepDrainingShards()
+	fr.sweepUnusedPriorityBands()
 }
 
 // verifyAndSweepFlows performs the "verify" and "sweep" phases of GC for Idle flows.
@@ -589,10 +726,11 @@ func (fr *FlowRegistry) updateAllShardsCacheLocked() {
 
 // propagateStatsDelta is the top-level, lock-free aggregator for all statistics.
 func (fr *FlowRegistry) propagateStatsDelta(priority int, lenDelta, byteSizeDelta int64) {
-	stats, ok := fr.perPriorityBandStats[priority]
+	val, ok := fr.perPriorityBandStats.Load(priority)
 	if !ok {
 		panic(fmt.Sprintf("invariant violation: priority band (%d) stats missing during propagation", priority))
 	}
+	stats := val.(*bandStats)
 
 	stats.len.Add(lenDelta)
 	stats.byteSize.Add(byteSizeDelta)
@@ -454,6 +454,104 @@ func TestFlowRegistry_GarbageCollection(t *testing.T) {
 	})
 }
 
+// --- Priority Band Provisioning Tests ---
+
+func TestFlowRegistry_SyncPriorityBands(t *testing.T) {
+	t.Parallel()
+	const provisionedPriority = 15
+
+	newTemplateConfig := func() *Config {
+		return &Config{
+			FlowGCTimeout: 5 * time.Minute,
+			PriorityBands: []PriorityBandConfig{
+				{Priority: highPriority, PriorityName: "High"},
+				{Priority: lowPriority, PriorityName: "Low"},
+			},
+			PriorityBandTemplate: &PriorityBandConfig{MaxBytes: 100},
+		}
+	}
+
+	t.Run("ShouldBeNoOp_WithoutTemplate", func(t *testing.T) {
+		t.Parallel()
+		h := newRegistryTestHarness(t, harnessOptions{})
+
+		require.NoError(t, h.fr.SyncPriorityBands([]int{provisionedPriority}), "Sync without a template should not fail")
+		err := h.fr.WithConnection(types.FlowKey{ID: "flow", Priority: provisionedPriority},
+			func(contracts.ActiveFlowConnection) error { return nil })
+		assert.ErrorIs(t, err, contracts.ErrPriorityBandNotFound,
+			"A priority without a configured band must be rejected when no template is configured")
+	})
+
+	t.Run("ShouldProvisionBandOnEveryShard", func(t *testing.T) {
+		t.Parallel()
+		h := newRegistryTestHarness(t, harnessOptions{config: newTemplateConfig(), initialShardCount: 2})
+
+		require.NoError(t, h.fr.SyncPriorityBands([]int{highPriority, provisionedPriority}), "Sync should not fail")
+		for _, shard := range h.fr.allShards {
+			assert.Equal(t, []int{highPriority, provisionedPriority, lowPriority}, shard.AllOrderedPriorityLevels(),
+				"The provisioned band must be ordered among the configured bands on shard %s", shard.ID())
+			band, err := shard.PriorityBandAccessor(provisionedPriority)
+			require.NoError(t, err, "The provisioned band must exist on shard %s", shard.ID())
+			assert.Equal(t, "priority-15", band.PriorityName(), "The provisioned band must be named after its priority")
+			assert.Equal(t, uint64(50), shard.Stats().PerPriorityBandStats[provisionedPriority].CapacityBytes,
+				"The capacity of the template must be partitioned across the shards")
+		}
+		assert.Contains(t, h.fr.Stats().PerPriorityBandStats, provisionedPriority,
+			"Global stats must include the provisioned band")
+
+		h.openConnectionOnFlow(types.FlowKey{ID: "flow", Priority: provisionedPriority})
+	})
+
+	t.Run("ShouldCollectUnusedBand_OnlyWhenEmpty", func(t *testing.T) {
+		t.Parallel()
+		h := newRegistryTestHarness(t, harnessOptions{config: newTemplateConfig()})
+		key := types.FlowKey{ID: "flow", Priority: provisionedPriority}
+		require.NoError(t, h.fr.SyncPriorityBands([]int{provisionedPriority}), "Sync should not fail")
+		h.openConnectionOnFlow(key)
+		mq, err := h.fr.allShards[0].ManagedQueue(key)
+		require.NoError(t, err, "Test setup: getting the queue of the flow should not fail")
+		item := mocks.NewMockQueueItemAccessor(10, "req1", key)
+		require.NoError(t, mq.Add(item), "Test setup: adding an item should not fail")
+
+		// The priority is no longer in use, but the band still holds an item.
+		require.NoError(t, h.fr.SyncPriorityBands(nil), "Sync should not fail")
+		h.fakeClock.Step(h.config.FlowGCTimeout + time.Second)
+		h.fr.executeGCCycle()
+		_, err = h.fr.allShards[0].PriorityBandAccessor(provisionedPriority)
+		require.NoError(t, err, "A band with queued items must not be collected")
+
+		_, err = mq.Remove(item.Handle())
+		require.NoError(t, err, "Test setup: removing the item should not fail")
+		h.fr.executeGCCycle()
+		_, err = h.fr.allShards[0].PriorityBandAccessor(provisionedPriority)
+		assert.ErrorIs(t, err, contracts.ErrPriorityBandNotFound, "An empty unused band must be collected")
+		assert.NotContains(t, h.fr.Stats().PerPriorityBandStats, provisionedPriority,
+			"Global stats must not include the collected band")
+	})
+
+	t.Run("ShouldNotCollectBandInUse_OrConfiguredBand", func(t *testing.T) {
+		t.Parallel()
+		h := newRegistryTestHarness(t, harnessOptions{config: newTemplateConfig()})
+		require.NoError(t, h.fr.SyncPriorityBands([]int{provisionedPriority}), "Sync should not fail")
+
+		h.fr.executeGCCycle()
+		assert.Equal(t, []int{highPriority, provisionedPriority, lowPriority}, h.fr.allShards[0].AllOrderedPriorityLevels(),
+			"Neither the band in use nor the configured bands must be collected")
+	})
+
+	t.Run("ShouldProvisionBandOnNewShards", func(t *testing.T) {
+		t.Parallel()
+		h := newRegistryTestHarness(t, harnessOptions{config: newTemplateConfig()})
+		require.NoError(t, h.fr.SyncPriorityBands([]int{provisionedPriority}), "Sync should not fail")
+
+		require.NoError(t, h.fr.updateShardCount(2), "Scaling up should not fail")
+		for _, shard := range h.fr.allShards {
+			_, err := shard.PriorityBandAccessor(provisionedPriority)
+			assert.NoError(t, err, "The provisioned band must exist on shard %s", shard.ID())
+		}
+	})
+}
+
 // --- Shard Management Tests ---
 
 func TestFlowRegistry_UpdateShardCount(t *testing.T) {
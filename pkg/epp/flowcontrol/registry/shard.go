@@ -18,6 +18,7 @@ package registry
 
 import (
 	"fmt"
+	"maps"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -49,6 +50,31 @@ type priorityBand struct {
 	len      atomic.Int64
 }
 
+// priorityBandSet is an immutable snapshot of the priority bands of a shard.
+// It is replaced as a whole when a band is provisioned or removed, which keeps the band lookups of the hot path
+// lock-free.
+type priorityBandSet struct {
+	// byPriority holds the priority bands, keyed by priority level.
+	byPriority map[int]*priorityBand
+	// orderedPriorityLevels is a sorted list of the priority levels, from highest to lowest.
+	orderedPriorityLevels []int
+}
+
+// newPriorityBandSet creates a snapshot of the given priority bands.
+func newPriorityBandSet(bands map[int]*priorityBand) *priorityBandSet {
+	set := &priorityBandSet{
+		byPriority:            bands,
+		orderedPriorityLevels: make([]int, 0, len(bands)),
+	}
+	for priority := range bands {
+		set.orderedPriorityLevels = append(set.orderedPriorityLevels, priority)
+	}
+	sort.Slice(set.orderedPriorityLevels, func(i, j int) bool {
+		return set.orderedPriorityLevels[i] > set.orderedPriorityLevels[j]
+	})
+	return set
+}
+
 // registryShard implements the `contracts.RegistryShard` interface.
 //
 // # Role: The Data Plane Slice
@@ -64,7 +90,8 @@ type priorityBand struct {
 //   - `mu (sync.RWMutex)`: Protects the shard's internal topology (the maps of priority bands and queues) during
 //     administrative operations like flow registration, garbage collection, and configuration updates.
 //     Read locks are used on the hot path to look up queues, while write locks are used for infrequent structural
-//     changes.
+//     changes. The set of priority bands is additionally published as an immutable snapshot, so that it can be read
+//     without locking.
 //   - Atomics: Aggregated statistics (`totalByteSize`, `totalLen`, etc.) and the `isDraining` flag use atomic
 //     operations, allowing for high-frequency, lock-free updates and reads of the shard's status and load, which is
 //     critical for the performance of the data path and statistics propagation.
@@ -75,20 +102,18 @@ type registryShard struct {
 
 	// onStatsDelta is the callback used to propagate statistics changes up to the parent registry.
 	onStatsDelta propagateStatsDeltaFunc
-	// orderedPriorityLevels is a cached, sorted list of priority levels.
-	orderedPriorityLevels []int
 
 	// --- State Protected by `mu` ---
 
-	// mu protects the shard's internal topology (`priorityBands`) and `config`.
+	// mu protects the shard's internal topology (`priorityBands` and the queues of each band) and `config`.
 	// TODO: This is a priority inversion issue. Administrative operations (e.g., GC) for a low-priority flow block all
 	// data path operations for high priority flows on this shard. We should replace `s.mu` with granular per-band locks.
-	// This is safe since the priority band map structure is immutable at initialization.
 	mu sync.RWMutex
 	// config holds the partitioned configuration for this shard, derived from the `FlowRegistry`'s global `Config`.
 	config *ShardConfig
 	// priorityBands is the primary lookup table for all managed queues on this shard.
-	priorityBands map[int]*priorityBand
+	// It is only replaced while holding `mu`, but may be loaded without it.
+	priorityBands atomic.Pointer[priorityBandSet]
 
 	// --- Concurrent-Safe State (Atomics) ---
 
@@ -112,35 +137,43 @@ func newShard(
 ) (*registryShard, error) {
 	shardLogger := logger.WithName("registry-shard").WithValues("shardID", id)
 	s := &registryShard{
-		id:            id,
-		logger:        shardLogger,
-		config:        config,
-		onStatsDelta:  onStatsDelta,
-		priorityBands: make(map[int]*priorityBand, len(config.PriorityBands)),
+		id:           id,
+		logger:       shardLogger,
+		config:       config,
+		onStatsDelta: onStatsDelta,
 	}
 
+	bands := make(map[int]*priorityBand, len(config.PriorityBands))
 	for _, bandConfig := range config.PriorityBands {
 		interPolicy, err := interFlowFactory(bandConfig.InterFlowDispatchPolicy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create inter-flow policy %q for priority band %d: %w",
 				bandConfig.InterFlowDispatchPolicy, bandConfig.Priority, err)
 		}
-
-		s.priorityBands[bandConfig.Priority] = &priorityBand{
-			config:                  bandConfig,
-			queues:                  make(map[string]*managedQueue),
-			interFlowDispatchPolicy: interPolicy,
-		}
-		s.orderedPriorityLevels = append(s.orderedPriorityLevels, bandConfig.Priority)
+		bands[bandConfig.Priority] = newPriorityBand(bandConfig, interPolicy)
 	}
-	sort.Slice(s.orderedPriorityLevels, func(i, j int) bool {
-		return s.orderedPriorityLevels[i] > s.orderedPriorityLevels[j]
-	})
+	bandSet := newPriorityBandSet(bands)
+	s.priorityBands.Store(bandSet)
 	s.logger.V(logging.DEFAULT).Info("Registry shard initialized successfully",
-		"priorityBandCount", len(s.priorityBands), "orderedPriorities", s.orderedPriorityLevels)
+		"priorityBandCount", len(bandSet.byPriority), "orderedPriorities", bandSet.orderedPriorityLevels)
 	return s, nil
 }
 
+// newPriorityBand creates an empty priority band.
+func newPriorityBand(config ShardPriorityBandConfig, interPolicy framework.InterFlowDispatchPolicy) *priorityBand {
+	return &priorityBand{
+		config:                  config,
+		queues:                  make(map[string]*managedQueue),
+		interFlowDispatchPolicy: interPolicy,
+	}
+}
+
+// band looks up the priority band of a priority level. This is a lock-free read.
+func (s *registryShard) band(priority int) (*priorityBand, bool) {
+	band, ok := s.priorityBands.Load().byPriority[priority]
+	return band, ok
+}
+
 // ID returns the unique identifier for this shard.
 func (s *registryShard) ID() string { return s.id }
 
@@ -155,7 +188,7 @@ func (s *registryShard) ManagedQueue(key types.FlowKey) (contracts.ManagedQueue,
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	band, ok := s.priorityBands[key.Priority]
+	band, ok := s.band(key.Priority)
 	if !ok {
 		return nil, fmt.Errorf("failed to get managed queue for flow %q: %w", key, contracts.ErrPriorityBandNotFound)
 	}
@@ -171,7 +204,7 @@ func (s *registryShard) IntraFlowDispatchPolicy(key types.FlowKey) (framework.In
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	band, ok := s.priorityBands[key.Priority]
+	band, ok := s.band(key.Priority)
 	if !ok {
 		return nil, fmt.Errorf("failed to get intra-flow policy for flow %q: %w", key, contracts.ErrPriorityBandNotFound)
 	}
@@ -184,10 +217,9 @@ func (s *registryShard) IntraFlowDispatchPolicy(key types.FlowKey) (framework.In
 }
 
 // InterFlowDispatchPolicy retrieves a priority band's configured `framework.InterFlowDispatchPolicy`.
-// This read is lock-free as the policy instance is immutable after the shard is initialized.
+// This read is lock-free as the policy instance is immutable after the band is created.
 func (s *registryShard) InterFlowDispatchPolicy(priority int) (framework.InterFlowDispatchPolicy, error) {
-	// This read is safe because the `priorityBands` map structure is immutable after initialization.
-	band, ok := s.priorityBands[priority]
+	band, ok := s.band(priority)
 	if !ok {
 		return nil, fmt.Errorf("failed to get inter-flow policy for priority %d: %w",
 			priority, contracts.ErrPriorityBandNotFound)
@@ -200,7 +232,7 @@ func (s *registryShard) PriorityBandAccessor(priority int) (framework.PriorityBa
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	band, ok := s.priorityBands[priority]
+	band, ok := s.band(priority)
 	if !ok {
 		return nil, fmt.Errorf("failed to get priority band accessor for priority %d: %w",
 			priority, contracts.ErrPriorityBandNotFound)
@@ -211,7 +243,7 @@ func (s *registryShard) PriorityBandAccessor(priority int) (framework.PriorityBa
 // AllOrderedPriorityLevels returns a cached, sorted slice of all configured priority levels for this shard.
 // This is a lock-free read.
 func (s *registryShard) AllOrderedPriorityLevels() []int {
-	return s.orderedPriorityLevels
+	return s.priorityBands.Load().orderedPriorityLevels
 }
 
 // Stats returns a snapshot of the aggregated statistics for this specific shard.
@@ -227,16 +259,17 @@ func (s *registryShard) Stats() contracts.ShardStats {
 
 	// Casts from `int64` to `uint64` are safe because the non-negative invariant is strictly enforced at the
 	// `managedQueue` level.
+	bands := s.priorityBands.Load().byPriority
 	stats := contracts.ShardStats{
 		ID:                   s.id,
 		IsActive:             s.IsActive(),
 		TotalCapacityBytes:   s.config.MaxBytes,
 		TotalByteSize:        uint64(s.totalByteSize.Load()),
 		TotalLen:             uint64(s.totalLen.Load()),
-		PerPriorityBandStats: make(map[int]contracts.PriorityBandStats, len(s.priorityBands)),
+		PerPriorityBandStats: make(map[int]contracts.PriorityBandStats, len(bands)),
 	}
 
-	for priority, band := range s.priorityBands {
+	for priority, band := range bands {
 		stats.PerPriorityBandStats[priority] = contracts.PriorityBandStats{
 			Priority:      priority,
 			PriorityName:  band.config.PriorityName,
@@ -264,7 +297,7 @@ func (s *registryShard) synchronizeFlow(
 
 	// Find the correct priority band. A missing band is an invariant violation, as the `FlowRegistry` should have already
 	// validated this.
-	band, ok := s.priorityBands[key.Priority]
+	band, ok := s.band(key.Priority)
 	if !ok {
 		panic(fmt.Sprintf("invariant violation: attempt to synchronize flow on non-existent priority band %d", key.Priority))
 	}
@@ -292,11 +325,52 @@ func (s *registryShard) deleteFlow(key types.FlowKey) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.logger.Info("Deleting queue instance.", "flowKey", key, "flowID", key.ID, "priority", key.Priority)
-	if band, ok := s.priorityBands[key.Priority]; ok {
+	if band, ok := s.band(key.Priority); ok {
 		delete(band.queues, key.ID)
 	}
 }
 
+// addPriorityBand provisions an empty priority band on this shard. It is a no-op if the band already exists.
+func (s *registryShard) addPriorityBand(config ShardPriorityBandConfig, interPolicy framework.InterFlowDispatchPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.priorityBands.Load().byPriority
+	if _, ok := current[config.Priority]; ok {
+		return
+	}
+	bands := maps.Clone(current)
+	bands[config.Priority] = newPriorityBand(config, interPolicy)
+	s.priorityBands.Store(newPriorityBandSet(bands))
+	s.logger.V(logging.DEFAULT).Info("Priority band added", "priority", config.Priority, "priorityName", config.PriorityName)
+}
+
+// isPriorityBandEmpty returns true if the band of the given priority level has no queues and no queued items.
+// A missing band is considered empty.
+func (s *registryShard) isPriorityBandEmpty(priority int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	band, ok := s.band(priority)
+	return !ok || (len(band.queues) == 0 && band.len.Load() == 0)
+}
+
+// removePriorityBand removes the band of the given priority level from this shard.
+// The caller must ensure that the band is empty and that no flow can be registered on it concurrently.
+func (s *registryShard) removePriorityBand(priority int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.priorityBands.Load().byPriority
+	if _, ok := current[priority]; !ok {
+		return
+	}
+	bands := maps.Clone(current)
+	delete(bands, priority)
+	s.priorityBands.Store(newPriorityBandSet(bands))
+	s.logger.V(logging.DEFAULT).Info("Priority band removed", "priority", priority)
+}
+
 // markAsDraining transitions the shard to a Draining state. This method is lock-free.
 func (s *registryShard) markAsDraining() {
 	s.isDraining.Store(true)
@@ -311,7 +385,7 @@ func (s *registryShard) updateConfig(newConfig *ShardConfig) {
 
 	s.config = newConfig
 	// Update the partitioned config for each priority band as well.
-	for priority, band := range s.priorityBands {
+	for priority, band := range s.priorityBands.Load().byPriority {
 		newBandConfig, err := newConfig.getBandConfig(priority)
 		if err != nil {
 			// An invariant was violated: a priority exists in the shard but not in the new config.
@@ -329,8 +403,8 @@ func (s *registryShard) updateConfig(newConfig *ShardConfig) {
 // It atomically updates the relevant band's stats, the shard's total stats, and propagates the delta to the parent
 // registry.
 func (s *registryShard) propagateStatsDelta(priority int, lenDelta, byteSizeDelta int64) {
-	// This read is safe because the `priorityBands` map structure is immutable after initialization.
-	band, ok := s.priorityBands[priority]
+	// A band is only removed once it has no queues, so a registered `managedQueue` always finds its band.
+	band, ok := s.band(priority)
 	if !ok {
 		// This should be impossible if the `managedQueue` calling this is correctly registered.
 		panic(fmt.Sprintf("invariant violation on shard %s: received stats propagation for unknown priority band (%d)",
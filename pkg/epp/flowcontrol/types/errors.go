@@ -56,6 +56,10 @@ var (
 	// ErrTTLExpired indicates a request was evicted from a queue because its effective Time-To-Live expired.
 	ErrTTLExpired = errors.New("request TTL expired")
 
+	// ErrDeadlineUnreachable indicates a request was evicted from a queue before its effective Time-To-Live expired,
+	// because its intra-flow dispatch policy determined that it could no longer be served in time.
+	ErrDeadlineUnreachable = errors.New("request deadline unreachable")
+
 	// ErrContextCancelled indicates a request was evicted because its associated context (from
 	// `FlowControlRequest.Context()`) was cancelled. This error typically wraps the underlying `context.Canceled` or
 	// `context.DeadlineExceeded` error.
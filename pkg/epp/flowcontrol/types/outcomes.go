@@ -54,6 +54,11 @@ const (
 	// The associated error will wrap `ErrTTLExpired` (and `ErrEvicted`).
 	QueueOutcomeEvictedTTL
 
+	// QueueOutcomeEvictedDeadlineUnreachable indicates eviction from a queue by a `framework.EarlyDropPolicy`, because
+	// the request could no longer be served before its deadline.
+	// The associated error will wrap `ErrDeadlineUnreachable` (and `ErrEvicted`).
+	QueueOutcomeEvictedDeadlineUnreachable
+
 	// QueueOutcomeEvictedContextCancelled indicates eviction from a queue because the request's own context (from
 	// `FlowControlRequest.Context()`) was cancelled.
 	// The associated error will wrap `ErrContextCancelled` (which may further wrap the underlying `context.Canceled` or
@@ -80,6 +85,8 @@ func (o QueueOutcome) String() string {
 		return "RejectedOther"
 	case QueueOutcomeEvictedTTL:
 		return "EvictedTTL"
+	case QueueOutcomeEvictedDeadlineUnreachable:
+		return "EvictedDeadlineUnreachable"
 	case QueueOutcomeEvictedContextCancelled:
 		return "EvictedContextCancelled"
 	case QueueOutcomeEvictedOther:
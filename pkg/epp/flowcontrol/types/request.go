@@ -56,6 +56,28 @@ type FlowControlRequest interface {
 	ID() string
 }
 
+// CostEstimatingFlowControlRequest is an optional extension of `FlowControlRequest` for requests that can estimate the
+// cost of serving them (e.g., in tokens). Cost-aware dispatch policies charge flows by this estimate or order requests by
+// it, and fall back to the request's `ByteSize()` for requests that do not implement this interface.
+type CostEstimatingFlowControlRequest interface {
+	FlowControlRequest
+
+	// EstimatedCost returns the estimated cost of serving the request. The unit is up to the caller, but it must be the
+	// same for all the requests submitted to a `controller.FlowController`.
+	EstimatedCost() uint64
+}
+
+// WeightedFlowControlRequest is an optional extension of `FlowControlRequest` for requests that carry a fairness weight.
+// Weighted inter-flow dispatch policies use the weight of a flow's head request to decide the flow's share of dispatch
+// within its priority band. Requests that do not implement this interface are treated as having a weight of 1.
+type WeightedFlowControlRequest interface {
+	FlowControlRequest
+
+	// FairnessWeight returns the relative share of dispatch the request's flow is entitled to. Values less than 1 are
+	// treated as 1.
+	FairnessWeight() int
+}
+
 // QueueItemHandle is an opaque handle to an item that has been successfully added to a `framework.SafeQueue`. It acts
 // as a key, allowing the `controller.FlowController` to perform targeted operations (like removal) on a specific item
 // without needing to know the queue's internal structure.
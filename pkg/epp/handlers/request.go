@@ -26,6 +26,7 @@ import (
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 	errutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/error"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
 
 const (
@@ -72,6 +73,9 @@ func (s *StreamingServer) HandleRequestHeaders(reqCtx *RequestContext, req *extP
 			// remove the objective header from the request headers,
 			// this is not data that should be manipulated or sent to the backend.
 			delete(reqCtx.Request.Headers, header.Key)
+		case metadata.InferencePoolKey:
+			// remove the pool header from the request headers, it is only used to select the pool serving the request.
+			delete(reqCtx.Request.Headers, header.Key)
 		case metadata.ModelNameRewriteKey:
 			reqCtx.TargetModelName = reqCtx.Request.Headers[header.Key]
 			// remove the rewrite header from the request headers,
@@ -87,6 +91,21 @@ func (s *StreamingServer) HandleRequestHeaders(reqCtx *RequestContext, req *extP
 	return nil
 }
 
+// RequestedPool returns the name of the InferencePool selected by the request, from the InferencePoolKey header or,
+// if the header is not set, from the InferencePoolKey of the request metadata. It returns an empty string when the
+// request does not select a pool.
+func RequestedPool(req *extProcPb.ProcessingRequest_RequestHeaders, requestMetadata map[string]any) string {
+	if pool := requtil.ExtractHeaderValue(req, metadata.InferencePoolKey); pool != "" {
+		return pool
+	}
+	poolMap, found := requestMetadata[metadata.InferencePoolNamespace].(map[string]any)
+	if !found {
+		return ""
+	}
+	pool, _ := poolMap[metadata.InferencePoolKey].(string)
+	return pool
+}
+
 func (s *StreamingServer) generateRequestBodyResponses(requestBodyBytes []byte) []*extProcPb.ProcessingResponse {
 	commonResponses := buildCommonResponses(requestBodyBytes, bodyByteLimit, true)
 	responses := []*extProcPb.ProcessingResponse{}
@@ -96,3 +96,61 @@ func TestHandleRequestHeaders_DefaultFairnessID(t *testing.T) {
 	assert.NoError(t, err, "expected no error")
 	assert.Equal(t, defaultFairnessID, reqCtx.FairnessID, "expected fairness ID to be defaulted")
 }
+
+func TestRequestedPool(t *testing.T) {
+	t.Parallel()
+
+	headers := func(pool string) *extProcPb.ProcessingRequest_RequestHeaders {
+		req := &extProcPb.ProcessingRequest_RequestHeaders{
+			RequestHeaders: &extProcPb.HttpHeaders{
+				Headers: &configPb.HeaderMap{},
+			},
+		}
+		if pool != "" {
+			req.RequestHeaders.Headers.Headers = append(req.RequestHeaders.Headers.Headers,
+				&configPb.HeaderValue{Key: metadata.InferencePoolKey, RawValue: []byte(pool)})
+		}
+		return req
+	}
+	poolMetadata := func(pool string) map[string]any {
+		return map[string]any{
+			metadata.InferencePoolNamespace: map[string]any{metadata.InferencePoolKey: pool},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		req      *extProcPb.ProcessingRequest_RequestHeaders
+		metadata map[string]any
+		want     string
+	}{
+		{
+			name: "no pool selected",
+			req:  headers(""),
+			want: "",
+		},
+		{
+			name: "pool selected by header",
+			req:  headers("pool-a"),
+			want: "pool-a",
+		},
+		{
+			name:     "pool selected by metadata",
+			req:      headers(""),
+			metadata: poolMetadata("pool-b"),
+			want:     "pool-b",
+		},
+		{
+			name:     "header takes precedence over metadata",
+			req:      headers("pool-a"),
+			metadata: poolMetadata("pool-b"),
+			want:     "pool-a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, RequestedPool(test.req, test.metadata))
+		})
+	}
+}
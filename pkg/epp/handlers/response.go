@@ -62,7 +62,9 @@ func (s *StreamingServer) HandleResponseBody(ctx context.Context, reqCtx *Reques
 
 	reqCtx.respBodyResp = generateResponseBodyResponses(responseBytes, true)
 
-	return s.director.HandleResponseBodyComplete(ctx, reqCtx)
+	reqCtx, err = s.director.HandleResponseBodyComplete(ctx, reqCtx)
+	s.finishTargetPodRequest(reqCtx)
+	return reqCtx, err
 }
 
 // The function is to handle streaming response if the modelServer is streaming.
@@ -76,12 +78,13 @@ func (s *StreamingServer) HandleResponseBodyModelStreaming(ctx context.Context,
 		reqCtx.ResponseComplete = true
 		resp := parseRespForUsage(ctx, responseText)
 		reqCtx.Usage = resp.Usage
-		metrics.RecordInputTokens(reqCtx.IncomingModelName, reqCtx.TargetModelName, resp.Usage.PromptTokens)
-		metrics.RecordOutputTokens(reqCtx.IncomingModelName, reqCtx.TargetModelName, resp.Usage.CompletionTokens)
+		metrics.RecordInputTokens(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, resp.Usage.PromptTokens)
+		metrics.RecordOutputTokens(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, resp.Usage.CompletionTokens)
 		_, err := s.director.HandleResponseBodyComplete(ctx, reqCtx)
 		if err != nil {
 			logger.Error(err, "error in HandleResponseBodyComplete")
 		}
+		s.finishTargetPodRequest(reqCtx)
 	}
 }
 
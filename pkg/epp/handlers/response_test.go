@@ -22,7 +22,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
 
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
@@ -179,3 +181,64 @@ func TestHandleStreamedResponseBody(t *testing.T) {
 		})
 	}
 }
+
+// fakeDatastore counts the in-flight requests of each endpoint.
+type fakeDatastore struct {
+	inFlight map[types.NamespacedName]int
+}
+
+func (ds *fakeDatastore) PoolGet() (*v1.InferencePool, error) {
+	return &v1.InferencePool{}, nil
+}
+
+func (ds *fakeDatastore) PodRequestFinished(endpoint types.NamespacedName) {
+	ds.inFlight[endpoint]--
+}
+
+func TestResponseCompleteFinishesTargetPodRequest(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	endpoint := types.NamespacedName{Name: "pod1-rank-0", Namespace: "default"}
+	tests := []struct {
+		name     string
+		complete func(server *StreamingServer, reqCtx *RequestContext)
+	}{
+		{
+			name: "response body",
+			complete: func(server *StreamingServer, reqCtx *RequestContext) {
+				var responseMap map[string]any
+				if err := json.Unmarshal([]byte(body), &responseMap); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := server.HandleResponseBody(ctx, reqCtx, responseMap); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "streamed response body",
+			complete: func(server *StreamingServer, reqCtx *RequestContext) {
+				reqCtx.modelServerStreaming = true
+				server.HandleResponseBodyModelStreaming(ctx, reqCtx, streamingBodyWithoutUsage)
+				if got := server.datastore.(*fakeDatastore).inFlight[endpoint]; got != 1 {
+					t.Errorf("Expected the request to be in flight until the last chunk, got %d", got)
+				}
+				server.HandleResponseBodyModelStreaming(ctx, reqCtx, streamingBodyWithUsage)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The director counted the request as in flight when it picked the target pod.
+			ds := &fakeDatastore{inFlight: map[types.NamespacedName]int{endpoint: 1}}
+			server := NewStreamingServer(ds, &mockDirector{})
+			reqCtx := &RequestContext{TargetPod: &backend.Pod{NamespacedName: endpoint}, TargetPodInFlight: true}
+
+			test.complete(server, reqCtx)
+			// The request is finished once, even if the stream ends afterwards.
+			server.finishTargetPodRequest(reqCtx)
+			if got := ds.inFlight[endpoint]; got != 0 {
+				t.Errorf("Expected no in-flight request, got %d", got)
+			}
+		})
+	}
+}
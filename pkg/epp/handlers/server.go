@@ -29,6 +29,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
@@ -52,6 +53,14 @@ func NewStreamingServer(datastore Datastore, director Director) *StreamingServer
 	}
 }
 
+// NewMultiPoolStreamingServer creates a server for an Endpoint Picker serving several InferencePools. The pool
+// serving each request is resolved from the request headers or metadata, see RequestedPool.
+func NewMultiPoolStreamingServer(pools PoolResolver) *StreamingServer {
+	return &StreamingServer{
+		pools: pools,
+	}
+}
+
 type Director interface {
 	HandleRequest(ctx context.Context, reqCtx *RequestContext) (*RequestContext, error)
 	HandleResponseReceived(ctx context.Context, reqCtx *RequestContext) (*RequestContext, error)
@@ -62,6 +71,15 @@ type Director interface {
 
 type Datastore interface {
 	PoolGet() (*v1.InferencePool, error)
+	PodRequestFinished(endpoint types.NamespacedName)
+}
+
+// PoolResolver resolves the datastore and director of the InferencePool serving a request, when the Endpoint
+// Picker serves several pools.
+type PoolResolver interface {
+	// Resolve returns the datastore and director of the named pool. The name is empty when the request does not
+	// select a pool.
+	Resolve(pool string) (Datastore, Director, error)
 }
 
 // Server implements the Envoy external processing server.
@@ -69,6 +87,9 @@ type Datastore interface {
 type StreamingServer struct {
 	datastore Datastore
 	director  Director
+	// pools is set when the server serves several InferencePools. The datastore and director are then resolved
+	// per request.
+	pools PoolResolver
 }
 
 // RequestContext stores context information during the life time of an HTTP request.
@@ -81,6 +102,7 @@ type RequestContext struct {
 	IncomingModelName         string
 	TargetModelName           string
 	FairnessID                string
+	FairnessWeight            int
 	ObjectiveKey              string
 	RequestReceivedTimestamp  time.Time
 	ResponseCompleteTimestamp time.Time
@@ -93,9 +115,13 @@ type RequestContext struct {
 	Request                   *Request
 
 	SchedulingRequest *schedulingtypes.LLMRequest
+	// PoolName is the name of the InferencePool serving the request when the Endpoint Picker serves several pools.
+	PoolName string
 
 	RequestState         StreamRequestState
 	modelServerStreaming bool
+	// TargetPodInFlight is set by the director while the request is counted as in flight on its target pod.
+	TargetPodInFlight bool
 
 	Response *Response
 
@@ -158,13 +184,15 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 	var err error
 	defer func(error, *RequestContext) {
 		if reqCtx.ResponseStatusCode != "" {
-			metrics.RecordRequestErrCounter(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseStatusCode)
+			metrics.RecordRequestErrCounter(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseStatusCode)
 		} else if err != nil {
-			metrics.RecordRequestErrCounter(reqCtx.IncomingModelName, reqCtx.TargetModelName, errutil.CanonicalCode(err))
+			metrics.RecordRequestErrCounter(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, errutil.CanonicalCode(err))
 		}
 		if reqCtx.RequestRunning {
-			metrics.DecRunningRequests(reqCtx.IncomingModelName)
+			metrics.DecRunningRequests(reqCtx.PoolName, reqCtx.IncomingModelName)
 		}
+		// Requests ending before their response completes, e.g. when the client disconnects, finish here.
+		s.finishTargetPodRequest(reqCtx)
 	}(err, reqCtx)
 
 	for {
@@ -198,6 +226,18 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			loggerTrace = logger.V(logutil.TRACE)
 			ctx = log.IntoContext(ctx, logger)
 
+			if s.pools != nil {
+				// The rest of the stream is handled by the stack of the selected pool.
+				var ds Datastore
+				var director Director
+				if ds, director, err = s.pools.Resolve(RequestedPool(v, reqCtx.Request.Metadata)); err != nil {
+					break
+				}
+				if pool, poolErr := ds.PoolGet(); poolErr == nil {
+					reqCtx.PoolName = pool.Name
+				}
+				s = NewStreamingServer(ds, director)
+			}
 			err = s.HandleRequestHeaders(reqCtx, v)
 		case *extProcPb.ProcessingRequest_RequestBody:
 			loggerTrace.Info("Incoming body chunk", "EoS", v.RequestBody.EndOfStream)
@@ -236,8 +276,8 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 				reqCtx.reqHeaderResp = s.generateRequestHeaderResponse(reqCtx)
 				reqCtx.reqBodyResp = s.generateRequestBodyResponses(requestBodyBytes)
 
-				metrics.RecordRequestCounter(reqCtx.IncomingModelName, reqCtx.TargetModelName)
-				metrics.RecordRequestSizes(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestSize)
+				metrics.RecordRequestCounter(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName)
+				metrics.RecordRequestSizes(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestSize)
 			}
 		case *extProcPb.ProcessingRequest_RequestTrailers:
 			// This is currently unused.
@@ -276,9 +316,9 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 					loggerTrace.Info("stream completed")
 
 					reqCtx.ResponseCompleteTimestamp = time.Now()
-					metrics.RecordRequestLatencies(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
-					metrics.RecordResponseSizes(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
-					metrics.RecordNormalizedTimePerOutputToken(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp, reqCtx.Usage.CompletionTokens)
+					metrics.RecordRequestLatencies(ctx, reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
+					metrics.RecordResponseSizes(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
+					metrics.RecordNormalizedTimePerOutputToken(ctx, reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp, reqCtx.Usage.CompletionTokens)
 				}
 
 				reqCtx.respBodyResp = generateResponseBodyResponses(v.ResponseBody.Body, v.ResponseBody.EndOfStream)
@@ -312,10 +352,10 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 						}
 					} else if reqCtx.ResponseComplete {
 						reqCtx.ResponseCompleteTimestamp = time.Now()
-						metrics.RecordRequestLatencies(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
-						metrics.RecordResponseSizes(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
-						metrics.RecordInputTokens(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Usage.PromptTokens)
-						metrics.RecordOutputTokens(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Usage.CompletionTokens)
+						metrics.RecordRequestLatencies(ctx, reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
+						metrics.RecordResponseSizes(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
+						metrics.RecordInputTokens(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Usage.PromptTokens)
+						metrics.RecordOutputTokens(reqCtx.PoolName, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Usage.CompletionTokens)
 					}
 				}
 			}
@@ -369,7 +409,7 @@ func (r *RequestContext) updateStateAndSendIfNeeded(srv extProcPb.ExternalProces
 			}
 		}
 		r.RequestState = BodyRequestResponsesComplete
-		metrics.IncRunningRequests(r.IncomingModelName)
+		metrics.IncRunningRequests(r.PoolName, r.IncomingModelName)
 		r.RequestRunning = true
 		// Dump the response so a new stream message can begin
 		r.reqBodyResp = nil
@@ -525,3 +565,13 @@ func buildCommonResponses(bodyBytes []byte, byteLimit int, setEos bool) []*extPr
 
 	return responses
 }
+
+// finishTargetPodRequest stops counting the request as in flight on its target pod. A draining pod is released
+// once its last in-flight request finished.
+func (s *StreamingServer) finishTargetPodRequest(reqCtx *RequestContext) {
+	if !reqCtx.TargetPodInFlight {
+		return
+	}
+	s.datastore.PodRequestFinished(reqCtx.TargetPod.NamespacedName)
+	reqCtx.TargetPodInFlight = false
+}
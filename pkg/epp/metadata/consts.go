@@ -32,4 +32,13 @@ const (
 	ObjectiveKey = "x-gateway-inference-objective"
 	// ModelNameRewriteKey is the header key used to specify the model name to be used when the request is forwarded to the model server.
 	ModelNameRewriteKey = "x-gateway-model-name-rewrite"
+	// InferencePoolNamespace is the key for the outer namespace struct in the metadata field of the extproc request that is used to wrap the InferencePool name.
+	InferencePoolNamespace = "envoy.lb"
+	// InferencePoolKey is the header and request metadata key used to select the InferencePool serving the request,
+	// when the Endpoint Picker serves several pools.
+	InferencePoolKey = "x-gateway-inference-pool"
+	// FairnessWeightAnnotation is the InferenceObjective annotation setting the relative share of dispatch of the flows
+	// of its requests, as applied by the weighted inter-flow dispatch policies of Flow Control. Its value must be a
+	// positive integer.
+	FairnessWeightAnnotation = "inference.networking.x-k8s.io/fairness-weight"
 )
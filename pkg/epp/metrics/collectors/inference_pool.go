@@ -34,10 +34,28 @@ var (
 			"model_server_pod",
 		}, nil,
 	)
+
+	descInferencePoolPerPodHealthy = prometheus.NewDesc(
+		"inference_pool_per_pod_healthy",
+		metricsutil.HelpMsgWithStability("Whether the data collection of each underlying pod is healthy (1) or failing (0).", compbasemetrics.ALPHA),
+		[]string{
+			"name",
+			"model_server_pod",
+		}, nil,
+	)
+
+	descInferencePoolPerPodDraining = prometheus.NewDesc(
+		"inference_pool_per_pod_draining",
+		metricsutil.HelpMsgWithStability("Whether each underlying pod is draining (1), serving its in-flight requests without receiving new ones, or not (0).", compbasemetrics.ALPHA),
+		[]string{
+			"name",
+			"model_server_pod",
+		}, nil,
+	)
 )
 
 type inferencePoolMetricsCollector struct {
-	ds datastore.Datastore
+	datastores func() []datastore.Datastore
 }
 
 // Check if inferencePoolMetricsCollector implements necessary interface
@@ -46,24 +64,40 @@ var _ prometheus.Collector = &inferencePoolMetricsCollector{}
 // NewInferencePoolMetricsCollector implements the prometheus.Collector interface and
 // exposes metrics about inference pool.
 func NewInferencePoolMetricsCollector(ds datastore.Datastore) prometheus.Collector {
+	return NewInferencePoolSetMetricsCollector(func() []datastore.Datastore {
+		return []datastore.Datastore{ds}
+	})
+}
+
+// NewInferencePoolSetMetricsCollector implements the prometheus.Collector interface and
+// exposes metrics about the inference pools served by the Endpoint Picker, labelled by pool name.
+func NewInferencePoolSetMetricsCollector(datastores func() []datastore.Datastore) prometheus.Collector {
 	return &inferencePoolMetricsCollector{
-		ds: ds,
+		datastores: datastores,
 	}
 }
 
 // DescribeWithStability implements the prometheus.Collector interface.
 func (c *inferencePoolMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descInferencePoolPerPodQueueSize
+	ch <- descInferencePoolPerPodHealthy
+	ch <- descInferencePoolPerPodDraining
 }
 
 // CollectWithStability implements the prometheus.Collector interface.
 func (c *inferencePoolMetricsCollector) Collect(ch chan<- prometheus.Metric) {
-	pool, err := c.ds.PoolGet()
+	for _, ds := range c.datastores() {
+		collectPool(ch, ds)
+	}
+}
+
+func collectPool(ch chan<- prometheus.Metric, ds datastore.Datastore) {
+	pool, err := ds.PoolGet()
 	if err != nil {
 		return
 	}
 
-	podMetrics := c.ds.PodList(backendmetrics.AllPodsPredicate)
+	podMetrics := ds.PodList(backendmetrics.AllPodsPredicate)
 	if len(podMetrics) == 0 {
 		return
 	}
@@ -76,5 +110,29 @@ func (c *inferencePoolMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 			pool.Name,
 			pod.GetPod().NamespacedName.Name,
 		)
+
+		healthy := 0.0
+		if pod.GetHealth().IsHealthy() {
+			healthy = 1
+		}
+		ch <- prometheus.MustNewConstMetric(
+			descInferencePoolPerPodHealthy,
+			prometheus.GaugeValue,
+			healthy,
+			pool.Name,
+			pod.GetPod().NamespacedName.Name,
+		)
+
+		draining := 0.0
+		if pod.GetPod().Draining {
+			draining = 1
+		}
+		ch <- prometheus.MustNewConstMetric(
+			descInferencePoolPerPodDraining,
+			prometheus.GaugeValue,
+			draining,
+			pool.Name,
+			pod.GetPod().NamespacedName.Name,
+		)
 	}
 }
@@ -52,9 +52,7 @@ func TestNoMetricsCollected(t *testing.T) {
 	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
 	ds := datastore.NewDatastore(context.Background(), pmf, 0)
 
-	collector := &inferencePoolMetricsCollector{
-		ds: ds,
-	}
+	collector := NewInferencePoolMetricsCollector(ds)
 
 	if err := testutil.CollectAndCompare(collector, strings.NewReader(""), ""); err != nil {
 		t.Fatal(err)
@@ -88,15 +86,82 @@ func TestMetricsCollected(t *testing.T) {
 
 	time.Sleep(1 * time.Second)
 
-	collector := &inferencePoolMetricsCollector{
-		ds: ds,
-	}
+	collector := NewInferencePoolMetricsCollector(ds)
 	err := testutil.CollectAndCompare(collector, strings.NewReader(`
 		# HELP inference_pool_per_pod_queue_size [ALPHA] The total number of requests pending in the model server queue for each underlying pod.
 		# TYPE inference_pool_per_pod_queue_size gauge
 		inference_pool_per_pod_queue_size{model_server_pod="pod1-rank-0",name="test-pool"} 100
+		# HELP inference_pool_per_pod_healthy [ALPHA] Whether the data collection of each underlying pod is healthy (1) or failing (0).
+		# TYPE inference_pool_per_pod_healthy gauge
+		inference_pool_per_pod_healthy{model_server_pod="pod1-rank-0",name="test-pool"} 1
+`), "inference_pool_per_pod_queue_size", "inference_pool_per_pod_healthy")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPoolSetMetricsCollected(t *testing.T) {
+	pmc := &backendmetrics.FakePodMetricsClient{
+		Res: map[types.NamespacedName]*backendmetrics.MetricsState{
+			pod1NamespacedName: pod1Metrics,
+		},
+	}
+	pmf := backendmetrics.NewPodMetricsFactory(pmc, time.Millisecond)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(runtime.NewScheme()).
+		Build()
+
+	var stores []datastore.Datastore
+	for _, name := range []string{"pool-a", "pool-b"} {
+		ds := datastore.NewDatastore(context.Background(), pmf, 0)
+		_ = ds.PoolSet(context.Background(), fakeClient, &v1.InferencePool{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1.InferencePoolSpec{
+				TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+			},
+		})
+		_ = ds.PodUpdateOrAddIfNotExist(pod1)
+		stores = append(stores, ds)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	collector := NewInferencePoolSetMetricsCollector(func() []datastore.Datastore { return stores })
+	err := testutil.CollectAndCompare(collector, strings.NewReader(`
+		# HELP inference_pool_per_pod_queue_size [ALPHA] The total number of requests pending in the model server queue for each underlying pod.
+		# TYPE inference_pool_per_pod_queue_size gauge
+		inference_pool_per_pod_queue_size{model_server_pod="pod1-rank-0",name="pool-a"} 100
+		inference_pool_per_pod_queue_size{model_server_pod="pod1-rank-0",name="pool-b"} 100
 `), "inference_pool_per_pod_queue_size")
 	if err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestDrainingPodMetricsCollected(t *testing.T) {
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Millisecond)
+	ds := datastore.NewDatastore(context.Background(), pmf, 0, datastore.WithDrainTimeout(time.Minute))
+
+	inferencePool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-pool",
+		},
+		Spec: v1.InferencePoolSpec{
+			TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+		},
+	}
+	_ = ds.PoolSet(context.Background(), fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(), inferencePool)
+	_ = ds.PodUpdateOrAddIfNotExist(pod1)
+	ds.PodRequestStarted(pod1NamespacedName)
+	ds.PodDelete(pod1.Name)
+
+	collector := NewInferencePoolMetricsCollector(ds)
+	err := testutil.CollectAndCompare(collector, strings.NewReader(`
+		# HELP inference_pool_per_pod_draining [ALPHA] Whether each underlying pod is draining (1), serving its in-flight requests without receiving new ones, or not (0).
+		# TYPE inference_pool_per_pod_draining gauge
+		inference_pool_per_pod_draining{model_server_pod="pod1-rank-0",name="test-pool"} 1
+`), "inference_pool_per_pod_draining")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
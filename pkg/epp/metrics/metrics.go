@@ -35,23 +35,26 @@ const (
 	InferencePoolComponent      = "inference_pool"
 	InferenceExtension          = "inference_extension"
 
-	KVCacheUsagePercentKey = "KVCacheUsagePercent"
-	WaitingQueueSizeKey    = "WaitingQueueSize"
-	MaxActiveModelsKey     = "MaxActiveModels"
-	ActiveModelsKey        = "ActiveModels"
-	WaitingModelsKey       = "WaitingModels"
-	UpdateTimeKey          = "UpdateTime"
+	KVCacheUsagePercentKey     = "KVCacheUsagePercent"
+	KvCacheMaxTokenCapacityKey = "KvCacheMaxTokenCapacity"
+	WaitingQueueSizeKey        = "WaitingQueueSize"
+	MaxActiveModelsKey         = "MaxActiveModels"
+	ActiveModelsKey            = "ActiveModels"
+	WaitingModelsKey           = "WaitingModels"
+	UpdateTimeKey              = "UpdateTime"
 )
 
 var (
 	// Inference Objective Metrics
+	// The pool_name label is the InferencePool serving the request when the Endpoint Picker serves several pools,
+	// and is empty otherwise.
 	requestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: InferenceObjectiveComponent,
 			Name:      "request_total",
 			Help:      metricsutil.HelpMsgWithStability("Counter of inference objective requests broken out for each model and target model.", compbasemetrics.ALPHA),
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	requestErrCounter = prometheus.NewCounterVec(
@@ -60,7 +63,7 @@ var (
 			Name:      "request_error_total",
 			Help:      metricsutil.HelpMsgWithStability("Counter of inference objective requests errors broken out for each model and target model.", compbasemetrics.ALPHA),
 		},
-		[]string{"model_name", "target_model_name", "error_code"},
+		[]string{"model_name", "target_model_name", "error_code", "pool_name"},
 	)
 
 	requestLatencies = prometheus.NewHistogramVec(
@@ -73,7 +76,7 @@ var (
 				4, 5, 6, 8, 10, 15, 20, 30, 45, 60, 120, 180, 240, 300, 360, 480, 600, 900, 1200, 1800, 2700, 3600,
 			},
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	requestSizes = prometheus.NewHistogramVec(
@@ -88,7 +91,7 @@ var (
 				16777216, 33554432, 67108864, 134217728, 268435456, 536870912, 1073741824, // Exponential up to 1GB
 			},
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	responseSizes = prometheus.NewHistogramVec(
@@ -100,7 +103,7 @@ var (
 			// 8192 * 4 = 32768.
 			Buckets: []float64{1, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32778, 65536},
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	inputTokens = prometheus.NewHistogramVec(
@@ -111,7 +114,7 @@ var (
 			// Most models have a input context window less than 1 million tokens.
 			Buckets: []float64{1, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32778, 65536, 131072, 262144, 524288, 1048576},
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	outputTokens = prometheus.NewHistogramVec(
@@ -122,7 +125,7 @@ var (
 			// Most models generates output less than 8192 tokens.
 			Buckets: []float64{1, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192},
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	runningRequests = prometheus.NewGaugeVec(
@@ -131,7 +134,7 @@ var (
 			Name:      "running_requests",
 			Help:      metricsutil.HelpMsgWithStability("Inference objective number of running requests in each model.", compbasemetrics.ALPHA),
 		},
-		[]string{"model_name"},
+		[]string{"model_name", "pool_name"},
 	)
 
 	// NTPOT - Normalized Time Per Output Token
@@ -145,7 +148,7 @@ var (
 				0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2, 0.5, 1.0, 2.0, 5.0, 10.0,
 			},
 		},
-		[]string{"model_name", "target_model_name"},
+		[]string{"model_name", "target_model_name", "pool_name"},
 	)
 
 	// Inference Pool Metrics
@@ -202,6 +205,15 @@ var (
 		[]string{"extension_point", "plugin_type", "plugin_name"},
 	)
 
+	SchedulerFilterFallbacks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: InferenceExtension,
+			Name:      "scheduler_filter_fallback_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of soft filter fallbacks triggered when a filter filtered out all pods, for each plugin type, plugin name and fallback policy.", compbasemetrics.ALPHA),
+		},
+		[]string{"plugin_type", "plugin_name", "policy"},
+	)
+
 	// Prefix indexer Metrics
 	PrefixCacheSize = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -233,6 +245,28 @@ var (
 		[]string{},
 	)
 
+	// Data Layer Metrics
+	DataLayerCollectionLatencies = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: InferenceExtension,
+			Name:      "datalayer_collection_duration_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Latency distribution in seconds of endpoint data collections (e.g., metrics scrapes) for each data source.", compbasemetrics.ALPHA),
+			Buckets: []float64{
+				0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2, 0.5, 1, 2, 5,
+			},
+		},
+		[]string{"source"},
+	)
+
+	DataLayerCollectionErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: InferenceExtension,
+			Name:      "datalayer_collection_errors_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of failed endpoint data collections (e.g., metrics scrapes) for each data source.", compbasemetrics.ALPHA),
+		},
+		[]string{"source"},
+	)
+
 	// Info Metrics
 	InferenceExtensionInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -285,6 +319,9 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(inferencePoolReadyPods)
 		metrics.Registry.MustRegister(SchedulerE2ELatency)
 		metrics.Registry.MustRegister(PluginProcessingLatencies)
+		metrics.Registry.MustRegister(SchedulerFilterFallbacks)
+		metrics.Registry.MustRegister(DataLayerCollectionLatencies)
+		metrics.Registry.MustRegister(DataLayerCollectionErrors)
 		metrics.Registry.MustRegister(InferenceExtensionInfo)
 		metrics.Registry.MustRegister(PrefixCacheSize)
 		metrics.Registry.MustRegister(PrefixCacheHitRatio)
@@ -313,6 +350,9 @@ func Reset() {
 	inferencePoolReadyPods.Reset()
 	SchedulerE2ELatency.Reset()
 	PluginProcessingLatencies.Reset()
+	SchedulerFilterFallbacks.Reset()
+	DataLayerCollectionLatencies.Reset()
+	DataLayerCollectionErrors.Reset()
 	InferenceExtensionInfo.Reset()
 	PrefixCacheSize.Reset()
 	PrefixCacheHitRatio.Reset()
@@ -322,55 +362,55 @@ func Reset() {
 }
 
 // RecordRequstCounter records the number of requests.
-func RecordRequestCounter(modelName, targetModelName string) {
-	requestCounter.WithLabelValues(modelName, targetModelName).Inc()
+func RecordRequestCounter(poolName, modelName, targetModelName string) {
+	requestCounter.WithLabelValues(modelName, targetModelName, poolName).Inc()
 }
 
 // RecordRequestErrCounter records the number of error requests.
-func RecordRequestErrCounter(modelName, targetModelName string, code string) {
+func RecordRequestErrCounter(poolName, modelName, targetModelName string, code string) {
 	if code != "" {
-		requestErrCounter.WithLabelValues(modelName, targetModelName, code).Inc()
+		requestErrCounter.WithLabelValues(modelName, targetModelName, code, poolName).Inc()
 	}
 }
 
 // RecordRequestSizes records the request sizes.
-func RecordRequestSizes(modelName, targetModelName string, reqSize int) {
-	requestSizes.WithLabelValues(modelName, targetModelName).Observe(float64(reqSize))
+func RecordRequestSizes(poolName, modelName, targetModelName string, reqSize int) {
+	requestSizes.WithLabelValues(modelName, targetModelName, poolName).Observe(float64(reqSize))
 }
 
 // RecordRequestLatencies records duration of request.
-func RecordRequestLatencies(ctx context.Context, modelName, targetModelName string, received time.Time, complete time.Time) bool {
+func RecordRequestLatencies(ctx context.Context, poolName, modelName, targetModelName string, received time.Time, complete time.Time) bool {
 	if !complete.After(received) {
 		log.FromContext(ctx).V(logutil.DEFAULT).Error(nil, "Request latency values are invalid",
 			"modelName", modelName, "targetModelName", targetModelName, "completeTime", complete, "receivedTime", received)
 		return false
 	}
 	elapsedSeconds := complete.Sub(received).Seconds()
-	requestLatencies.WithLabelValues(modelName, targetModelName).Observe(elapsedSeconds)
+	requestLatencies.WithLabelValues(modelName, targetModelName, poolName).Observe(elapsedSeconds)
 	return true
 }
 
 // RecordResponseSizes records the response sizes.
-func RecordResponseSizes(modelName, targetModelName string, size int) {
-	responseSizes.WithLabelValues(modelName, targetModelName).Observe(float64(size))
+func RecordResponseSizes(poolName, modelName, targetModelName string, size int) {
+	responseSizes.WithLabelValues(modelName, targetModelName, poolName).Observe(float64(size))
 }
 
 // RecordInputTokens records input tokens count.
-func RecordInputTokens(modelName, targetModelName string, size int) {
+func RecordInputTokens(poolName, modelName, targetModelName string, size int) {
 	if size > 0 {
-		inputTokens.WithLabelValues(modelName, targetModelName).Observe(float64(size))
+		inputTokens.WithLabelValues(modelName, targetModelName, poolName).Observe(float64(size))
 	}
 }
 
 // RecordOutputTokens records output tokens count.
-func RecordOutputTokens(modelName, targetModelName string, size int) {
+func RecordOutputTokens(poolName, modelName, targetModelName string, size int) {
 	if size > 0 {
-		outputTokens.WithLabelValues(modelName, targetModelName).Observe(float64(size))
+		outputTokens.WithLabelValues(modelName, targetModelName, poolName).Observe(float64(size))
 	}
 }
 
 // RecordNormalizedTimePerOutputToken (NTPOT) records the normalized time per output token.
-func RecordNormalizedTimePerOutputToken(ctx context.Context, modelName, targetModelName string, received time.Time, complete time.Time, outputTokenCount int) bool {
+func RecordNormalizedTimePerOutputToken(ctx context.Context, poolName, modelName, targetModelName string, received time.Time, complete time.Time, outputTokenCount int) bool {
 	if !complete.After(received) {
 		log.FromContext(ctx).Error(nil, "Request latency values are invalid for NTPOT calculation",
 			"modelName", modelName, "targetModelName", targetModelName, "completeTime", complete, "receivedTime", received)
@@ -386,21 +426,21 @@ func RecordNormalizedTimePerOutputToken(ctx context.Context, modelName, targetMo
 	elapsedSeconds := complete.Sub(received).Seconds()
 	secondsPerToken := elapsedSeconds / float64(outputTokenCount)
 
-	NormalizedTimePerOutputToken.WithLabelValues(modelName, targetModelName).Observe(secondsPerToken)
+	NormalizedTimePerOutputToken.WithLabelValues(modelName, targetModelName, poolName).Observe(secondsPerToken)
 	return true
 }
 
 // IncRunningRequests increases the current running requests.
-func IncRunningRequests(modelName string) {
+func IncRunningRequests(poolName, modelName string) {
 	if modelName != "" {
-		runningRequests.WithLabelValues(modelName).Inc()
+		runningRequests.WithLabelValues(modelName, poolName).Inc()
 	}
 }
 
 // DecRunningRequests decreases the current running requests.
-func DecRunningRequests(modelName string) {
+func DecRunningRequests(poolName, modelName string) {
 	if modelName != "" {
-		runningRequests.WithLabelValues(modelName).Dec()
+		runningRequests.WithLabelValues(modelName, poolName).Dec()
 	}
 }
 
@@ -426,6 +466,11 @@ func RecordPluginProcessingLatency(extensionPoint, pluginType, pluginName string
 	PluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName).Observe(duration.Seconds())
 }
 
+// RecordSchedulerFilterFallback records that a soft filter fallback was triggered.
+func RecordSchedulerFilterFallback(pluginType, pluginName, policy string) {
+	SchedulerFilterFallbacks.WithLabelValues(pluginType, pluginName, policy).Inc()
+}
+
 // RecordPrefixCacheSize records the size of the prefix indexer in megabytes.
 func RecordPrefixCacheSize(size int64) {
 	PrefixCacheSize.WithLabelValues().Set(float64(size))
@@ -444,6 +489,16 @@ func RecordPrefixCacheMatch(matchedLength, totalLength int) {
 	}
 }
 
+// RecordDataLayerCollectionLatency records the duration of an endpoint data collection.
+func RecordDataLayerCollectionLatency(source string, duration time.Duration) {
+	DataLayerCollectionLatencies.WithLabelValues(source).Observe(duration.Seconds())
+}
+
+// RecordDataLayerCollectionError records a failed endpoint data collection.
+func RecordDataLayerCollectionError(source string) {
+	DataLayerCollectionErrors.WithLabelValues(source).Inc()
+}
+
 func RecordInferenceExtensionInfo(commitSha, buildRef string) {
 	InferenceExtensionInfo.WithLabelValues(commitSha, buildRef).Set(1)
 }
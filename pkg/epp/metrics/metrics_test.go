@@ -56,6 +56,7 @@ func TestMain(m *testing.M) {
 func TestRecordRequestCounterandSizes(t *testing.T) {
 	Reset()
 	type requests struct {
+		poolName        string
 		modelName       string
 		targetModelName string
 		reqSize         int
@@ -82,6 +83,7 @@ func TestRecordRequestCounterandSizes(t *testing.T) {
 				reqSize:         2480,
 			},
 			{
+				poolName:        "pool-a",
 				modelName:       "m20",
 				targetModelName: "t20",
 				reqSize:         80,
@@ -91,8 +93,8 @@ func TestRecordRequestCounterandSizes(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, req := range scenario.reqs {
-				RecordRequestCounter(req.modelName, req.targetModelName)
-				RecordRequestSizes(req.modelName, req.targetModelName, req.reqSize)
+				RecordRequestCounter(req.poolName, req.modelName, req.targetModelName)
+				RecordRequestSizes(req.poolName, req.modelName, req.targetModelName, req.reqSize)
 			}
 			wantRequestTotal, err := os.Open("testdata/request_total_metric")
 			defer func() {
@@ -163,7 +165,7 @@ func TestRecordRequestErrorCounter(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, req := range scenario.reqs {
-				RecordRequestErrCounter(req.modelName, req.targetModelName, req.error)
+				RecordRequestErrCounter("", req.modelName, req.targetModelName, req.error)
 			}
 
 			wantRequestErrorCounter, err := os.Open("testdata/request_error_total_metric")
@@ -242,7 +244,7 @@ func TestRecordRequestLatencies(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, req := range scenario.reqs {
-				success := RecordRequestLatencies(ctx, req.modelName, req.targetModelName, req.receivedTime, req.completeTime)
+				success := RecordRequestLatencies(ctx, "", req.modelName, req.targetModelName, req.receivedTime, req.completeTime)
 				if success == scenario.invalid {
 					t.Errorf("got record success(%v), but the request expects invalid(%v)", success, scenario.invalid)
 				}
@@ -343,7 +345,7 @@ func TestRecordNormalizedTimePerOutputToken(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, req := range scenario.reqs {
-				success := RecordNormalizedTimePerOutputToken(ctx, req.modelName, req.targetModelName, req.receivedTime, req.completeTime, req.outputTokens)
+				success := RecordNormalizedTimePerOutputToken(ctx, "", req.modelName, req.targetModelName, req.receivedTime, req.completeTime, req.outputTokens)
 				if success == scenario.invalid {
 					t.Errorf("got record success(%v), but the request expects invalid(%v)", success, scenario.invalid)
 				}
@@ -413,9 +415,9 @@ func TestRecordResponseMetrics(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, resp := range scenario.resp {
-				RecordInputTokens(resp.modelName, resp.targetModelName, resp.inputToken)
-				RecordOutputTokens(resp.modelName, resp.targetModelName, resp.outputToken)
-				RecordResponseSizes(resp.modelName, resp.targetModelName, resp.respSize)
+				RecordInputTokens("", resp.modelName, resp.targetModelName, resp.inputToken)
+				RecordOutputTokens("", resp.modelName, resp.targetModelName, resp.outputToken)
+				RecordResponseSizes("", resp.modelName, resp.targetModelName, resp.respSize)
 			}
 			wantResponseSize, err := os.Open("testdata/response_sizes_metric")
 			defer func() {
@@ -497,9 +499,9 @@ func TestRunningRequestsMetrics(t *testing.T) {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, req := range scenario.requests {
 				if req.complete {
-					DecRunningRequests(req.modelName)
+					DecRunningRequests("", req.modelName)
 				} else {
-					IncRunningRequests(req.modelName)
+					IncRunningRequests("", req.modelName)
 				}
 			}
 
@@ -878,3 +880,26 @@ func TestFlowControlQueueSizeMetric(t *testing.T) {
 	require.NoError(t, err, "Failed to get gauge value for non-existent user-c/100")
 	require.Equal(t, 0.0, val, "Gauge value for non-existent labels should be 0")
 }
+
+func TestDataLayerCollectionMetrics(t *testing.T) {
+	Reset()
+
+	RecordDataLayerCollectionLatency("metrics-data-source", 10*time.Millisecond)
+	RecordDataLayerCollectionLatency("metrics-data-source", 30*time.Millisecond)
+	RecordDataLayerCollectionLatency("models-data-source", 5*time.Millisecond)
+	RecordDataLayerCollectionError("metrics-data-source")
+	RecordDataLayerCollectionError("metrics-data-source")
+
+	hist, err := getHistogramVecLabelValues(t, DataLayerCollectionLatencies, "metrics-data-source")
+	require.NoError(t, err, "Failed to get histogram for metrics-data-source")
+	require.Equal(t, uint64(2), hist.GetSampleCount(), "Sample count mismatch for metrics-data-source")
+	require.InDelta(t, 0.04, hist.GetSampleSum(), 0.00001, "Sample sum mismatch for metrics-data-source")
+
+	val, err := testutil.GetCounterMetricValue(DataLayerCollectionErrors.WithLabelValues("metrics-data-source"))
+	require.NoError(t, err, "Failed to get counter value for metrics-data-source")
+	require.Equal(t, 2.0, val, "Error count mismatch for metrics-data-source")
+
+	val, err = testutil.GetCounterMetricValue(DataLayerCollectionErrors.WithLabelValues("models-data-source"))
+	require.NoError(t, err, "Failed to get counter value for models-data-source")
+	require.Equal(t, 0.0, val, "Error count mismatch for models-data-source")
+}
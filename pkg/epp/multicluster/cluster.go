@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+// ClusterAttributeKey is the key of the data layer attribute holding the cluster of a remote endpoint group.
+// Local endpoints don't have this attribute.
+const ClusterAttributeKey = "cluster"
+
+// Cluster is the name of the cluster of a remote endpoint group.
+type Cluster string
+
+// Clone implements datalayer.Cloneable.
+func (c Cluster) Clone() datalayer.Cloneable {
+	return c
+}
+
+// ClusterOf returns the cluster of the given endpoint, and false when the endpoint is local.
+func ClusterOf(endpoint interface {
+	Get(key string) (datalayer.Cloneable, bool)
+}) (string, bool) {
+	value, ok := endpoint.Get(ClusterAttributeKey)
+	if !ok {
+		return "", false
+	}
+	cluster, ok := value.(Cluster)
+	return string(cluster), ok
+}
+
+// RemoteEndpointName returns the name of the endpoint standing for the given pool in the given cluster.
+func RemoteEndpointName(pool, cluster string) string {
+	return pool + "@" + cluster
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package multicluster routes requests to the InferencePools exported by other clusters, as listed by the
+// InferencePoolImport of the served pool. Each exporting cluster is a remote endpoint group: a single candidate
+// endpoint, carrying the cluster attribute and the aggregate load of the pool in that cluster.
+package multicluster
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+// ClusterStatus is the status of an exported InferencePool in a remote cluster.
+type ClusterStatus struct {
+	// Address and Port are where the requests routed to the cluster are sent, e.g. the address of its Gateway.
+	Address string
+	Port    int32
+	// ReadyEndpoints is the number of ready endpoints of the pool in the cluster. Clusters without ready endpoints
+	// are not candidates.
+	ReadyEndpoints int
+	// Metrics is the aggregate load of the pool in the cluster, e.g. the average queue sizes and KV cache
+	// utilization of its endpoints.
+	Metrics *datalayer.Metrics
+}
+
+// StatusSource provides the status of the InferencePools exported by remote clusters.
+type StatusSource interface {
+	// ClusterStatus returns the status of the given pool in the given cluster.
+	ClusterStatus(ctx context.Context, cluster string, pool types.NamespacedName) (*ClusterStatus, error)
+}
+
+// FakeStatusSource is a StatusSource returning preset statuses, by cluster name.
+type FakeStatusSource struct {
+	mu       sync.RWMutex
+	Statuses map[string]*ClusterStatus
+	Err      map[string]error
+}
+
+func (f *FakeStatusSource) ClusterStatus(_ context.Context, cluster string, _ types.NamespacedName) (*ClusterStatus, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if err, ok := f.Err[cluster]; ok {
+		return nil, err
+	}
+	return f.Statuses[cluster], nil
+}
+
+func (f *FakeStatusSource) SetStatuses(statuses map[string]*ClusterStatus) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Statuses = statuses
+}
+
+func (f *FakeStatusSource) SetErr(err map[string]error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Err = err
+}
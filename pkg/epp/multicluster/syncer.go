@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha1"
+	"sigs.k8s.io/gateway-api-inference-extension/internal/runnable"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+// Syncer periodically sets the remote endpoints of the served pools. The remote endpoints of a pool are the
+// clusters exporting it, as listed by the InferencePoolImport of the same name and namespace, with the status
+// provided by the Source. Clusters whose status can not be fetched, or without ready endpoints, are skipped.
+type Syncer struct {
+	client.Reader
+	// Datastores returns the datastores of the served pools.
+	Datastores func() []datastore.Datastore
+	Source     StatusSource
+	// LocalCluster is the name of the local cluster, which is skipped when it exports the pool too.
+	LocalCluster string
+	Interval     time.Duration
+}
+
+// SetupWithManager adds the syncer to the manager. It runs on every replica, since each one routes requests.
+func (s *Syncer) SetupWithManager(mgr ctrl.Manager) error {
+	if s.Interval <= 0 {
+		return fmt.Errorf("invalid remote status interval %v", s.Interval)
+	}
+	return mgr.Add(runnable.NoLeaderElection(manager.RunnableFunc(s.Start)))
+}
+
+// Start syncs the remote endpoints every Interval until ctx is done.
+func (s *Syncer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		s.Sync(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync sets the remote endpoints of the served pools.
+func (s *Syncer) Sync(ctx context.Context) {
+	for _, ds := range s.Datastores() {
+		pool, err := ds.PoolGet()
+		if err != nil {
+			continue
+		}
+		if err := s.syncPool(ctx, ds, pool); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to sync the remote endpoints", "pool", client.ObjectKeyFromObject(pool))
+		}
+	}
+}
+
+func (s *Syncer) syncPool(ctx context.Context, ds datastore.Datastore, pool *v1.InferencePool) error {
+	logger := log.FromContext(ctx).WithValues("pool", client.ObjectKeyFromObject(pool))
+	poolImport := &v1alpha1.InferencePoolImport{}
+	if err := s.Get(ctx, client.ObjectKeyFromObject(pool), poolImport); err != nil {
+		if apierrors.IsNotFound(err) {
+			ds.RemoteEndpointsSet(nil)
+			return nil
+		}
+		return fmt.Errorf("unable to get InferencePoolImport - %w", err)
+	}
+
+	var endpoints []backendmetrics.PodMetrics
+	for _, cluster := range s.exportingClusters(poolImport) {
+		status, err := s.Source.ClusterStatus(ctx, cluster, client.ObjectKeyFromObject(pool))
+		if err != nil {
+			logger.V(logutil.DEFAULT).Info("Failed to get the status of a remote cluster", "cluster", cluster, "error", err)
+			continue
+		}
+		if status == nil || status.ReadyEndpoints == 0 {
+			logger.V(logutil.DEBUG).Info("Remote cluster has no ready endpoints", "cluster", cluster)
+			continue
+		}
+		endpoints = append(endpoints, newRemoteEndpoint(pool, cluster, status))
+	}
+	ds.RemoteEndpointsSet(endpoints)
+	return nil
+}
+
+// exportingClusters returns the remote clusters exporting the pool, across all the controllers of the import.
+func (s *Syncer) exportingClusters(poolImport *v1alpha1.InferencePoolImport) []string {
+	seen := map[string]bool{s.LocalCluster: true}
+	var clusters []string
+	for _, controller := range poolImport.Status.Controllers {
+		for _, exporting := range controller.ExportingClusters {
+			name := string(exporting.Name)
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			clusters = append(clusters, name)
+		}
+	}
+	return clusters
+}
+
+func newRemoteEndpoint(pool *v1.InferencePool, cluster string, status *ClusterStatus) backendmetrics.PodMetrics {
+	name := RemoteEndpointName(pool.Name, cluster)
+	endpoint := datalayer.NewEndpoint()
+	endpoint.UpdatePod(&datalayer.PodInfo{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: pool.Namespace},
+		PodName:        name,
+		Address:        status.Address,
+		Port:           strconv.Itoa(int(status.Port)),
+		Labels:         map[string]string{},
+	})
+	metrics := datalayer.NewMetrics()
+	if status.Metrics != nil {
+		metrics = status.Metrics.Clone()
+	}
+	metrics.UpdateTime = time.Now()
+	endpoint.UpdateMetrics(metrics)
+	endpoint.Put(ClusterAttributeKey, Cluster(cluster))
+	return endpoint
+}
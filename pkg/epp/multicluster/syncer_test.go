@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha1"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+func TestSyncer(t *testing.T) {
+	pool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: v1.InferencePoolSpec{
+			TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+		},
+	}
+	poolImport := &v1alpha1.InferencePoolImport{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Status: v1alpha1.InferencePoolImportStatus{
+			Controllers: []v1alpha1.ImportController{
+				{
+					Name:              "example.com/import-controller",
+					ExportingClusters: []v1alpha1.ExportingCluster{{Name: "local"}, {Name: "cluster-b"}, {Name: "cluster-c"}},
+				},
+				{
+					Name:              "example.com/other-controller",
+					ExportingClusters: []v1alpha1.ExportingCluster{{Name: "cluster-b"}, {Name: "cluster-d"}},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.Install(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(poolImport).Build()
+
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(t.Context(), pmf, 0)
+	if err := ds.PoolSet(ctx, fakeClient, pool); err != nil {
+		t.Fatalf("Unexpected error setting pool: %v", err)
+	}
+
+	source := &FakeStatusSource{
+		Statuses: map[string]*ClusterStatus{
+			"local":     {Address: "10.0.0.1", Port: 80, ReadyEndpoints: 1},
+			"cluster-b": {Address: "10.0.1.1", Port: 80, ReadyEndpoints: 2, Metrics: &datalayer.Metrics{WaitingQueueSize: 3, KVCacheUsagePercent: 0.5}},
+			"cluster-c": {Address: "10.0.2.1", Port: 80, ReadyEndpoints: 0},
+		},
+		Err: map[string]error{"cluster-d": errors.New("unreachable")},
+	}
+	syncer := &Syncer{
+		Reader:       fakeClient,
+		Datastores:   func() []datastore.Datastore { return []datastore.Datastore{ds} },
+		Source:       source,
+		LocalCluster: "local",
+		Interval:     time.Second,
+	}
+
+	type endpoint struct {
+		Name, Address, Port, Cluster string
+		WaitingQueueSize             int
+		KVCacheUsagePercent          float64
+	}
+	remoteEndpoints := func() []endpoint {
+		var res []endpoint
+		for _, ep := range ds.RemoteEndpointList() {
+			cluster, _ := ClusterOf(ep)
+			res = append(res, endpoint{
+				Name:                ep.GetPod().NamespacedName.String(),
+				Address:             ep.GetPod().Address,
+				Port:                ep.GetPod().Port,
+				Cluster:             cluster,
+				WaitingQueueSize:    ep.GetMetrics().WaitingQueueSize,
+				KVCacheUsagePercent: ep.GetMetrics().KVCacheUsagePercent,
+			})
+		}
+		return res
+	}
+
+	// Only cluster-b is a remote endpoint: the local cluster is skipped, cluster-c has no ready endpoints and the
+	// status of cluster-d can not be fetched.
+	syncer.Sync(ctx)
+	want := []endpoint{{
+		Name:                "default/pool@cluster-b",
+		Address:             "10.0.1.1",
+		Port:                "80",
+		Cluster:             "cluster-b",
+		WaitingQueueSize:    3,
+		KVCacheUsagePercent: 0.5,
+	}}
+	if diff := cmp.Diff(want, remoteEndpoints()); diff != "" {
+		t.Errorf("Unexpected remote endpoints (-want +got): %s", diff)
+	}
+	if got := len(ds.PodList(backendmetrics.AllPodsPredicate)); got != 0 {
+		t.Errorf("Expected remote endpoints not to be listed as pods, got %d pods", got)
+	}
+
+	source.SetErr(nil)
+	source.SetStatuses(map[string]*ClusterStatus{"cluster-d": {Address: "10.0.3.1", Port: 80, ReadyEndpoints: 1}})
+	syncer.Sync(ctx)
+	want = []endpoint{{Name: "default/pool@cluster-d", Address: "10.0.3.1", Port: "80", Cluster: "cluster-d"}}
+	if diff := cmp.Diff(want, remoteEndpoints()); diff != "" {
+		t.Errorf("Unexpected remote endpoints (-want +got): %s", diff)
+	}
+
+	// Deleting the import removes the remote endpoints.
+	if err := fakeClient.Delete(ctx, poolImport); err != nil {
+		t.Fatalf("Unexpected error deleting import: %v", err)
+	}
+	syncer.Sync(ctx)
+	if got := remoteEndpoints(); len(got) != 0 {
+		t.Errorf("Expected no remote endpoints, got %v", got)
+	}
+}
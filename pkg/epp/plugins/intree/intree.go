@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package intree registers the factory functions of the plugins, data sources and extractors that are
+// part of this repository, so that binaries that load an EndpointPickerConfig can share the same set of
+// known plugins.
+package intree
+
+import (
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/healthcheck"
+	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/models"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/push"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/trend"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/filter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/multi/media"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/multi/prefix"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/picker"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/profile"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/scorer"
+	testfilter "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/test/filter"
+)
+
+// Register registers the factory functions of all known plugins, data sources and extractors
+func Register() {
+	plugins.Register(prefix.PrefixCachePluginType, prefix.PrefixCachePluginFactory)
+	plugins.Register(media.MediaAffinityScorerType, media.MediaAffinityScorerFactory)
+	plugins.Register(picker.MaxScorePickerType, picker.MaxScorePickerFactory)
+	plugins.Register(picker.RandomPickerType, picker.RandomPickerFactory)
+	plugins.Register(picker.WeightedRandomPickerType, picker.WeightedRandomPickerFactory)
+	plugins.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
+	plugins.Register(profile.FallbackProfileHandlerType, profile.FallbackProfileHandlerFactory)
+	plugins.Register(scorer.KvCacheUtilizationScorerType, scorer.KvCacheUtilizationScorerFactory)
+	plugins.Register(scorer.QueueScorerType, scorer.QueueScorerFactory)
+	plugins.Register(scorer.LoraAffinityScorerType, scorer.LoraAffinityScorerFactory)
+	plugins.Register(scorer.KvCacheHeadroomScorerType, scorer.KvCacheHeadroomScorerFactory)
+	plugins.Register(scorer.ClusterLocalityScorerType, scorer.ClusterLocalityScorerFactory)
+	plugins.Register(filter.KvCacheHeadroomFilterType, filter.KvCacheHeadroomFilterFactory)
+	plugins.Register(filter.LoraAffinityFilterType, filter.LoraAffinityFilterFactory)
+	plugins.Register(filter.HealthyEndpointFilterType, filter.HealthyEndpointFilterFactory)
+	plugins.Register(filter.ServedModelFilterType, filter.ServedModelFilterFactory)
+	// register filter for test purpose only (used in conformance tests)
+	plugins.Register(testfilter.HeaderBasedTestingFilterType, testfilter.HeaderBasedTestingFilterFactory)
+
+	datalayer.RegisterSourceFactory(dlmetrics.DataSourceType, dlmetrics.DataSourceFactory)
+	datalayer.RegisterExtractorFactory(dlmetrics.ExtractorType, dlmetrics.ExtractorFactory)
+	datalayer.RegisterSourceFactory(push.DataSourceType, push.DataSourceFactory)
+	datalayer.RegisterSourceFactory(healthcheck.DataSourceType, healthcheck.DataSourceFactory)
+	datalayer.RegisterExtractorFactory(trend.ExtractorType, trend.ExtractorFactory)
+	datalayer.RegisterSourceFactory(models.DataSourceType, models.DataSourceFactory)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package poolset lets a single Endpoint Picker serve several InferencePools, each with its own datastore and
+// request handling stack.
+package poolset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/controller"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+	errutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/error"
+)
+
+// Selector selects the InferencePools served by the Endpoint Picker, by name or by labels.
+type Selector struct {
+	// Names holds the names of the selected pools. It is ignored when Labels is set.
+	Names sets.Set[string]
+	// Labels selects the pools by their labels.
+	Labels labels.Selector
+}
+
+// Selects returns whether the given pool is selected.
+func (s Selector) Selects(pool client.Object) bool {
+	if s.Labels != nil {
+		return s.Labels.Matches(labels.Set(pool.GetLabels()))
+	}
+	return s.Names.Has(pool.GetName())
+}
+
+// Stack holds the components serving the requests of a single InferencePool.
+type Stack struct {
+	Datastore datastore.Datastore
+	Director  handlers.Director
+	// PriorityBands is synchronized with the priorities of the InferenceObjectives of the pool when set.
+	PriorityBands controller.PriorityBandSyncer
+}
+
+// StackFactory creates the stack serving a pool. The components of the stack must stop when ctx is done.
+type StackFactory func(ctx context.Context, pool types.NamespacedName) (*Stack, error)
+
+// Registry holds the stacks of the InferencePools served by the Endpoint Picker. A stack is created when its
+// pool is added by the InferencePool reconciler and stopped when the pool is removed.
+type Registry struct {
+	ctx       context.Context
+	namespace string
+	selector  Selector
+	factory   StackFactory
+
+	mu     sync.RWMutex
+	stacks map[string]*entry // by pool name
+}
+
+type entry struct {
+	stack  *Stack
+	cancel context.CancelFunc
+}
+
+var (
+	_ controller.PoolSet    = &Registry{}
+	_ handlers.PoolResolver = &Registry{}
+)
+
+// NewRegistry returns a registry serving the selected pools of the given namespace. The stacks are created by
+// the factory, and stopped when the pools are removed or ctx is done.
+func NewRegistry(ctx context.Context, namespace string, selector Selector, factory StackFactory) *Registry {
+	return &Registry{
+		ctx:       ctx,
+		namespace: namespace,
+		selector:  selector,
+		factory:   factory,
+		stacks:    make(map[string]*entry),
+	}
+}
+
+// Selects returns whether the given pool is served.
+func (r *Registry) Selects(pool client.Object) bool {
+	return pool.GetNamespace() == r.namespace && r.selector.Selects(pool)
+}
+
+// Datastore returns the datastore of a served pool.
+func (r *Registry) Datastore(pool types.NamespacedName) (datastore.Datastore, bool) {
+	stack, ok := r.Stack(pool)
+	if !ok {
+		return nil, false
+	}
+	return stack.Datastore, true
+}
+
+// Stack returns the stack of a served pool.
+func (r *Registry) Stack(pool types.NamespacedName) (*Stack, bool) {
+	if pool.Namespace != r.namespace {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.stacks[pool.Name]
+	if !ok {
+		return nil, false
+	}
+	return e.stack, true
+}
+
+// Add returns the datastore of a served pool, creating its stack if the pool was not served yet. The returned
+// boolean is true when the stack was created.
+func (r *Registry) Add(pool types.NamespacedName) (datastore.Datastore, bool, error) {
+	if pool.Namespace != r.namespace {
+		return nil, false, fmt.Errorf("pool %s is not in the served namespace %s", pool, r.namespace)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.stacks[pool.Name]; ok {
+		return e.stack.Datastore, false, nil
+	}
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	stack, err := r.factory(ctx, pool)
+	if err != nil {
+		cancel()
+		return nil, false, fmt.Errorf("failed to create the stack of pool %s - %w", pool, err)
+	}
+	r.stacks[pool.Name] = &entry{stack: stack, cancel: cancel}
+	return stack.Datastore, true, nil
+}
+
+// Remove stops serving a pool: its datastore is cleared and its stack is stopped.
+func (r *Registry) Remove(pool types.NamespacedName) {
+	if pool.Namespace != r.namespace {
+		return
+	}
+	r.mu.Lock()
+	e, ok := r.stacks[pool.Name]
+	delete(r.stacks, pool.Name)
+	r.mu.Unlock()
+
+	if ok {
+		e.stack.Datastore.Clear()
+		e.cancel()
+	}
+}
+
+// Datastores returns the datastores of all served pools, ordered by pool name.
+func (r *Registry) Datastores() []datastore.Datastore {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.stacks))
+	for name := range r.stacks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	stores := make([]datastore.Datastore, 0, len(names))
+	for _, name := range names {
+		stores = append(stores, r.stacks[name].stack.Datastore)
+	}
+	return stores
+}
+
+// SyncPriorityBands synchronizes the flow control priority bands of the served pools with the priorities of their
+// InferenceObjectives.
+func (r *Registry) SyncPriorityBands() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var errs []error
+	for _, e := range r.stacks {
+		if e.stack.PriorityBands != nil {
+			if err := controller.SyncPriorityBands(e.stack.Datastore, e.stack.PriorityBands); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PoolHasSynced returns whether at least one pool is served and all the served pools have synced.
+func (r *Registry) PoolHasSynced() bool {
+	stores := r.Datastores()
+	if len(stores) == 0 {
+		return false
+	}
+	for _, ds := range stores {
+		if !ds.PoolHasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve returns the datastore and director of the named pool. When the name is empty, the only served pool
+// is used.
+func (r *Registry) Resolve(pool string) (handlers.Datastore, handlers.Director, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if pool == "" {
+		switch len(r.stacks) {
+		case 0:
+			return nil, nil, errutil.Error{Code: errutil.ServiceUnavailable, Msg: "no InferencePool is served"}
+		case 1:
+			for _, e := range r.stacks {
+				return e.stack.Datastore, e.stack.Director, nil
+			}
+		}
+		return nil, nil, errutil.Error{Code: errutil.BadRequest,
+			Msg: fmt.Sprintf("the request must select an InferencePool with the %s header or metadata", metadata.InferencePoolKey)}
+	}
+
+	e, ok := r.stacks[pool]
+	if !ok {
+		return nil, nil, errutil.Error{Code: errutil.BadRequest, Msg: fmt.Sprintf("InferencePool %s is not served", pool)}
+	}
+	return e.stack.Datastore, e.stack.Director, nil
+}
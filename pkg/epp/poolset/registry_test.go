@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolset
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	errutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/error"
+	utiltest "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/testing"
+)
+
+func TestSelector(t *testing.T) {
+	pool := utiltest.MakeInferencePool("pool-a").Namespace("ns").ObjRef()
+	pool.Labels = map[string]string{"team": "a"}
+
+	tests := []struct {
+		name     string
+		selector Selector
+		want     bool
+	}{
+		{
+			name:     "selected by name",
+			selector: Selector{Names: sets.New("pool-a", "pool-b")},
+			want:     true,
+		},
+		{
+			name:     "not selected by name",
+			selector: Selector{Names: sets.New("pool-b")},
+			want:     false,
+		},
+		{
+			name:     "selected by labels",
+			selector: Selector{Labels: labels.SelectorFromSet(labels.Set{"team": "a"})},
+			want:     true,
+		},
+		{
+			name:     "labels take precedence over names",
+			selector: Selector{Names: sets.New("pool-a"), Labels: labels.SelectorFromSet(labels.Set{"team": "b"})},
+			want:     false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.selector.Selects(pool); got != test.want {
+				t.Errorf("Selects() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stopped := map[string]<-chan struct{}{}
+	factory := func(ctx context.Context, pool types.NamespacedName) (*Stack, error) {
+		if pool.Name == "broken" {
+			return nil, errors.New("broken pool")
+		}
+		stopped[pool.Name] = ctx.Done()
+		pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+		return &Stack{Datastore: datastore.NewDatastore(ctx, pmf, 0)}, nil
+	}
+	registry := NewRegistry(ctx, "ns", Selector{Names: sets.New("pool-a", "pool-b")}, factory)
+	poolA := types.NamespacedName{Name: "pool-a", Namespace: "ns"}
+	poolB := types.NamespacedName{Name: "pool-b", Namespace: "ns"}
+
+	if registry.PoolHasSynced() {
+		t.Error("Expected no synced pool before any pool is served")
+	}
+	if _, _, err := registry.Resolve(""); errutil.CanonicalCode(err) != errutil.ServiceUnavailable {
+		t.Errorf("Expected %s resolving a pool before any pool is served, got %v", errutil.ServiceUnavailable, err)
+	}
+
+	dsA, added, err := registry.Add(poolA)
+	if err != nil || !added {
+		t.Fatalf("Expected pool-a to be added, got added=%v, err=%v", added, err)
+	}
+	if ds, added, _ := registry.Add(poolA); added || ds != dsA {
+		t.Error("Expected the existing stack of pool-a to be returned")
+	}
+	if _, _, err := registry.Add(types.NamespacedName{Name: "pool-c", Namespace: "other"}); err == nil {
+		t.Error("Expected an error adding a pool of another namespace")
+	}
+	if _, _, err := registry.Add(types.NamespacedName{Name: "broken", Namespace: "ns"}); err == nil {
+		t.Error("Expected the factory error to be returned")
+	}
+
+	// A single served pool serves the requests not selecting a pool.
+	if ds, _, err := registry.Resolve(""); err != nil || ds != dsA {
+		t.Errorf("Expected pool-a to be resolved, got err=%v", err)
+	}
+
+	dsB, _, err := registry.Add(poolB)
+	if err != nil {
+		t.Fatalf("Unexpected error adding pool-b: %v", err)
+	}
+	if got := registry.Datastores(); len(got) != 2 || got[0] != dsA || got[1] != dsB {
+		t.Errorf("Expected the datastores of pool-a and pool-b, got %v", got)
+	}
+	if ds, _, err := registry.Resolve("pool-b"); err != nil || ds != dsB {
+		t.Errorf("Expected pool-b to be resolved, got err=%v", err)
+	}
+	if _, _, err := registry.Resolve(""); errutil.CanonicalCode(err) != errutil.BadRequest {
+		t.Errorf("Expected %s resolving an unselected pool among several, got %v", errutil.BadRequest, err)
+	}
+	if _, _, err := registry.Resolve("pool-c"); errutil.CanonicalCode(err) != errutil.BadRequest {
+		t.Errorf("Expected %s resolving a pool not served, got %v", errutil.BadRequest, err)
+	}
+
+	registry.Remove(poolA)
+	if _, ok := registry.Datastore(poolA); ok {
+		t.Error("Expected pool-a to no longer be served")
+	}
+	select {
+	case <-stopped["pool-a"]:
+	default:
+		t.Error("Expected the stack of pool-a to be stopped")
+	}
+	select {
+	case <-stopped["pool-b"]:
+		t.Error("Expected the stack of pool-b to keep running")
+	default:
+	}
+}
@@ -30,6 +30,10 @@ import (
 	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
 
+// estimatedOutputTokens is the number of output tokens assumed when estimating the cost of a request that doesn't
+// specify max tokens.
+const estimatedOutputTokens = 256
+
 // AdmissionController defines the interface for making admission control decisions.
 // Implementations of this interface determine whether an incoming inference request should be accepted or rejected
 // based on various criteria such as system load, fairness, priority, and available capacity.
@@ -128,6 +132,7 @@ func (lac *LegacyAdmissionController) Admit(
 type FlowControlAdmissionController struct {
 	saturationDetector saturationDetector
 	flowController     flowController
+	fairnessWeights    map[string]int
 }
 
 // NewFlowControlAdmissionController creates a new FlowControlAdmissionController.
@@ -139,6 +144,13 @@ func NewFlowControlAdmissionController(sd saturationDetector, fc flowController)
 	}
 }
 
+// WithFairnessWeights sets the fairness weights of the flows, keyed by fairness ID. They take precedence over the
+// weight of the request's InferenceObjective.
+func (fcac *FlowControlAdmissionController) WithFairnessWeights(weights map[string]int) *FlowControlAdmissionController {
+	fcac.fairnessWeights = weights
+	return fcac
+}
+
 // Admit implements the AdmissionController interface by checking for saturation on sheddable requests first, then
 // deferring to the Flow Control system.
 func (fcac *FlowControlAdmissionController) Admit(
@@ -156,12 +168,19 @@ func (fcac *FlowControlAdmissionController) Admit(
 
 	logger.V(logutil.TRACE).Info("Request proceeding to flow control", "requestID", reqCtx.SchedulingRequest.RequestId)
 
+	fairnessWeight := reqCtx.FairnessWeight
+	if weight, ok := fcac.fairnessWeights[reqCtx.FairnessID]; ok {
+		fairnessWeight = weight
+	}
 	fcReq := &flowControlRequest{
 		requestID:       reqCtx.SchedulingRequest.RequestId,
 		fairnessID:      reqCtx.FairnessID,
+		fairnessWeight:  fairnessWeight,
 		priority:        priority,
 		requestByteSize: uint64(reqCtx.RequestSize),
-		candidatePods:   candidatePods,
+		estimatedCost: uint64(requtil.EstimateRequestTokens(reqCtx.SchedulingRequest.Body,
+			requtil.DefaultCharactersPerToken, estimatedOutputTokens)),
+		candidatePods: candidatePods,
 	}
 
 	outcome, err := fcac.flowController.EnqueueAndWait(ctx, fcReq)
@@ -174,16 +193,24 @@ func (fcac *FlowControlAdmissionController) Admit(
 type flowControlRequest struct {
 	requestID       string
 	fairnessID      string
+	fairnessWeight  int
 	priority        int
 	requestByteSize uint64
-	candidatePods   []backendmetrics.PodMetrics
+	// estimatedCost is the estimated number of prompt and output tokens of the request.
+	estimatedCost uint64
+	candidatePods []backendmetrics.PodMetrics
 }
 
-var _ types.FlowControlRequest = &flowControlRequest{}
+var (
+	_ types.WeightedFlowControlRequest       = &flowControlRequest{}
+	_ types.CostEstimatingFlowControlRequest = &flowControlRequest{}
+)
 
 func (r *flowControlRequest) ID() string                         { return r.requestID }
 func (r *flowControlRequest) InitialEffectiveTTL() time.Duration { return 0 } // Use controller default.
 func (r *flowControlRequest) ByteSize() uint64                   { return r.requestByteSize }
+func (r *flowControlRequest) FairnessWeight() int                { return r.fairnessWeight }
+func (r *flowControlRequest) EstimatedCost() uint64              { return r.estimatedCost }
 func (r *flowControlRequest) CandidatePodsForScheduling() []backendmetrics.PodMetrics {
 	return r.candidatePods
 }
@@ -206,6 +233,8 @@ func translateFlowControlOutcome(outcome types.QueueOutcome, err error) error {
 		return errutil.Error{Code: errutil.InferencePoolResourceExhausted, Msg: msg}
 	case types.QueueOutcomeEvictedTTL:
 		return errutil.Error{Code: errutil.ServiceUnavailable, Msg: "request timed out in queue: " + msg}
+	case types.QueueOutcomeEvictedDeadlineUnreachable:
+		return errutil.Error{Code: errutil.ServiceUnavailable, Msg: "request can no longer meet its deadline: " + msg}
 	case types.QueueOutcomeEvictedContextCancelled:
 		return errutil.Error{Code: errutil.ServiceUnavailable, Msg: "client disconnected: " + msg}
 	case types.QueueOutcomeRejectedOther, types.QueueOutcomeEvictedOther:
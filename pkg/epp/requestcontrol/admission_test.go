@@ -19,6 +19,7 @@ package requestcontrol
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -46,13 +47,15 @@ type mockFlowController struct {
 	outcome fctypes.QueueOutcome
 	err     error
 	called  bool
+	req     fctypes.FlowControlRequest
 }
 
 func (m *mockFlowController) EnqueueAndWait(
 	_ context.Context,
-	_ fctypes.FlowControlRequest,
+	req fctypes.FlowControlRequest,
 ) (fctypes.QueueOutcome, error) {
 	m.called = true
+	m.req = req
 	return m.outcome, m.err
 }
 
@@ -144,6 +147,8 @@ func TestFlowControlRequestAdapter(t *testing.T) {
 			fcReq := &flowControlRequest{
 				requestID:       tc.requestID,
 				fairnessID:      tc.fairnessID,
+				fairnessWeight:  3,
+				estimatedCost:   300,
 				priority:        tc.priority,
 				requestByteSize: tc.requestByteSize,
 				candidatePods:   candidatePods,
@@ -154,6 +159,8 @@ func TestFlowControlRequestAdapter(t *testing.T) {
 			assert.Equal(t, candidatePods, fcReq.CandidatePodsForScheduling(), "CandidatePodsForScheduling() mismatch")
 			assert.Equal(t, tc.expectFlowKey, fcReq.FlowKey(), "FlowKey() mismatch")
 			assert.Zero(t, fcReq.InitialEffectiveTTL(), "InitialEffectiveTTL() should be zero")
+			assert.Equal(t, 3, fcReq.FairnessWeight(), "FairnessWeight() mismatch")
+			assert.Equal(t, uint64(300), fcReq.EstimatedCost(), "EstimatedCost() mismatch")
 		})
 	}
 }
@@ -217,6 +224,15 @@ func TestFlowControlAdmissionController_Admit(t *testing.T) {
 			expectErrCode:   errutil.ServiceUnavailable,
 			expectErrSubstr: "request timed out in queue: timeout",
 		},
+		{
+			name:            "fc_evict_deadline_unreachable",
+			priority:        0,
+			fcOutcome:       fctypes.QueueOutcomeEvictedDeadlineUnreachable,
+			fcErr:           errors.New("deadline unreachable"),
+			expectErr:       true,
+			expectErrCode:   errutil.ServiceUnavailable,
+			expectErrSubstr: "request can no longer meet its deadline: deadline unreachable",
+		},
 		{
 			name:            "fc_evict_context_cancelled",
 			priority:        0,
@@ -280,3 +296,99 @@ func TestFlowControlAdmissionController_Admit(t *testing.T) {
 		})
 	}
 }
+
+func TestFlowControlAdmissionController_FairnessWeight(t *testing.T) {
+	t.Parallel()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	testCases := []struct {
+		name            string
+		fairnessID      string
+		objectiveWeight int
+		configWeights   map[string]int
+		expectWeight    int
+	}{
+		{
+			name:         "no_weight",
+			fairnessID:   "flow-1",
+			expectWeight: 0,
+		},
+		{
+			name:            "objective_weight",
+			fairnessID:      "flow-1",
+			objectiveWeight: 2,
+			expectWeight:    2,
+		},
+		{
+			name:            "config_weight_overrides_objective_weight",
+			fairnessID:      "flow-1",
+			objectiveWeight: 2,
+			configWeights:   map[string]int{"flow-1": 5},
+			expectWeight:    5,
+		},
+		{
+			name:            "config_weight_of_other_flow_ignored",
+			fairnessID:      "flow-1",
+			objectiveWeight: 2,
+			configWeights:   map[string]int{"flow-2": 5},
+			expectWeight:    2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &mockFlowController{outcome: fctypes.QueueOutcomeDispatched}
+			ac := NewFlowControlAdmissionController(&mockSaturationDetector{}, fc).WithFairnessWeights(tc.configWeights)
+			reqCtx := &handlers.RequestContext{
+				SchedulingRequest: &schedulingtypes.LLMRequest{RequestId: "test-req"},
+				FairnessID:        tc.fairnessID,
+				FairnessWeight:    tc.objectiveWeight,
+			}
+
+			require.NoError(t, ac.Admit(ctx, reqCtx, nil, 0), "Admit() returned an unexpected error")
+			req, ok := fc.req.(fctypes.WeightedFlowControlRequest)
+			require.True(t, ok, "the flow control request should carry a fairness weight")
+			assert.Equal(t, tc.expectWeight, req.FairnessWeight(), "incorrect fairness weight")
+		})
+	}
+}
+
+func TestFlowControlAdmissionController_EstimatedCost(t *testing.T) {
+	t.Parallel()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	prompt := strings.Repeat("a", 40) // 10 tokens.
+
+	testCases := []struct {
+		name       string
+		body       *schedulingtypes.LLMRequestBody
+		expectCost uint64
+	}{
+		{
+			name:       "with_max_tokens",
+			body:       &schedulingtypes.LLMRequestBody{Completions: &schedulingtypes.CompletionsRequest{Prompt: prompt, MaxTokens: 100}},
+			expectCost: 110,
+		},
+		{
+			name:       "without_max_tokens",
+			body:       &schedulingtypes.LLMRequestBody{Completions: &schedulingtypes.CompletionsRequest{Prompt: prompt}},
+			expectCost: 10 + estimatedOutputTokens,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			fc := &mockFlowController{outcome: fctypes.QueueOutcomeDispatched}
+			ac := NewFlowControlAdmissionController(&mockSaturationDetector{}, fc)
+			reqCtx := &handlers.RequestContext{
+				SchedulingRequest: &schedulingtypes.LLMRequest{RequestId: "test-req", Body: tc.body},
+			}
+
+			require.NoError(t, ac.Admit(ctx, reqCtx, nil, 0), "Admit() returned an unexpected error")
+			req, ok := fc.req.(fctypes.CostEstimatingFlowControlRequest)
+			require.True(t, ok, "the flow control request should carry a cost estimate")
+			assert.Equal(t, tc.expectCost, req.EstimatedCost(), "incorrect estimated cost")
+		})
+	}
+}
@@ -23,15 +23,18 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
@@ -46,6 +49,8 @@ type Datastore interface {
 	PoolGet() (*v1.InferencePool, error)
 	ObjectiveGet(modelName string) *v1alpha2.InferenceObjective
 	PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics
+	RemoteEndpointList() []backendmetrics.PodMetrics
+	PodRequestStarted(endpoint types.NamespacedName)
 }
 
 // Scheduler defines the interface required by the Director for scheduling.
@@ -125,6 +130,14 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 		// Default to 0 if not specified.
 		infObjective.Spec.Priority = &d.defaultPriority
 	}
+	if value, ok := infObjective.Annotations[metadata.FairnessWeightAnnotation]; ok {
+		if weight, err := strconv.Atoi(value); err == nil && weight > 0 {
+			reqCtx.FairnessWeight = weight
+		} else {
+			logger.V(logutil.DEFAULT).Info("Ignoring invalid fairness weight of InferenceObjective",
+				"objectiveKey", reqCtx.ObjectiveKey, "annotation", metadata.FairnessWeightAnnotation, "value", value)
+		}
+	}
 
 	// Prepare LLMRequest (needed for both saturation detection and Scheduler)
 	reqCtx.SchedulingRequest = &schedulingtypes.LLMRequest{
@@ -167,6 +180,7 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 }
 
 // getCandidatePodsForScheduling gets the list of relevant endpoints for the scheduling cycle from the datastore.
+// Draining endpoints are never candidates, while the remote endpoints of the pool in other clusters are when enabled.
 // according to EPP protocol, if "x-gateway-destination-endpoint-subset" is set on the request metadata and specifies
 // a subset of endpoints, only these endpoints will be considered as candidates for the scheduler.
 // Snapshot pod metrics from the datastore to:
@@ -175,15 +189,20 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 func (d *Director) getCandidatePodsForScheduling(ctx context.Context, requestMetadata map[string]any) []backendmetrics.PodMetrics {
 	loggerTrace := log.FromContext(ctx).V(logutil.TRACE)
 
+	var remoteEndpoints []backendmetrics.PodMetrics
+	if d.requestControlPlugins.remoteEndpoints {
+		remoteEndpoints = d.datastore.RemoteEndpointList()
+	}
+
 	subsetMap, found := requestMetadata[metadata.SubsetFilterNamespace].(map[string]any)
 	if !found {
-		return d.datastore.PodList(backendmetrics.AllPodsPredicate)
+		return append(d.datastore.PodList(backendmetrics.ServingPodsPredicate), remoteEndpoints...)
 	}
 
 	// Check if endpoint key is present in the subset map and ensure there is at least one value
 	endpointSubsetList, found := subsetMap[metadata.SubsetFilterKey].([]any)
 	if !found {
-		return d.datastore.PodList(backendmetrics.AllPodsPredicate)
+		return append(d.datastore.PodList(backendmetrics.ServingPodsPredicate), remoteEndpoints...)
 	} else if len(endpointSubsetList) == 0 {
 		loggerTrace.Info("found empty subset filter in request metadata, filtering all pods")
 		return []backendmetrics.PodMetrics{}
@@ -200,6 +219,9 @@ func (d *Director) getCandidatePodsForScheduling(ctx context.Context, requestMet
 
 	podTotalCount := 0
 	podFilteredList := d.datastore.PodList(func(pm backendmetrics.PodMetrics) bool {
+		if !backendmetrics.ServingPodsPredicate(pm) {
+			return false
+		}
 		podTotalCount++
 		if _, found := endpoints[pm.GetPod().GetIPAddress()]; found {
 			return true
@@ -207,6 +229,12 @@ func (d *Director) getCandidatePodsForScheduling(ctx context.Context, requestMet
 		return false
 	})
 
+	for _, endpoint := range remoteEndpoints {
+		if _, found := endpoints[endpoint.GetPod().GetIPAddress()]; found {
+			podFilteredList = append(podFilteredList, endpoint)
+		}
+	}
+
 	loggerTrace.Info("filtered candidate pods by subset filtering", "podTotalCount", podTotalCount, "filteredCount", len(podFilteredList))
 
 	return podFilteredList
@@ -235,6 +263,10 @@ func (d *Director) prepareRequest(ctx context.Context, reqCtx *handlers.RequestC
 
 	reqCtx.TargetPod = targetPods[0]
 	reqCtx.TargetEndpoint = multiEndpointString
+	// Count the request as in flight as soon as its target pod is picked, so that the pod is drained rather than
+	// removed if it leaves the pool before the request is forwarded.
+	d.datastore.PodRequestStarted(reqCtx.TargetPod.NamespacedName)
+	reqCtx.TargetPodInFlight = true
 
 	d.runPreRequestPlugins(ctx, reqCtx.SchedulingRequest, result)
 
@@ -244,12 +276,29 @@ func (d *Director) prepareRequest(ctx context.Context, reqCtx *handlers.RequestC
 func (d *Director) toSchedulerPodMetrics(pods []backendmetrics.PodMetrics) []schedulingtypes.Pod {
 	pm := make([]schedulingtypes.Pod, len(pods))
 	for i, pod := range pods {
-		pm[i] = &schedulingtypes.PodMetrics{Pod: pod.GetPod().Clone(), MetricsState: pod.GetMetrics().Clone()}
+		pm[i] = &schedulingtypes.PodMetrics{Pod: pod.GetPod().Clone(), MetricsState: pod.GetMetrics().Clone(), Health: pod.GetHealth().Clone(),
+			Attributes: snapshotAttributes(pod)}
 	}
 
 	return pm
 }
 
+// snapshotAttributes copies the data layer attributes of the pod, so that scheduling plugins
+// see consistent values during the scheduling cycles of a request.
+func snapshotAttributes(pod backendmetrics.PodMetrics) *datalayer.Attributes {
+	keys := pod.Keys()
+	if len(keys) == 0 {
+		return nil
+	}
+	attributes := datalayer.NewAttributes()
+	for _, key := range keys {
+		if value, ok := pod.Get(key); ok { // Get returns a copy
+			attributes.Put(key, value)
+		}
+	}
+	return attributes
+}
+
 // HandleResponseReceived is called when the response headers are received.
 func (d *Director) HandleResponseReceived(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
 	response := &Response{
@@ -294,7 +343,7 @@ func (d *Director) HandleResponseBodyComplete(ctx context.Context, reqCtx *handl
 }
 
 func (d *Director) GetRandomPod() *backend.Pod {
-	pods := d.datastore.PodList(backendmetrics.AllPodsPredicate)
+	pods := d.datastore.PodList(backendmetrics.ServingPodsPredicate)
 	if len(pods) == 0 {
 		return nil
 	}
@@ -37,6 +37,8 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/trend"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
@@ -73,7 +75,8 @@ func (m *mockScheduler) Schedule(_ context.Context, _ *schedulingtypes.LLMReques
 }
 
 type mockDatastore struct {
-	pods []backendmetrics.PodMetrics
+	pods   []backendmetrics.PodMetrics
+	remote []backendmetrics.PodMetrics
 }
 
 func (ds *mockDatastore) PoolGet() (*v1.InferencePool, error) {
@@ -92,6 +95,10 @@ func (ds *mockDatastore) PodList(predicate func(backendmetrics.PodMetrics) bool)
 
 	return res
 }
+func (ds *mockDatastore) RemoteEndpointList() []backendmetrics.PodMetrics {
+	return ds.remote
+}
+func (ds *mockDatastore) PodRequestStarted(_ types.NamespacedName) {}
 
 func TestDirector_HandleRequest(t *testing.T) {
 	ctx := logutil.NewTestLoggerIntoContext(context.Background())
@@ -116,6 +123,7 @@ func TestDirector_HandleRequest(t *testing.T) {
 	ioFoodReviewResolve := testutil.MakeInferenceObjective("imFoodReviewResolve").
 		CreationTimestamp(metav1.Unix(1000, 0)).
 		Priority(1).
+		Annotation(metadata.FairnessWeightAnnotation, "4").
 		ObjRef()
 
 	// Datastore setup
@@ -311,6 +319,7 @@ func TestDirector_HandleRequest(t *testing.T) {
 			},
 			wantReqCtx: &handlers.RequestContext{
 				ObjectiveKey:    objectiveNameResolve,
+				FairnessWeight:  4,
 				TargetModelName: "resolved-target-model-A",
 				TargetPod: &backend.Pod{
 					NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod1"},
@@ -450,7 +459,9 @@ func TestDirector_HandleRequest(t *testing.T) {
 				assert.Equal(t, test.wantReqCtx.TargetModelName, returnedReqCtx.TargetModelName,
 					"reqCtx.ResolvedTargetModel mismatch")
 				assert.Equal(t, test.wantReqCtx.TargetPod, returnedReqCtx.TargetPod, "reqCtx.TargetPod mismatch")
+				assert.True(t, returnedReqCtx.TargetPodInFlight, "reqCtx.TargetPodInFlight should be set")
 				assert.Equal(t, test.wantReqCtx.TargetEndpoint, returnedReqCtx.TargetEndpoint, "reqCtx.TargetEndpoint mismatch")
+				assert.Equal(t, test.wantReqCtx.FairnessWeight, returnedReqCtx.FairnessWeight, "reqCtx.FairnessWeight mismatch")
 			}
 
 			if test.wantMutatedBodyModel != "" {
@@ -547,6 +558,69 @@ func TestGetCandidatePodsForScheduling(t *testing.T) {
 	}
 }
 
+func TestGetCandidatePodsForSchedulingWithRemoteEndpoints(t *testing.T) {
+	local := &backendmetrics.FakePodMetrics{Pod: &backend.Pod{
+		NamespacedName: types.NamespacedName{Name: "pod1"},
+		Address:        "10.0.0.1",
+	}}
+	remote := &backendmetrics.FakePodMetrics{Pod: &backend.Pod{
+		NamespacedName: types.NamespacedName{Name: "pool@cluster-b"},
+		Address:        "192.168.0.1",
+	}}
+	ds := &mockDatastore{pods: []backendmetrics.PodMetrics{local}, remote: []backendmetrics.PodMetrics{remote}}
+
+	tests := []struct {
+		name            string
+		remoteEndpoints bool
+		metadata        map[string]any
+		output          []backendmetrics.PodMetrics
+	}{
+		{
+			name:            "no subset filter — local and remote endpoints",
+			remoteEndpoints: true,
+			metadata:        map[string]any{},
+			output:          []backendmetrics.PodMetrics{local, remote},
+		},
+		{
+			name:            "subset filter — remote endpoint not in the subset",
+			remoteEndpoints: true,
+			metadata: map[string]any{metadata.SubsetFilterNamespace: map[string]any{
+				metadata.SubsetFilterKey: []any{"10.0.0.1:8000"},
+			}},
+			output: []backendmetrics.PodMetrics{local},
+		},
+		{
+			name:            "subset filter — remote endpoint in the subset",
+			remoteEndpoints: true,
+			metadata: map[string]any{metadata.SubsetFilterNamespace: map[string]any{
+				metadata.SubsetFilterKey: []any{"192.168.0.1:8000"},
+			}},
+			output: []backendmetrics.PodMetrics{remote},
+		},
+		{
+			name:     "remote endpoints disabled — local endpoints only",
+			metadata: map[string]any{},
+			output:   []backendmetrics.PodMetrics{local},
+		},
+		{
+			name: "remote endpoints disabled — remote endpoint in the subset",
+			metadata: map[string]any{metadata.SubsetFilterNamespace: map[string]any{
+				metadata.SubsetFilterKey: []any{"192.168.0.1:8000"},
+			}},
+			output: []backendmetrics.PodMetrics{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			director := NewDirectorWithConfig(ds, &mockScheduler{}, &mockAdmissionController{}, NewConfig().WithRemoteEndpoints(test.remoteEndpoints))
+			got := director.getCandidatePodsForScheduling(context.Background(), test.metadata)
+			if diff := cmp.Diff(test.output, got); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
 func TestGetRandomPod(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -615,6 +689,38 @@ func TestGetRandomPod(t *testing.T) {
 	}
 }
 
+func TestToSchedulerPodMetrics(t *testing.T) {
+	endpoint := datalayer.NewEndpoint()
+	endpoint.UpdatePod(&backend.Pod{NamespacedName: types.NamespacedName{Name: "pod1"}})
+	endpoint.UpdateMetrics(&backendmetrics.MetricsState{WaitingQueueSize: 3})
+	endpoint.UpdateHealth(func(*datalayer.Health) *datalayer.Health {
+		return &datalayer.Health{State: datalayer.Unhealthy}
+	})
+	endpoint.Put("test-attribute", &trend.Trend{EWMA: 7})
+	plain := &backendmetrics.FakePodMetrics{
+		Pod:     &backend.Pod{NamespacedName: types.NamespacedName{Name: "pod2"}},
+		Metrics: &backendmetrics.MetricsState{},
+	}
+
+	d := &Director{}
+	pods := d.toSchedulerPodMetrics([]backendmetrics.PodMetrics{endpoint, plain})
+
+	assert.Equal(t, 3, pods[0].GetMetrics().WaitingQueueSize)
+	assert.Equal(t, datalayer.Unhealthy, pods[0].GetHealth().GetState())
+	value, ok := pods[0].Get("test-attribute")
+	assert.True(t, ok)
+	assert.Equal(t, 7.0, value.(*trend.Trend).EWMA)
+
+	// the snapshot is not affected by later updates of the endpoint
+	endpoint.Put("test-attribute", &trend.Trend{EWMA: 8})
+	value, _ = pods[0].Get("test-attribute")
+	assert.Equal(t, 7.0, value.(*trend.Trend).EWMA)
+
+	assert.Nil(t, pods[1].GetHealth())
+	_, ok = pods[1].Get("test-attribute")
+	assert.False(t, ok)
+}
+
 func TestDirector_HandleResponseReceived(t *testing.T) {
 	pr1 := newTestResponseReceived("pr1")
 
@@ -36,6 +36,7 @@ type Config struct {
 	responseReceivedPlugins  []ResponseReceived
 	responseStreamingPlugins []ResponseStreaming
 	responseCompletePlugins  []ResponseComplete
+	remoteEndpoints          bool
 }
 
 // WithPreRequestPlugins sets the given plugins as the PreRequest plugins.
@@ -66,6 +67,13 @@ func (c *Config) WithResponseCompletePlugins(plugins ...ResponseComplete) *Confi
 	return c
 }
 
+// WithRemoteEndpoints sets whether the remote endpoints of the pool in other clusters are candidates for scheduling.
+// They should only be candidates when the scheduler prefers the local endpoints, e.g. with the cluster locality scorer.
+func (c *Config) WithRemoteEndpoints(remoteEndpoints bool) *Config {
+	c.remoteEndpoints = remoteEndpoints
+	return c
+}
+
 // AddPlugins adds the given plugins to the Config.
 // The type of each plugin is checked and added to the corresponding list of plugins in the Config.
 // If a plugin implements multiple plugin interfaces, it will be added to each corresponding list.
@@ -81,9 +81,10 @@ func NewDetector(config *Config, logger logr.Logger) *Detector {
 // IsSaturated checks if the system is currently considered saturated.
 // The system is saturated if NO pod currently has "good capacity".
 // "Good capacity" means:
-//  1. Metrics are fresh (not stale).
-//  2. WaitingQueueSize <= QueueDepthThreshold.
-//  3. KVCacheUsagePercent <= KVCacheUtilThreshold.
+//  1. The pod is not unhealthy (its data collection is not failing).
+//  2. Metrics are fresh (not stale).
+//  3. WaitingQueueSize <= QueueDepthThreshold.
+//  4. KVCacheUsagePercent <= KVCacheUtilThreshold.
 //
 // This function is called with the relevant pods for the current request.
 func (d *Detector) IsSaturated(ctx context.Context, candidatePods []backendmetrics.PodMetrics) bool {
@@ -101,6 +102,13 @@ func (d *Detector) IsSaturated(ctx context.Context, candidatePods []backendmetri
 			continue
 		}
 
+		// Check for endpoints that stopped reporting
+		if health := podMetric.GetHealth(); !health.IsHealthy() {
+			logger.V(logutil.TRACE).Info("Pod is unhealthy, considered as not having good capacity",
+				"pod", podNn, "health", health)
+			continue
+		}
+
 		// Check for metric staleness
 		if time.Since(metrics.UpdateTime) > d.config.MetricsStalenessThreshold {
 			logger.V(logutil.TRACE).Info("Pod metrics are stale, considered as not having good capacity",
@@ -30,6 +30,7 @@ import (
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 )
 
 func newMockPodMetrics(name string, metrics *backendmetrics.MetricsState) *backendmetrics.FakePodMetrics {
@@ -149,6 +150,22 @@ func TestDetector_IsSaturated(t *testing.T) {
 			},
 			expectedSaturation: true,
 		},
+		{
+			name:   "Single unhealthy pod with fresh metrics",
+			config: defaultConfig,
+			pods: []backendmetrics.PodMetrics{
+				func() backendmetrics.PodMetrics {
+					pm := newMockPodMetrics("pod1", &backendmetrics.MetricsState{
+						UpdateTime:          baseTime,
+						WaitingQueueSize:    1,
+						KVCacheUsagePercent: 0.1,
+					})
+					pm.Health = &datalayer.Health{State: datalayer.Unhealthy}
+					return pm
+				}(),
+			},
+			expectedSaturation: true,
+		},
 		{
 			name:   "Single pod with high queue depth",
 			config: defaultConfig,
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/healthcheck"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+const (
+	HealthyEndpointFilterType = "healthy-endpoint-filter"
+)
+
+// compile-time type assertion
+var _ framework.Filter = &HealthyEndpointFilter{}
+
+// HealthyEndpointParameters defines the parameters of the healthy endpoint filter.
+type HealthyEndpointParameters struct {
+	// FailOpen keeps all pods when every pod is unhealthy. Defaults to true.
+	FailOpen bool `json:"failOpen"`
+}
+
+// HealthyEndpointFilterFactory defines the factory function for HealthyEndpointFilter.
+func HealthyEndpointFilterFactory(name string, rawParameters json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	parameters := HealthyEndpointParameters{FailOpen: true}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", HealthyEndpointFilterType, err)
+		}
+	}
+	return NewHealthyEndpointFilter().WithFailOpen(parameters.FailOpen).WithName(name), nil
+}
+
+// NewHealthyEndpointFilter initializes a new HealthyEndpointFilter that fails open and returns its pointer.
+func NewHealthyEndpointFilter() *HealthyEndpointFilter {
+	return &HealthyEndpointFilter{
+		typedName: plugins.TypedName{Type: HealthyEndpointFilterType, Name: HealthyEndpointFilterType},
+		failOpen:  true,
+	}
+}
+
+// HealthyEndpointFilter filters out pods failing their latest health check probe. Pods that were
+// not probed (e.g., when no health check data source is configured) are filtered out when their
+// data collection, such as their metrics scrapes, is failing, and kept when their health is unknown.
+// By default, the filter fails open: when every pod is unhealthy, all of them are kept, since
+// failing the request is not better than trying an unhealthy pod. With fail-open disabled, no pod
+// is kept then, and the scheduling profile fails.
+type HealthyEndpointFilter struct {
+	typedName plugins.TypedName
+	failOpen  bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (f *HealthyEndpointFilter) TypedName() plugins.TypedName {
+	return f.typedName
+}
+
+// WithName sets the name of the filter.
+func (f *HealthyEndpointFilter) WithName(name string) *HealthyEndpointFilter {
+	f.typedName.Name = name
+	return f
+}
+
+// WithFailOpen sets whether the filter keeps all pods when every pod is unhealthy.
+func (f *HealthyEndpointFilter) WithFailOpen(failOpen bool) *HealthyEndpointFilter {
+	f.failOpen = failOpen
+	return f
+}
+
+// Filter selects the healthy pods, or all pods if none of them is healthy and the filter fails open.
+func (f *HealthyEndpointFilter) Filter(ctx context.Context, _ *types.CycleState, _ *types.LLMRequest, pods []types.Pod) []types.Pod {
+	filteredPods := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if isHealthy(pod) {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	if len(filteredPods) == 0 && len(pods) != 0 && f.failOpen {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("All pods are unhealthy, keeping all of them", "filter", f.typedName, "pods", len(pods))
+		return pods
+	}
+	return filteredPods
+}
+
+// isHealthy returns the result of the pod's latest health check probe, or its collection health
+// if it was not probed.
+func isHealthy(pod types.Pod) bool {
+	if value, ok := pod.Get(healthcheck.HealthCheckKey); ok {
+		if result, ok := value.(*healthcheck.Result); ok && result != nil {
+			return result.Healthy
+		}
+	}
+	return pod.GetHealth().IsHealthy()
+}
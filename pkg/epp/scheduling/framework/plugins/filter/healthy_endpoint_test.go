@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/healthcheck"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestHealthyEndpointFilter(t *testing.T) {
+	healthy := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "healthy"}},
+		MetricsState: backendmetrics.NewMetricsState(),
+		Health:       &datalayer.Health{State: datalayer.Healthy},
+	}
+	unknown := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "unknown"}},
+		MetricsState: backendmetrics.NewMetricsState(),
+	}
+	unhealthy := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "unhealthy"}},
+		MetricsState: backendmetrics.NewMetricsState(),
+		Health:       &datalayer.Health{State: datalayer.Unhealthy},
+	}
+	withProbe := func(name string, health datalayer.HealthState, probeHealthy bool) *types.PodMetrics {
+		pod := &types.PodMetrics{
+			Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: name}},
+			MetricsState: backendmetrics.NewMetricsState(),
+			Health:       &datalayer.Health{State: health},
+			Attributes:   datalayer.NewAttributes(),
+		}
+		pod.Attributes.Put(healthcheck.HealthCheckKey, &healthcheck.Result{Healthy: probeHealthy})
+		return pod
+	}
+	// the probe fails while the metrics scrapes succeed
+	probeFailing := withProbe("probe-failing", datalayer.Healthy, false)
+	// the probe succeeds while the metrics scrapes fail
+	probeSucceeding := withProbe("probe-succeeding", datalayer.Unhealthy, true)
+
+	tests := []struct {
+		name   string
+		strict bool
+		pods   []types.Pod
+		want   []types.Pod
+	}{
+		{
+			name: "unhealthy pods are filtered out",
+			pods: []types.Pod{healthy, unhealthy, unknown},
+			want: []types.Pod{healthy, unknown},
+		},
+		{
+			name: "the probe result takes precedence over the collection health",
+			pods: []types.Pod{probeFailing, probeSucceeding},
+			want: []types.Pod{probeSucceeding},
+		},
+		{
+			name: "all pods unhealthy, fail open",
+			pods: []types.Pod{unhealthy, probeFailing},
+			want: []types.Pod{unhealthy, probeFailing},
+		},
+		{
+			name:   "all pods unhealthy, fail-open disabled",
+			strict: true,
+			pods:   []types.Pod{unhealthy, probeFailing},
+			want:   []types.Pod{},
+		},
+		{
+			name: "no pods",
+			pods: []types.Pod{},
+			want: []types.Pod{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter := NewHealthyEndpointFilter().WithFailOpen(!test.strict)
+			got := filter.Filter(context.Background(), types.NewCycleState(), &types.LLMRequest{}, test.pods)
+			// the pods are compared by name, their attributes can't be compared
+			if diff := cmp.Diff(podNames(test.want), podNames(got)); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
+func podNames(pods []types.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.GetPod().NamespacedName.Name)
+	}
+	return names
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/scorer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+const (
+	KvCacheHeadroomFilterType = "kv-cache-headroom-filter"
+)
+
+// compile-time type assertion
+var _ framework.RelaxableFilter = &KVCacheHeadroomFilter{}
+
+// KvCacheHeadroomFilterFactory defines the factory function for KVCacheHeadroomFilter.
+func KvCacheHeadroomFilterFactory(name string, rawParameters json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	parameters, err := scorer.ParseKVCacheHeadroomParameters(KvCacheHeadroomFilterType, rawParameters)
+	if err != nil {
+		return nil, err
+	}
+	return NewKVCacheHeadroomFilter(parameters).WithName(name), nil
+}
+
+// NewKVCacheHeadroomFilter initializes a new KVCacheHeadroomFilter and returns its pointer.
+func NewKVCacheHeadroomFilter(parameters scorer.KVCacheHeadroomParameters) *KVCacheHeadroomFilter {
+	return &KVCacheHeadroomFilter{
+		typedName:  plugins.TypedName{Type: KvCacheHeadroomFilterType, Name: KvCacheHeadroomFilterType},
+		parameters: parameters,
+	}
+}
+
+// KVCacheHeadroomFilter filters out pods where the request doesn't fit the free KV cache capacity, and would
+// therefore trigger preemption or eviction. The size of the request is estimated from its prompt length and
+// the expected number of output tokens. Pods with unknown KV cache capacity are not filtered out.
+type KVCacheHeadroomFilter struct {
+	typedName  plugins.TypedName
+	parameters scorer.KVCacheHeadroomParameters
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (f *KVCacheHeadroomFilter) TypedName() plugins.TypedName {
+	return f.typedName
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (f *KVCacheHeadroomFilter) Consumes() map[string]any {
+	return map[string]any{
+		metrics.KVCacheUsagePercentKey:     float64(0),
+		metrics.KvCacheMaxTokenCapacityKey: int(0),
+	}
+}
+
+// WithName sets the name of the filter.
+func (f *KVCacheHeadroomFilter) WithName(name string) *KVCacheHeadroomFilter {
+	f.typedName.Name = name
+	return f
+}
+
+// Filter selects the pods that have enough free KV cache capacity for the request.
+func (f *KVCacheHeadroomFilter) Filter(_ context.Context, _ *types.CycleState, request *types.LLMRequest, pods []types.Pod) []types.Pod {
+	requestTokens := requtil.EstimateRequestTokens(request.Body, f.parameters.CharactersPerToken, f.parameters.DefaultOutputTokens)
+
+	filteredPods := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		freeTokens, ok := pod.GetMetrics().KVCacheFreeTokens()
+		if !ok || freeTokens >= requestTokens {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	return filteredPods
+}
+
+// RelaxedFilter selects the pods with the most free KV cache capacity. It is used when the request doesn't fit the
+// free KV cache capacity of any pod, and keeps the pods where it causes the least preemption or eviction.
+// As in Filter, pods with unknown KV cache capacity are not filtered out.
+func (f *KVCacheHeadroomFilter) RelaxedFilter(_ context.Context, _ *types.CycleState, _ *types.LLMRequest, pods []types.Pod) []types.Pod {
+	filteredPods := make([]types.Pod, 0, len(pods))
+	maxFreeTokens := -1
+	for _, pod := range pods {
+		freeTokens, ok := pod.GetMetrics().KVCacheFreeTokens()
+		if !ok {
+			freeTokens = math.MaxInt
+		}
+		if freeTokens > maxFreeTokens {
+			maxFreeTokens = freeTokens
+			filteredPods = filteredPods[:0]
+		}
+		if freeTokens == maxFreeTokens {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	return filteredPods
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/scorer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestKvCacheHeadroomFilter(t *testing.T) {
+	// the request needs 100 prompt tokens (400 characters) and 100 output tokens
+	request := &types.LLMRequest{
+		Body: &types.LLMRequestBody{
+			Completions: &types.CompletionsRequest{Prompt: string(make([]byte, 400)), MaxTokens: 100},
+		},
+	}
+	fits := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "fits"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.5, KvCacheMaxTokenCapacity: 1000},
+	}
+	fitsExactly := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "fits-exactly"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.8, KvCacheMaxTokenCapacity: 1000},
+	}
+	doesNotFit := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "does-not-fit"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.9, KvCacheMaxTokenCapacity: 1000},
+	}
+	unknownCapacity := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "unknown-capacity"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.99},
+	}
+
+	tests := []struct {
+		name string
+		pods []types.Pod
+		want []types.Pod
+	}{
+		{
+			name: "filters out pods without headroom",
+			pods: []types.Pod{fits, fitsExactly, doesNotFit},
+			want: []types.Pod{fits, fitsExactly},
+		},
+		{
+			name: "keeps pods with unknown capacity",
+			pods: []types.Pod{doesNotFit, unknownCapacity},
+			want: []types.Pod{unknownCapacity},
+		},
+		{
+			name: "filters out all pods",
+			pods: []types.Pod{doesNotFit},
+			want: []types.Pod{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parameters, err := scorer.ParseKVCacheHeadroomParameters(KvCacheHeadroomFilterType, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing parameters: %v", err)
+			}
+			got := NewKVCacheHeadroomFilter(parameters).Filter(context.Background(), types.NewCycleState(), request, test.pods)
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
+func TestKvCacheHeadroomFilter_RelaxedFilter(t *testing.T) {
+	// the request fits the free KV cache capacity of none of the pods
+	request := &types.LLMRequest{
+		Body: &types.LLMRequestBody{
+			Completions: &types.CompletionsRequest{Prompt: string(make([]byte, 4000)), MaxTokens: 1000},
+		},
+	}
+	mostFree := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "most-free"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.5, KvCacheMaxTokenCapacity: 1000},
+	}
+	alsoMostFree := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "also-most-free"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.75, KvCacheMaxTokenCapacity: 2000},
+	}
+	lessFree := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "less-free"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.9, KvCacheMaxTokenCapacity: 1000},
+	}
+	unknownCapacity := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "unknown-capacity"}},
+		MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.99},
+	}
+
+	tests := []struct {
+		name string
+		pods []types.Pod
+		want []types.Pod
+	}{
+		{
+			name: "keeps the pods with the most free capacity",
+			pods: []types.Pod{lessFree, mostFree, alsoMostFree},
+			want: []types.Pod{mostFree, alsoMostFree},
+		},
+		{
+			name: "keeps pods with unknown capacity",
+			pods: []types.Pod{mostFree, unknownCapacity},
+			want: []types.Pod{unknownCapacity},
+		},
+		{
+			name: "no pods",
+			pods: []types.Pod{},
+			want: []types.Pod{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parameters, err := scorer.ParseKVCacheHeadroomParameters(KvCacheHeadroomFilterType, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing parameters: %v", err)
+			}
+			got := NewKVCacheHeadroomFilter(parameters).RelaxedFilter(context.Background(), types.NewCycleState(), request, test.pods)
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"encoding/json"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+const (
+	LoraAffinityFilterType = "lora-affinity-filter"
+)
+
+// compile-time type assertion
+var _ framework.RelaxableFilter = &LoraAffinityFilter{}
+
+// LoraAffinityFilterFactory defines the factory function for LoraAffinityFilter.
+func LoraAffinityFilterFactory(name string, _ json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	return NewLoraAffinityFilter().WithName(name), nil
+}
+
+// NewLoraAffinityFilter initializes a new LoraAffinityFilter and returns its pointer.
+func NewLoraAffinityFilter() *LoraAffinityFilter {
+	return &LoraAffinityFilter{
+		typedName: plugins.TypedName{Type: LoraAffinityFilterType, Name: LoraAffinityFilterType},
+	}
+}
+
+// LoraAffinityFilter filters out pods where the request's target adapter is not active. It is the
+// hard exclusion companion of the LoraAffinityScorer, meant to be configured as a soft filter:
+// when the adapter is not active on any pod, its relaxed variant also keeps the pods that can load
+// the adapter, or are already loading it.
+type LoraAffinityFilter struct {
+	typedName plugins.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (f *LoraAffinityFilter) TypedName() plugins.TypedName {
+	return f.typedName
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (f *LoraAffinityFilter) Consumes() map[string]any {
+	return map[string]any{
+		metrics.ActiveModelsKey:  map[string]int{},
+		metrics.WaitingModelsKey: map[string]int{},
+	}
+}
+
+// WithName sets the name of the filter.
+func (f *LoraAffinityFilter) WithName(name string) *LoraAffinityFilter {
+	f.typedName.Name = name
+	return f
+}
+
+// Filter selects the pods where the target adapter is active.
+func (f *LoraAffinityFilter) Filter(_ context.Context, _ *types.CycleState, request *types.LLMRequest, pods []types.Pod) []types.Pod {
+	filteredPods := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if _, active := pod.GetMetrics().ActiveModels[request.TargetModel]; active {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	return filteredPods
+}
+
+// RelaxedFilter selects the pods where the target adapter is active or waiting to be loaded, and the
+// pods with capacity to load one more adapter.
+func (f *LoraAffinityFilter) RelaxedFilter(_ context.Context, _ *types.CycleState, request *types.LLMRequest, pods []types.Pod) []types.Pod {
+	filteredPods := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		podMetrics := pod.GetMetrics()
+		_, active := podMetrics.ActiveModels[request.TargetModel]
+		_, waiting := podMetrics.WaitingModels[request.TargetModel]
+		hasCapacity := len(podMetrics.ActiveModels)+len(podMetrics.WaitingModels) < podMetrics.MaxActiveModels
+		if active || waiting || hasCapacity {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	return filteredPods
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestLoraAffinityFilter(t *testing.T) {
+	request := &types.LLMRequest{TargetModel: "adapter"}
+	active := &types.PodMetrics{
+		Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "active"}},
+		MetricsState: &backendmetrics.MetricsState{
+			ActiveModels:    map[string]int{"adapter": 1, "other": 1},
+			WaitingModels:   map[string]int{},
+			MaxActiveModels: 2,
+		},
+	}
+	waiting := &types.PodMetrics{
+		Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "waiting"}},
+		MetricsState: &backendmetrics.MetricsState{
+			ActiveModels:    map[string]int{"other": 1},
+			WaitingModels:   map[string]int{"adapter": 1},
+			MaxActiveModels: 2,
+		},
+	}
+	hasCapacity := &types.PodMetrics{
+		Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "has-capacity"}},
+		MetricsState: &backendmetrics.MetricsState{
+			ActiveModels:    map[string]int{"other": 1},
+			WaitingModels:   map[string]int{},
+			MaxActiveModels: 2,
+		},
+	}
+	full := &types.PodMetrics{
+		Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "full"}},
+		MetricsState: &backendmetrics.MetricsState{
+			ActiveModels:    map[string]int{"other": 1},
+			WaitingModels:   map[string]int{"another": 1},
+			MaxActiveModels: 2,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		pods        []types.Pod
+		want        []types.Pod
+		wantRelaxed []types.Pod
+	}{
+		{
+			name:        "keeps pods with the adapter active",
+			pods:        []types.Pod{active, waiting, hasCapacity, full},
+			want:        []types.Pod{active},
+			wantRelaxed: []types.Pod{active, waiting, hasCapacity},
+		},
+		{
+			name:        "adapter not active on any pod",
+			pods:        []types.Pod{waiting, hasCapacity, full},
+			want:        []types.Pod{},
+			wantRelaxed: []types.Pod{waiting, hasCapacity},
+		},
+		{
+			name:        "no pod can load the adapter",
+			pods:        []types.Pod{full},
+			want:        []types.Pod{},
+			wantRelaxed: []types.Pod{},
+		},
+	}
+
+	filter := NewLoraAffinityFilter()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := filter.Filter(context.Background(), types.NewCycleState(), request, test.pods)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+			gotRelaxed := filter.RelaxedFilter(context.Background(), types.NewCycleState(), request, test.pods)
+			if diff := cmp.Diff(test.wantRelaxed, gotRelaxed); diff != "" {
+				t.Errorf("Unexpected relaxed output (-want +got): %v", diff)
+			}
+		})
+	}
+}
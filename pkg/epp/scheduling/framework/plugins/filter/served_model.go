@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"encoding/json"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/models"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+const (
+	ServedModelFilterType = "served-model-filter"
+)
+
+// compile-time type assertion
+var _ framework.Filter = &ServedModelFilter{}
+
+// ServedModelFilterFactory defines the factory function for ServedModelFilter.
+func ServedModelFilterFactory(name string, _ json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	return NewServedModelFilter().WithName(name), nil
+}
+
+// NewServedModelFilter initializes a new ServedModelFilter and returns its pointer.
+func NewServedModelFilter() *ServedModelFilter {
+	return &ServedModelFilter{
+		typedName: plugins.TypedName{Type: ServedModelFilterType, Name: ServedModelFilterType},
+	}
+}
+
+// ServedModelFilter filters out pods that do not serve the request's target model, based on the
+// models listed by the models data source. Pods whose served models are not known yet are kept.
+// When no pod serves the target model, no pod is kept and the request fails. During a model
+// rollout, the new model is not listed until the pods have loaded it and their models have been
+// collected again, so the filter must then be wrapped in the soft filter Skip fallback policy
+// (`fallback: policy: Skip`), which keeps all pods instead. The same applies to engines loading
+// adapters on demand.
+type ServedModelFilter struct {
+	typedName plugins.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (f *ServedModelFilter) TypedName() plugins.TypedName {
+	return f.typedName
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (f *ServedModelFilter) Consumes() map[string]any {
+	return map[string]any{
+		models.ServedModelsKey: &models.ServedModels{},
+	}
+}
+
+// WithName sets the name of the filter.
+func (f *ServedModelFilter) WithName(name string) *ServedModelFilter {
+	f.typedName.Name = name
+	return f
+}
+
+// Filter selects the pods serving the request's target model.
+func (f *ServedModelFilter) Filter(_ context.Context, _ *types.CycleState, request *types.LLMRequest, pods []types.Pod) []types.Pod {
+	if request == nil || request.TargetModel == "" {
+		return pods
+	}
+	filteredPods := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if servesModel(pod, request.TargetModel) {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	return filteredPods
+}
+
+// servesModel returns true if the pod serves the model, or if its served models are unknown.
+func servesModel(pod types.Pod, model string) bool {
+	value, ok := pod.Get(models.ServedModelsKey)
+	if !ok {
+		return true
+	}
+	served, ok := value.(*models.ServedModels)
+	if !ok || served == nil {
+		return true
+	}
+	return served.Serves(model)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/models"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func newServedModelPod(name string, served map[string]string) *types.PodMetrics {
+	pod := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: name}},
+		MetricsState: backendmetrics.NewMetricsState(),
+	}
+	if served != nil {
+		pod.Attributes = datalayer.NewAttributes()
+		pod.Attributes.Put(models.ServedModelsKey, &models.ServedModels{Models: served})
+	}
+	return pod
+}
+
+func TestServedModelFilter(t *testing.T) {
+	base := newServedModelPod("base", map[string]string{"llama": ""})
+	adapter := newServedModelPod("adapter", map[string]string{"llama": "", "sql-lora": "llama"})
+	unknown := newServedModelPod("unknown", nil)
+
+	tests := []struct {
+		name  string
+		model string
+		pods  []types.Pod
+		want  []string
+	}{
+		{
+			name:  "pods serving the adapter are selected",
+			model: "sql-lora",
+			pods:  []types.Pod{base, adapter, unknown},
+			want:  []string{"adapter", "unknown"},
+		},
+		{
+			name:  "pods serving the base model are selected",
+			model: "llama",
+			pods:  []types.Pod{base, adapter},
+			want:  []string{"base", "adapter"},
+		},
+		{
+			name:  "no pod serves the model",
+			model: "other-lora",
+			pods:  []types.Pod{base, adapter},
+			want:  []string{},
+		},
+		{
+			name: "no target model",
+			pods: []types.Pod{base, adapter},
+			want: []string{"base", "adapter"},
+		},
+	}
+
+	filter := NewServedModelFilter()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := []string{}
+			for _, pod := range filter.Filter(context.Background(), types.NewCycleState(), &types.LLMRequest{TargetModel: test.model}, test.pods) {
+				got = append(got, pod.GetPod().NamespacedName.Name)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
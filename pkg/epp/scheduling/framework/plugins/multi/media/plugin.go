@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package media implements a scorer that routes multimodal requests to pods that recently processed
+// the same media, in order to reuse the model server's encoder cache.
+package media
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+const (
+	// DefaultLRUCapacity is the default number of media items the plugin keeps track of.
+	DefaultLRUCapacity = 10000
+	// DefaultMaxPodsPerMedia is the default maximum number of pods tracked per media item.
+	DefaultMaxPodsPerMedia = 4
+
+	MediaAffinityScorerType = "media-affinity-scorer"
+)
+
+type Config struct {
+	// LRUCapacity is the maximum number of media items to keep track of. The least recently used
+	// media items are evicted first.
+	LRUCapacity int `json:"lruCapacity"`
+	// MaxPodsPerMedia is the maximum number of pods tracked per media item. When exceeded, the pod
+	// that processed the media least recently is forgotten.
+	MaxPodsPerMedia int `json:"maxPodsPerMedia"`
+}
+
+// compile-time type validation
+var _ plugins.StateData = &SchedulingContextState{}
+
+// SchedulingContextState is the state of this plugin to be used during a scheduling cycle.
+type SchedulingContextState struct {
+	// MediaDigests is the list of unique media digests of the request, in order.
+	MediaDigests []string
+}
+
+func (s *SchedulingContextState) Clone() plugins.StateData {
+	mediaDigests := make([]string, len(s.MediaDigests))
+	copy(mediaDigests, s.MediaDigests)
+	return &SchedulingContextState{MediaDigests: mediaDigests}
+}
+
+// compile-time type assertion
+var (
+	_ framework.Scorer          = &Plugin{}
+	_ requestcontrol.PreRequest = &Plugin{}
+)
+
+// MediaAffinityScorerFactory defines the factory function for the media affinity Plugin.
+func MediaAffinityScorerFactory(name string, rawParameters json.RawMessage, handle plugins.Handle) (plugins.Plugin, error) {
+	parameters := Config{
+		LRUCapacity:     DefaultLRUCapacity,
+		MaxPodsPerMedia: DefaultMaxPodsPerMedia,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", MediaAffinityScorerType, err)
+		}
+	}
+
+	return New(handle.Context(), parameters).WithName(name), nil
+}
+
+// New initializes a new media affinity Plugin and returns its pointer.
+func New(ctx context.Context, config Config) *Plugin {
+	if config.LRUCapacity <= 0 {
+		config.LRUCapacity = DefaultLRUCapacity
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("LRUCapacity is not positive, using default value", "defaultCapacity", DefaultLRUCapacity)
+	}
+	if config.MaxPodsPerMedia <= 0 {
+		config.MaxPodsPerMedia = DefaultMaxPodsPerMedia
+	}
+	mediaToPods, _ := lru.New[string, []k8stypes.NamespacedName](config.LRUCapacity)
+
+	return &Plugin{
+		typedName:   plugins.TypedName{Type: MediaAffinityScorerType, Name: MediaAffinityScorerType},
+		config:      config,
+		pluginState: plugins.NewPluginState(ctx),
+		mediaToPods: mediaToPods,
+	}
+}
+
+// Plugin scores pods by the fraction of the request's media (e.g., images, audio or video) that they
+// processed recently. Model servers cache the encoder outputs of multimodal inputs, so routing requests
+// with the same media to the same pods avoids re-encoding it.
+type Plugin struct {
+	typedName   plugins.TypedName
+	config      Config
+	pluginState *plugins.PluginState
+
+	mu sync.Mutex
+	// mediaToPods maps a media digest to the pods that recently processed it, from the least to the most recent.
+	mediaToPods *lru.Cache[string, []k8stypes.NamespacedName]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *Plugin) TypedName() plugins.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+// Score returns the scoring result for the given list of pods based on context.
+func (p *Plugin) Score(ctx context.Context, _ *types.CycleState, request *types.LLMRequest, pods []types.Pod) map[types.Pod]float64 {
+	state := &SchedulingContextState{MediaDigests: requestMediaDigests(request)}
+	p.pluginState.Write(request.RequestId, plugins.StateKey(p.TypedName().String()), state)
+
+	scores := make(map[types.Pod]float64, len(pods))
+	total := len(state.MediaDigests)
+	if total == 0 {
+		for _, pod := range pods {
+			scores[pod] = 0
+		}
+		return scores
+	}
+
+	matches := make(map[k8stypes.NamespacedName]int)
+	p.mu.Lock()
+	for _, digest := range state.MediaDigests {
+		cachedPods, _ := p.mediaToPods.Get(digest)
+		for _, pod := range cachedPods {
+			matches[pod]++
+		}
+	}
+	p.mu.Unlock()
+	log.FromContext(ctx).V(logutil.TRACE).Info("media affinity state", "media", total, "matches", matches)
+
+	for _, pod := range pods {
+		scores[pod] = float64(matches[pod.GetPod().NamespacedName]) / float64(total)
+	}
+	return scores
+}
+
+// PreRequest records the media of the request as processed by the selected pod.
+func (p *Plugin) PreRequest(ctx context.Context, request *types.LLMRequest, schedulingResult *types.SchedulingResult) {
+	primaryProfileResult := schedulingResult.ProfileResults[schedulingResult.PrimaryProfileName]
+	targetPod := primaryProfileResult.TargetPods[0].GetPod().NamespacedName // get the first pod of the primary profile
+
+	state, err := plugins.ReadPluginStateKey[*SchedulingContextState](p.pluginState, request.RequestId, plugins.StateKey(p.TypedName().String()))
+	p.pluginState.Delete(request.RequestId) // delete the state explicitly after completing using it
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to read media affinity plugin state", "requestID", request.RequestId)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, digest := range state.MediaDigests {
+		cachedPods, _ := p.mediaToPods.Get(digest)
+		updated := make([]k8stypes.NamespacedName, 0, len(cachedPods)+1)
+		for _, pod := range cachedPods {
+			if pod != targetPod {
+				updated = append(updated, pod)
+			}
+		}
+		updated = append(updated, targetPod) // the target pod is the most recent
+		if len(updated) > p.config.MaxPodsPerMedia {
+			updated = updated[len(updated)-p.config.MaxPodsPerMedia:]
+		}
+		p.mediaToPods.Add(digest, updated)
+	}
+}
+
+// requestMediaDigests returns the unique media digests of a chat-completions request, in order.
+func requestMediaDigests(request *types.LLMRequest) []string {
+	if request == nil || request.Body == nil || request.Body.ChatCompletions == nil {
+		return nil
+	}
+	seen := map[string]struct{}{}
+	var digests []string
+	for _, msg := range request.Body.ChatCompletions.Messages {
+		for _, digest := range msg.Content.MediaDigests() {
+			if _, ok := seen[digest]; ok {
+				continue
+			}
+			seen[digest] = struct{}{}
+			digests = append(digests, digest)
+		}
+	}
+	return digests
+}
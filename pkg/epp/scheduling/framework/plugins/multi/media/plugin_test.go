@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package media
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func newMediaRequest(imageURLs ...string) *types.LLMRequest {
+	blocks := []types.ContentBlock{{Type: "text", Text: "describe the images"}}
+	for _, url := range imageURLs {
+		blocks = append(blocks, types.ContentBlock{Type: "image_url", ImageURL: types.ImageBlock{Url: url}})
+	}
+	return &types.LLMRequest{
+		RequestId:   uuid.NewString(),
+		TargetModel: "test-model",
+		Body: &types.LLMRequestBody{
+			ChatCompletions: &types.ChatCompletionsRequest{
+				Messages: []types.Message{{Role: "user", Content: types.Content{Structured: blocks}}},
+			},
+		},
+	}
+}
+
+func pickPod(plugin *Plugin, request *types.LLMRequest, pod types.Pod) {
+	plugin.PreRequest(context.Background(), request, &types.SchedulingResult{
+		PrimaryProfileName: "default",
+		ProfileResults: map[string]*types.ProfileRunResult{
+			"default": {TargetPods: []types.Pod{pod}},
+		},
+	})
+}
+
+func TestMediaAffinityScorer(t *testing.T) {
+	plugin := New(context.Background(), Config{LRUCapacity: 10, MaxPodsPerMedia: 2})
+
+	pod1 := &types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, MetricsState: backendmetrics.NewMetricsState()}
+	pod2 := &types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, MetricsState: backendmetrics.NewMetricsState()}
+	pod3 := &types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod3"}}, MetricsState: backendmetrics.NewMetricsState()}
+	pods := []types.Pod{pod1, pod2, pod3}
+
+	// First request, no pod processed the media yet.
+	req1 := newMediaRequest("https://example.com/a.jpg", "data:image/png;base64,AAAA")
+	scores := plugin.Score(context.Background(), types.NewCycleState(), req1, pods)
+	assert.Equal(t, float64(0), scores[pod1], "score for pod1")
+	assert.Equal(t, float64(0), scores[pod2], "score for pod2")
+	pickPod(plugin, req1, pod1)
+
+	// Second request shares one of the two images with the first request.
+	req2 := newMediaRequest("data:image/png;base64,AAAA", "https://example.com/b.jpg")
+	scores = plugin.Score(context.Background(), types.NewCycleState(), req2, pods)
+	assert.Equal(t, 0.5, scores[pod1], "score for pod1")
+	assert.Equal(t, float64(0), scores[pod2], "score for pod2")
+	pickPod(plugin, req2, pod2)
+
+	// Third request has both images of the second request.
+	req3 := newMediaRequest("https://example.com/b.jpg", "data:image/png;base64,AAAA")
+	scores = plugin.Score(context.Background(), types.NewCycleState(), req3, pods)
+	assert.Equal(t, 0.5, scores[pod1], "score for pod1")
+	assert.Equal(t, float64(1), scores[pod2], "score for pod2")
+	pickPod(plugin, req3, pod3)
+
+	// The shared image was processed by three pods, only the two most recent are tracked.
+	req4 := newMediaRequest("data:image/png;base64,AAAA")
+	scores = plugin.Score(context.Background(), types.NewCycleState(), req4, pods)
+	assert.Equal(t, float64(0), scores[pod1], "score for pod1")
+	assert.Equal(t, float64(1), scores[pod2], "score for pod2")
+	assert.Equal(t, float64(1), scores[pod3], "score for pod3")
+
+	// A request without media gets zero scores.
+	req5 := newMediaRequest()
+	scores = plugin.Score(context.Background(), types.NewCycleState(), req5, pods)
+	for _, pod := range pods {
+		assert.Equal(t, float64(0), scores[pod], "score for %s", pod.GetPod().NamespacedName)
+	}
+}
@@ -355,8 +355,14 @@ func getUserInputBytes(request *types.LLMRequest) ([]byte, error) {
 		return []byte(request.Body.Completions.Prompt), nil
 	}
 
-	// must be chat-completions request at this point, return bytes of entire messages
-	return json.Marshal(request.Body.ChatCompletions.Messages)
+	// must be chat-completions request at this point, return bytes of entire messages.
+	// Media content (e.g., images or audio) is replaced by its digest, so requests that differ only in
+	// their media hash to different blocks, without inline media data inflating the hashed input.
+	messages := make([]types.Message, len(request.Body.ChatCompletions.Messages))
+	for i, msg := range request.Body.ChatCompletions.Messages {
+		messages[i] = types.Message{Role: msg.Role, Content: msg.Content.WithMediaDigests()}
+	}
+	return json.Marshal(messages)
 }
 
 func getBlockSize(pods []types.Pod, defaultBlockSize int) int {
@@ -233,6 +233,37 @@ func TestPrefixPluginChatCompletions(t *testing.T) {
 	assert.Equal(t, float64(0), scores[pod1], "score for pod1")
 }
 
+func TestPrefixPluginChatCompletionsMultimodal(t *testing.T) {
+	newRequest := func(audioData string) *types.LLMRequest {
+		return &types.LLMRequest{
+			RequestId:   uuid.NewString(),
+			TargetModel: "test-model1",
+			Body: &types.LLMRequestBody{
+				ChatCompletions: &types.ChatCompletionsRequest{
+					Messages: []types.Message{
+						{Role: "user", Content: types.Content{Structured: []types.ContentBlock{
+							{Type: "text", Text: "transcribe the following audio"},
+							{Type: "input_audio", InputAudio: &types.AudioBlock{Data: audioData, Format: "wav"}},
+							{Type: "text", Text: "and summarize it"},
+						}}},
+					},
+				},
+			},
+		}
+	}
+
+	hashes1 := hashPrompt(context.Background(), newRequest(strings.Repeat("a", 4096)), 4, DefaultMaxPrefixBlocks)
+	hashes2 := hashPrompt(context.Background(), newRequest(strings.Repeat("b", 4096)), 4, DefaultMaxPrefixBlocks)
+	hashes3 := hashPrompt(context.Background(), newRequest(strings.Repeat("a", 4096)), 4, DefaultMaxPrefixBlocks)
+
+	assert.Equal(t, hashes1, hashes3, "requests with the same media should have the same hashes")
+	assert.NotEqual(t, hashes1[len(hashes1)-1], hashes2[len(hashes2)-1], "requests with different media should have different hashes")
+	// the text before the media is shared
+	assert.Equal(t, hashes1[0], hashes2[0], "requests should share the text prefix before the media")
+	// the inline media data is replaced by its digest, so it doesn't inflate the number of blocks
+	assert.Less(t, len(hashes1), 4096/4, "inline media data should not be hashed as is")
+}
+
 func TestPrefixPluginChatCompletionsGrowth(t *testing.T) {
 	config := Config{
 		DefaultBlockSize:       8, // Use larger block size for more predictable JSON marshaling
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+const (
+	FallbackProfileHandlerType = "fallback-profile-handler"
+)
+
+// compile-time type assertion
+var _ framework.ProfileHandler = &FallbackProfileHandler{}
+
+type fallbackProfileHandlerParameters struct {
+	Profiles []string `json:"profiles"`
+}
+
+// FallbackProfileHandlerFactory defines the factory function for FallbackProfileHandler.
+func FallbackProfileHandlerFactory(name string, rawParameters json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	parameters := fallbackProfileHandlerParameters{}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' profile handler - %w", FallbackProfileHandlerType, err)
+		}
+	}
+	if len(parameters.Profiles) == 0 {
+		return nil, fmt.Errorf("the '%s' profile handler requires at least one profile", FallbackProfileHandlerType)
+	}
+
+	return NewFallbackProfileHandler(parameters.Profiles...).WithName(name), nil
+}
+
+// NewFallbackProfileHandler initializes a new FallbackProfileHandler and returns its pointer.
+// The given profile names are tried in order.
+func NewFallbackProfileHandler(profiles ...string) *FallbackProfileHandler {
+	return &FallbackProfileHandler{
+		typedName: plugins.TypedName{Type: FallbackProfileHandlerType, Name: FallbackProfileHandlerType},
+		profiles:  profiles,
+	}
+}
+
+// FallbackProfileHandler runs profiles one at a time in a configured order. It stops at the first profile
+// that returns a result, which becomes the primary profile. The profiles that follow serve as fallbacks for
+// the ones before them, e.g., a strict affinity profile followed by a profile that accepts any pod.
+type FallbackProfileHandler struct {
+	typedName plugins.TypedName
+	profiles  []string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (h *FallbackProfileHandler) TypedName() plugins.TypedName {
+	return h.typedName
+}
+
+// WithName sets the name of the profile handler.
+func (h *FallbackProfileHandler) WithName(name string) *FallbackProfileHandler {
+	h.typedName.Name = name
+	return h
+}
+
+// Profiles returns the names of the profiles in the order they are tried.
+func (h *FallbackProfileHandler) Profiles() []string {
+	return h.profiles
+}
+
+// Pick selects the SchedulingProfiles to run from the list of candidate profiles, while taking into consideration the request properties and the
+// previously executed cycles along with their results.
+// It returns the next profile in order that didn't run yet, or no profile if a previous profile returned a result.
+func (h *FallbackProfileHandler) Pick(ctx context.Context, _ *types.CycleState, _ *types.LLMRequest, profiles map[string]*framework.SchedulerProfile,
+	profileResults map[string]*types.ProfileRunResult) map[string]*framework.SchedulerProfile {
+	for _, name := range h.profiles {
+		result, alreadyRan := profileResults[name]
+		if !alreadyRan {
+			profile, ok := profiles[name]
+			if !ok {
+				log.FromContext(ctx).V(logutil.DEFAULT).Info("Skipping unknown scheduler profile", "plugin", h.typedName, "profile", name)
+				continue
+			}
+			return map[string]*framework.SchedulerProfile{name: profile}
+		}
+		if result != nil { // a previous profile returned a result, no need to fall back
+			break
+		}
+	}
+	return map[string]*framework.SchedulerProfile{}
+}
+
+// ProcessResults handles the outcome of the profile runs after all profiles ran.
+// The first profile in order that returned a result is set as the primary profile.
+// When a profile run fails, its result in the profileResults map is nil.
+func (h *FallbackProfileHandler) ProcessResults(ctx context.Context, _ *types.CycleState, _ *types.LLMRequest,
+	profileResults map[string]*types.ProfileRunResult) (*types.SchedulingResult, error) {
+	for _, name := range h.profiles {
+		if profileResults[name] == nil { // the profile failed or didn't run
+			continue
+		}
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Scheduler profile served the request", "plugin", h.typedName, "profile", name)
+		return &types.SchedulingResult{
+			ProfileResults:     profileResults,
+			PrimaryProfileName: name,
+		}, nil
+	}
+
+	return nil, errors.New("failed to run any of the fallback scheduler profiles")
+}
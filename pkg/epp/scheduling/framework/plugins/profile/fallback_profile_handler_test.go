@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestFallbackProfileHandler(t *testing.T) {
+	strict := framework.NewSchedulerProfile()
+	relaxed := framework.NewSchedulerProfile()
+	anyPod := framework.NewSchedulerProfile()
+	profiles := map[string]*framework.SchedulerProfile{
+		"strict":  strict,
+		"relaxed": relaxed,
+		"any-pod": anyPod,
+	}
+	result := &types.ProfileRunResult{
+		TargetPods: []types.Pod{&types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}}},
+	}
+
+	tests := []struct {
+		name           string
+		order          []string
+		profileResults map[string]*types.ProfileRunResult
+		wantPicked     []string
+	}{
+		{
+			name:           "first pick runs the first profile",
+			order:          []string{"strict", "relaxed", "any-pod"},
+			profileResults: map[string]*types.ProfileRunResult{},
+			wantPicked:     []string{"strict"},
+		},
+		{
+			name:           "falls back after a failed profile",
+			order:          []string{"strict", "relaxed", "any-pod"},
+			profileResults: map[string]*types.ProfileRunResult{"strict": nil},
+			wantPicked:     []string{"relaxed"},
+		},
+		{
+			name:           "stops after a successful profile",
+			order:          []string{"strict", "relaxed", "any-pod"},
+			profileResults: map[string]*types.ProfileRunResult{"strict": nil, "relaxed": result},
+			wantPicked:     []string{},
+		},
+		{
+			name:           "stops after all profiles ran",
+			order:          []string{"strict", "any-pod"},
+			profileResults: map[string]*types.ProfileRunResult{"strict": nil, "any-pod": nil},
+			wantPicked:     []string{},
+		},
+		{
+			name:           "skips unknown profiles",
+			order:          []string{"unknown", "any-pod"},
+			profileResults: map[string]*types.ProfileRunResult{},
+			wantPicked:     []string{"any-pod"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := NewFallbackProfileHandler(test.order...)
+			picked := handler.Pick(context.Background(), types.NewCycleState(), &types.LLMRequest{}, profiles, test.profileResults)
+
+			got := []string{}
+			for name := range picked {
+				got = append(got, name)
+			}
+			if diff := cmp.Diff(test.wantPicked, got); diff != "" {
+				t.Errorf("Unexpected picked profiles (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
+func TestFallbackProfileHandlerProcessResults(t *testing.T) {
+	result := &types.ProfileRunResult{
+		TargetPods: []types.Pod{&types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}}},
+	}
+	handler := NewFallbackProfileHandler("strict", "relaxed", "any-pod")
+
+	tests := []struct {
+		name           string
+		profileResults map[string]*types.ProfileRunResult
+		wantPrimary    string
+		wantErr        bool
+	}{
+		{
+			name:           "primary is the first successful profile",
+			profileResults: map[string]*types.ProfileRunResult{"strict": nil, "relaxed": result},
+			wantPrimary:    "relaxed",
+		},
+		{
+			name:           "primary is the first profile",
+			profileResults: map[string]*types.ProfileRunResult{"strict": result},
+			wantPrimary:    "strict",
+		},
+		{
+			name:           "all profiles failed",
+			profileResults: map[string]*types.ProfileRunResult{"strict": nil, "relaxed": nil, "any-pod": nil},
+			wantErr:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := handler.ProcessResults(context.Background(), types.NewCycleState(), &types.LLMRequest{}, test.profileResults)
+			if test.wantErr != (err != nil) {
+				t.Fatalf("Unexpected error, got %v, want error %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.PrimaryProfileName != test.wantPrimary {
+				t.Errorf("Unexpected primary profile, got %s, want %s", got.PrimaryProfileName, test.wantPrimary)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scorer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/multicluster"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+const (
+	ClusterLocalityScorerType = "cluster-locality-scorer"
+)
+
+// compile-time type assertion
+var _ framework.Scorer = &ClusterLocalityScorer{}
+
+// ClusterLocalityParameters defines the parameters of the cluster locality scorer. An endpoint has capacity when
+// both its waiting queue size and its KV cache utilization are within the thresholds.
+type ClusterLocalityParameters struct {
+	QueueDepthThreshold  int     `json:"queueDepthThreshold"`
+	KVCacheUtilThreshold float64 `json:"kvCacheUtilThreshold"`
+}
+
+// ClusterLocalityScorerFactory defines the factory function for ClusterLocalityScorer.
+func ClusterLocalityScorerFactory(name string, rawParameters json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	parameters := ClusterLocalityParameters{
+		QueueDepthThreshold:  saturationdetector.DefaultQueueDepthThreshold,
+		KVCacheUtilThreshold: saturationdetector.DefaultKVCacheUtilThreshold,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ClusterLocalityScorerType, err)
+		}
+	}
+	if parameters.QueueDepthThreshold < 0 {
+		return nil, fmt.Errorf("invalid queueDepthThreshold %d for the '%s' plugin, must not be negative", parameters.QueueDepthThreshold, ClusterLocalityScorerType)
+	}
+	if parameters.KVCacheUtilThreshold <= 0 || parameters.KVCacheUtilThreshold > 1 {
+		return nil, fmt.Errorf("invalid kvCacheUtilThreshold %v for the '%s' plugin, must be in (0, 1]", parameters.KVCacheUtilThreshold, ClusterLocalityScorerType)
+	}
+	return NewClusterLocalityScorer(parameters).WithName(name), nil
+}
+
+// NewClusterLocalityScorer initializes a new ClusterLocalityScorer and returns its pointer.
+func NewClusterLocalityScorer(parameters ClusterLocalityParameters) *ClusterLocalityScorer {
+	return &ClusterLocalityScorer{
+		typedName:  plugins.TypedName{Type: ClusterLocalityScorerType, Name: ClusterLocalityScorerType},
+		parameters: parameters,
+	}
+}
+
+// ClusterLocalityScorer prefers the local endpoints of the pool over its remote endpoint groups in other clusters,
+// and spills over to the remote clusters when the local endpoints are saturated.
+// While a local endpoint has capacity, local endpoints score 1 and remote ones 0. Once no local endpoint has
+// capacity, local endpoints score 0, and remote ones score 1 when they have capacity.
+type ClusterLocalityScorer struct {
+	typedName  plugins.TypedName
+	parameters ClusterLocalityParameters
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *ClusterLocalityScorer) TypedName() plugins.TypedName {
+	return s.typedName
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (s *ClusterLocalityScorer) Consumes() map[string]any {
+	return map[string]any{
+		metrics.WaitingQueueSizeKey:    int(0),
+		metrics.KVCacheUsagePercentKey: float64(0),
+	}
+}
+
+// WithName sets the name of the scorer.
+func (s *ClusterLocalityScorer) WithName(name string) *ClusterLocalityScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Score returns the scoring result for the given list of pods based on context.
+func (s *ClusterLocalityScorer) Score(_ context.Context, _ *types.CycleState, _ *types.LLMRequest, pods []types.Pod) map[types.Pod]float64 {
+	localSaturated := true
+	for _, pod := range pods {
+		if _, remote := multicluster.ClusterOf(pod); !remote && s.hasCapacity(pod) {
+			localSaturated = false
+			break
+		}
+	}
+
+	scores := make(map[types.Pod]float64, len(pods))
+	for _, pod := range pods {
+		_, remote := multicluster.ClusterOf(pod)
+		switch {
+		case !remote && !localSaturated:
+			scores[pod] = 1
+		case remote && localSaturated && s.hasCapacity(pod):
+			scores[pod] = 1
+		default:
+			scores[pod] = 0
+		}
+	}
+	return scores
+}
+
+func (s *ClusterLocalityScorer) hasCapacity(pod types.Pod) bool {
+	podMetrics := pod.GetMetrics()
+	return podMetrics.WaitingQueueSize <= s.parameters.QueueDepthThreshold &&
+		podMetrics.KVCacheUsagePercent <= s.parameters.KVCacheUtilThreshold
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scorer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/multicluster"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestClusterLocalityScorer(t *testing.T) {
+	local := func(metrics *backendmetrics.MetricsState) types.Pod {
+		return &types.PodMetrics{Pod: &backend.Pod{}, MetricsState: metrics}
+	}
+	remote := func(metrics *backendmetrics.MetricsState) types.Pod {
+		attributes := datalayer.NewAttributes()
+		attributes.Put(multicluster.ClusterAttributeKey, multicluster.Cluster("cluster-b"))
+		return &types.PodMetrics{Pod: &backend.Pod{}, MetricsState: metrics, Attributes: attributes}
+	}
+
+	tests := []struct {
+		name              string
+		pods              []types.Pod
+		expectedScoresPod map[int]float64 // Map of pod index to expected score
+	}{
+		{
+			name: "Local capacity is preferred",
+			pods: []types.Pod{
+				local(&backendmetrics.MetricsState{WaitingQueueSize: 10, KVCacheUsagePercent: 0.5}),
+				local(&backendmetrics.MetricsState{WaitingQueueSize: 0, KVCacheUsagePercent: 0.5}),
+				remote(&backendmetrics.MetricsState{WaitingQueueSize: 0, KVCacheUsagePercent: 0.1}),
+			},
+			expectedScoresPod: map[int]float64{0: 1, 1: 1, 2: 0},
+		},
+		{
+			name: "Saturated local endpoints spill over to remote clusters with capacity",
+			pods: []types.Pod{
+				local(&backendmetrics.MetricsState{WaitingQueueSize: 10, KVCacheUsagePercent: 0.5}),
+				local(&backendmetrics.MetricsState{WaitingQueueSize: 0, KVCacheUsagePercent: 0.9}),
+				remote(&backendmetrics.MetricsState{WaitingQueueSize: 0, KVCacheUsagePercent: 0.1}),
+				remote(&backendmetrics.MetricsState{WaitingQueueSize: 0, KVCacheUsagePercent: 0.95}),
+			},
+			expectedScoresPod: map[int]float64{0: 0, 1: 0, 2: 1, 3: 0},
+		},
+		{
+			name: "Remote clusters only",
+			pods: []types.Pod{
+				remote(&backendmetrics.MetricsState{WaitingQueueSize: 0, KVCacheUsagePercent: 0.1}),
+			},
+			expectedScoresPod: map[int]float64{0: 1},
+		},
+	}
+
+	plugin, err := ClusterLocalityScorerFactory("cluster-locality", json.RawMessage(`{"queueDepthThreshold": 5, "kvCacheUtilThreshold": 0.8}`), nil)
+	assert.NoError(t, err)
+	scorer := plugin.(*ClusterLocalityScorer)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scores := scorer.Score(context.Background(), types.NewCycleState(), &types.LLMRequest{}, test.pods)
+			for i, pod := range test.pods {
+				assert.InDelta(t, test.expectedScoresPod[i], scores[pod], 0.0001, "Pod %d should have score %f", i, test.expectedScoresPod[i])
+			}
+		})
+	}
+}
+
+func TestClusterLocalityScorerFactoryInvalidParameters(t *testing.T) {
+	for _, raw := range []string{`{"queueDepthThreshold": -1}`, `{"kvCacheUtilThreshold": 0}`, `{"kvCacheUtilThreshold": 1.5}`} {
+		if _, err := ClusterLocalityScorerFactory("cluster-locality", json.RawMessage(raw), nil); err == nil {
+			t.Errorf("Expected an error for parameters %s", raw)
+		}
+	}
+}
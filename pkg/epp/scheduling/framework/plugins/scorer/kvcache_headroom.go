@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scorer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+const (
+	KvCacheHeadroomScorerType = "kv-cache-headroom-scorer"
+
+	// defaultOutputTokens is the number of output tokens expected for requests that don't specify max tokens.
+	defaultOutputTokens = 256
+)
+
+// compile-time type assertion
+var _ framework.Scorer = &KVCacheHeadroomScorer{}
+
+// KVCacheHeadroomParameters defines the parameters of the KV cache headroom scorer and filter.
+type KVCacheHeadroomParameters struct {
+	// CharactersPerToken is the average number of characters per token used to estimate the prompt tokens.
+	CharactersPerToken int `json:"charactersPerToken"`
+	// DefaultOutputTokens is the number of output tokens expected for requests that don't specify max tokens.
+	DefaultOutputTokens int `json:"defaultOutputTokens"`
+}
+
+// ParseKVCacheHeadroomParameters parses the parameters of the KV cache headroom scorer and filter, and sets defaults.
+func ParseKVCacheHeadroomParameters(pluginType string, rawParameters json.RawMessage) (KVCacheHeadroomParameters, error) {
+	parameters := KVCacheHeadroomParameters{
+		CharactersPerToken:  requtil.DefaultCharactersPerToken,
+		DefaultOutputTokens: defaultOutputTokens,
+	}
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return parameters, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", pluginType, err)
+		}
+	}
+	if parameters.CharactersPerToken <= 0 {
+		return parameters, fmt.Errorf("invalid charactersPerToken %d for the '%s' plugin, must be positive", parameters.CharactersPerToken, pluginType)
+	}
+	if parameters.DefaultOutputTokens < 0 {
+		return parameters, fmt.Errorf("invalid defaultOutputTokens %d for the '%s' plugin, must not be negative", parameters.DefaultOutputTokens, pluginType)
+	}
+	return parameters, nil
+}
+
+// KvCacheHeadroomScorerFactory defines the factory function for KVCacheHeadroomScorer.
+func KvCacheHeadroomScorerFactory(name string, rawParameters json.RawMessage, _ plugins.Handle) (plugins.Plugin, error) {
+	parameters, err := ParseKVCacheHeadroomParameters(KvCacheHeadroomScorerType, rawParameters)
+	if err != nil {
+		return nil, err
+	}
+	return NewKVCacheHeadroomScorer(parameters).WithName(name), nil
+}
+
+// NewKVCacheHeadroomScorer initializes a new KVCacheHeadroomScorer and returns its pointer.
+func NewKVCacheHeadroomScorer(parameters KVCacheHeadroomParameters) *KVCacheHeadroomScorer {
+	return &KVCacheHeadroomScorer{
+		typedName:  plugins.TypedName{Type: KvCacheHeadroomScorerType, Name: KvCacheHeadroomScorerType},
+		parameters: parameters,
+	}
+}
+
+// KVCacheHeadroomScorer scores list of candidate pods based on the KV cache capacity that remains free after
+// placing the request on the pod. The size of the request is estimated from its prompt length and the expected
+// number of output tokens. Pods where the request doesn't fit the free KV cache capacity, and would therefore
+// trigger preemption or eviction, get the lowest score.
+// Pods with unknown KV cache capacity are scored based on their KV cache utilization alone.
+type KVCacheHeadroomScorer struct {
+	typedName  plugins.TypedName
+	parameters KVCacheHeadroomParameters
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (s *KVCacheHeadroomScorer) TypedName() plugins.TypedName {
+	return s.typedName
+}
+
+// Consumes returns the list of data that is consumed by the plugin.
+func (s *KVCacheHeadroomScorer) Consumes() map[string]any {
+	return map[string]any{
+		metrics.KVCacheUsagePercentKey:     float64(0),
+		metrics.KvCacheMaxTokenCapacityKey: int(0),
+	}
+}
+
+// WithName sets the name of the scorer.
+func (s *KVCacheHeadroomScorer) WithName(name string) *KVCacheHeadroomScorer {
+	s.typedName.Name = name
+	return s
+}
+
+// Score returns the scoring result for the given list of pods based on context.
+func (s *KVCacheHeadroomScorer) Score(_ context.Context, _ *types.CycleState, request *types.LLMRequest, pods []types.Pod) map[types.Pod]float64 {
+	requestTokens := requtil.EstimateRequestTokens(request.Body, s.parameters.CharactersPerToken, s.parameters.DefaultOutputTokens)
+
+	scores := make(map[types.Pod]float64, len(pods))
+	for _, pod := range pods {
+		podMetrics := pod.GetMetrics()
+		freeTokens, ok := podMetrics.KVCacheFreeTokens()
+		if !ok { // capacity is unknown, fall back to KV cache utilization
+			scores[pod] = 1 - podMetrics.KVCacheUsagePercent
+			continue
+		}
+		headroom := freeTokens - requestTokens
+		if headroom < 0 { // the request would trigger preemption or eviction
+			scores[pod] = 0
+			continue
+		}
+		scores[pod] = float64(headroom) / float64(podMetrics.KvCacheMaxTokenCapacity)
+	}
+	return scores
+}
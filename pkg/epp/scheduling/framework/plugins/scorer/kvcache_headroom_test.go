@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scorer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestKvCacheHeadroomScorer(t *testing.T) {
+	// the request needs 100 prompt tokens (400 characters) and 100 output tokens
+	request := &types.LLMRequest{
+		Body: &types.LLMRequestBody{
+			Completions: &types.CompletionsRequest{Prompt: string(make([]byte, 400)), MaxTokens: 100},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		pods              []types.Pod
+		expectedScoresPod map[int]float64 // Map of pod index to expected score
+	}{
+		{
+			name: "Different KV cache headroom",
+			pods: []types.Pod{
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.5, KvCacheMaxTokenCapacity: 1000}},
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.0, KvCacheMaxTokenCapacity: 1000}},
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.5, KvCacheMaxTokenCapacity: 2000}},
+			},
+			expectedScoresPod: map[int]float64{
+				0: 0.3, // (500 free - 200 needed) / 1000
+				1: 0.8, // (1000 free - 200 needed) / 1000
+				2: 0.4, // (1000 free - 200 needed) / 2000
+			},
+		},
+		{
+			name: "Request triggers eviction",
+			pods: []types.Pod{
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.9, KvCacheMaxTokenCapacity: 1000}},
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.8, KvCacheMaxTokenCapacity: 1000}},
+			},
+			expectedScoresPod: map[int]float64{
+				0: 0.0, // 100 free < 200 needed
+				1: 0.0, // 200 free - 200 needed
+			},
+		},
+		{
+			name: "Unknown KV cache capacity",
+			pods: []types.Pod{
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.6}},
+				&types.PodMetrics{Pod: &backend.Pod{}, MetricsState: &backendmetrics.MetricsState{KVCacheUsagePercent: 0.2}},
+			},
+			expectedScoresPod: map[int]float64{
+				0: 0.4, // falls back to 1 - KV cache utilization
+				1: 0.8,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parameters, err := ParseKVCacheHeadroomParameters(KvCacheHeadroomScorerType, nil)
+			assert.NoError(t, err)
+			scores := NewKVCacheHeadroomScorer(parameters).Score(context.Background(), types.NewCycleState(), request, test.pods)
+
+			for i, pod := range test.pods {
+				expectedScore := test.expectedScoresPod[i]
+				assert.InDelta(t, expectedScore, scores[pod], 0.0001, "Pod %d should have score %f", i, expectedScore)
+			}
+		})
+	}
+}
+
+func TestParseKVCacheHeadroomParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		want    KVCacheHeadroomParameters
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			want: KVCacheHeadroomParameters{CharactersPerToken: 4, DefaultOutputTokens: defaultOutputTokens},
+		},
+		{
+			name:   "custom values",
+			params: `{"charactersPerToken": 3, "defaultOutputTokens": 1024}`,
+			want:   KVCacheHeadroomParameters{CharactersPerToken: 3, DefaultOutputTokens: 1024},
+		},
+		{
+			name:    "invalid characters per token",
+			params:  `{"charactersPerToken": 0}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			params:  `{"charactersPerToken": "four"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var rawParameters json.RawMessage
+			if test.params != "" {
+				rawParameters = json.RawMessage(test.params)
+			}
+			got, err := ParseKVCacheHeadroomParameters(KvCacheHeadroomScorerType, rawParameters)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
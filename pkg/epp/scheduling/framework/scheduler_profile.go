@@ -73,6 +73,7 @@ func (p *SchedulerProfile) WithPicker(picker Picker) *SchedulerProfile {
 // Special Case: In order to add a scorer, one must use the scorer.NewWeightedScorer function in order to provide a weight.
 // if a scorer implements more than one interface, supplying a WeightedScorer is sufficient. The function will take the internal
 // scorer object and register it to all interfaces it implements.
+// Similarly, a soft filter is added by supplying a SoftFilter created with the NewSoftFilter function.
 func (p *SchedulerProfile) AddPlugins(pluginObjects ...plugins.Plugin) error {
 	for _, plugin := range pluginObjects {
 		if weightedScorer, ok := plugin.(*WeightedScorer); ok {
@@ -81,7 +82,10 @@ func (p *SchedulerProfile) AddPlugins(pluginObjects ...plugins.Plugin) error {
 		} else if scorer, ok := plugin.(Scorer); ok { // if we got a Scorer instead of WeightedScorer that's an error.
 			return fmt.Errorf("failed to register scorer '%s' without a weight. follow function documentation to register a scorer", scorer.TypedName())
 		}
-		if filter, ok := plugin.(Filter); ok {
+		if softFilter, ok := plugin.(*SoftFilter); ok {
+			p.filters = append(p.filters, softFilter)
+			plugin = softFilter.Unwrap() // if we got SoftFilter, unwrap the plugin
+		} else if filter, ok := plugin.(Filter); ok {
 			p.filters = append(p.filters, filter)
 		}
 		if picker, ok := plugin.(Picker); ok {
@@ -94,10 +98,23 @@ func (p *SchedulerProfile) AddPlugins(pluginObjects ...plugins.Plugin) error {
 	return nil
 }
 
+// HasScorer returns true if the SchedulerProfile has a scorer of the given type.
+func (p *SchedulerProfile) HasScorer(scorerType string) bool {
+	for _, scorer := range p.scorers {
+		if scorer.TypedName().Type == scorerType {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *SchedulerProfile) String() string {
 	filterNames := make([]string, len(p.filters))
 	for i, filter := range p.filters {
 		filterNames[i] = filter.TypedName().String()
+		if softFilter, ok := filter.(*SoftFilter); ok {
+			filterNames[i] = fmt.Sprintf("%s (fallback: %s)", filterNames[i], softFilter.Policy())
+		}
 	}
 	scorerNames := make([]string, len(p.scorers))
 	for i, scorer := range p.scorers {
@@ -133,11 +150,13 @@ func (p *SchedulerProfile) runFilterPlugins(ctx context.Context, request *types.
 	loggerDebug.Info("Before running filter plugins", "pods", filteredPods)
 
 	for _, filter := range p.filters {
-		loggerDebug.Info("Running filter plugin", "plugin", filter.TypedName())
-		before := time.Now()
-		filteredPods = filter.Filter(ctx, cycleState, request, filteredPods)
-		metrics.RecordPluginProcessingLatency(FilterExtensionPoint, filter.TypedName().Type, filter.TypedName().Name, time.Since(before))
-		loggerDebug.Info("Completed running filter plugin successfully", "plugin", filter.TypedName(), "pods", filteredPods)
+		inputPods := filteredPods
+		filteredPods = runFilterPlugin(ctx, filter, request, cycleState, inputPods)
+		if len(filteredPods) == 0 {
+			if softFilter, ok := filter.(*SoftFilter); ok { // a soft filter doesn't fail the profile, apply its fallback instead
+				filteredPods = p.runFilterFallback(ctx, softFilter, request, cycleState, inputPods)
+			}
+		}
 		if len(filteredPods) == 0 {
 			break
 		}
@@ -147,6 +166,49 @@ func (p *SchedulerProfile) runFilterPlugins(ctx context.Context, request *types.
 	return filteredPods
 }
 
+func (p *SchedulerProfile) runFilterFallback(ctx context.Context, softFilter *SoftFilter, request *types.LLMRequest, cycleState *types.CycleState, pods []types.Pod) []types.Pod {
+	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
+	loggerDebug.Info("Soft filter filtered out all pods, running fallback", "plugin", softFilter.TypedName(), "policy", softFilter.Policy())
+	metrics.RecordSchedulerFilterFallback(softFilter.TypedName().Type, softFilter.TypedName().Name, string(softFilter.Policy()))
+
+	var filteredPods []types.Pod
+	switch softFilter.Policy() {
+	case FallbackSkip:
+		filteredPods = pods
+	case FallbackRelax:
+		relaxableFilter, ok := softFilter.Unwrap().(RelaxableFilter)
+		if !ok {
+			loggerDebug.Info("Soft filter doesn't support relaxed filtering", "plugin", softFilter.TypedName())
+			return []types.Pod{}
+		}
+		before := time.Now()
+		filteredPods = relaxableFilter.RelaxedFilter(ctx, cycleState, request, pods)
+		metrics.RecordPluginProcessingLatency(FilterExtensionPoint, softFilter.TypedName().Type, softFilter.TypedName().Name, time.Since(before))
+	case FallbackSecondary:
+		filteredPods = pods
+		for _, filter := range softFilter.Secondary() {
+			filteredPods = runFilterPlugin(ctx, filter, request, cycleState, filteredPods)
+			if len(filteredPods) == 0 {
+				break
+			}
+		}
+	}
+	loggerDebug.Info("Completed running soft filter fallback", "plugin", softFilter.TypedName(), "pods", filteredPods)
+
+	return filteredPods
+}
+
+func runFilterPlugin(ctx context.Context, filter Filter, request *types.LLMRequest, cycleState *types.CycleState, pods []types.Pod) []types.Pod {
+	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
+	loggerDebug.Info("Running filter plugin", "plugin", filter.TypedName())
+	before := time.Now()
+	filteredPods := filter.Filter(ctx, cycleState, request, pods)
+	metrics.RecordPluginProcessingLatency(FilterExtensionPoint, filter.TypedName().Type, filter.TypedName().Name, time.Since(before))
+	loggerDebug.Info("Completed running filter plugin successfully", "plugin", filter.TypedName(), "pods", filteredPods)
+
+	return filteredPods
+}
+
 func (p *SchedulerProfile) runScorerPlugins(ctx context.Context, request *types.LLMRequest, cycleState *types.CycleState, pods []types.Pod) map[types.Pod]float64 {
 	logger := log.FromContext(ctx)
 	logger.V(logutil.DEBUG).Info("Before running scorer plugins", "pods", pods)
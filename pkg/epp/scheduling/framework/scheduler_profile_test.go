@@ -177,6 +177,100 @@ func TestSchedulePlugins(t *testing.T) {
 	}
 }
 
+func TestSoftFilterFallback(t *testing.T) {
+	filterAll := &testPlugin{
+		TypeRes:   "filter all",
+		FilterRes: []k8stypes.NamespacedName{},
+	}
+	filterPod2 := &testPlugin{
+		TypeRes:   "filter pod2",
+		FilterRes: []k8stypes.NamespacedName{{Name: "pod2"}},
+	}
+	relaxable := &relaxableTestPlugin{
+		testPlugin: testPlugin{
+			TypeRes:   "relaxable",
+			FilterRes: []k8stypes.NamespacedName{},
+		},
+		RelaxedFilterRes: []k8stypes.NamespacedName{{Name: "pod3"}},
+	}
+	input := []types.Pod{
+		&types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}},
+		&types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}},
+		&types.PodMetrics{Pod: &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pod3"}}},
+	}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		wantPods []k8stypes.NamespacedName
+	}{
+		{
+			name:     "hard filter filters all",
+			filter:   filterAll,
+			wantPods: []k8stypes.NamespacedName{},
+		},
+		{
+			name:     "skip fallback",
+			filter:   NewSoftFilter(filterAll, FallbackSkip),
+			wantPods: []k8stypes.NamespacedName{{Name: "pod1"}, {Name: "pod2"}, {Name: "pod3"}},
+		},
+		{
+			name:     "relax fallback",
+			filter:   NewSoftFilter(relaxable, FallbackRelax),
+			wantPods: []k8stypes.NamespacedName{{Name: "pod3"}},
+		},
+		{
+			name:     "relax fallback of a filter that doesn't support it",
+			filter:   NewSoftFilter(filterAll, FallbackRelax),
+			wantPods: []k8stypes.NamespacedName{},
+		},
+		{
+			name:     "secondary fallback",
+			filter:   NewSoftFilter(filterAll, FallbackSecondary, filterPod2),
+			wantPods: []k8stypes.NamespacedName{{Name: "pod2"}},
+		},
+		{
+			name:     "secondary fallback filters all",
+			filter:   NewSoftFilter(filterAll, FallbackSecondary, filterPod2, filterAll),
+			wantPods: []k8stypes.NamespacedName{},
+		},
+		{
+			name:     "soft filter doesn't filter all",
+			filter:   NewSoftFilter(filterPod2, FallbackSkip),
+			wantPods: []k8stypes.NamespacedName{{Name: "pod2"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			profile := NewSchedulerProfile().WithFilters(test.filter)
+			request := &types.LLMRequest{
+				TargetModel: "test-model",
+				RequestId:   uuid.NewString(),
+			}
+
+			got := profile.runFilterPlugins(context.Background(), request, types.NewCycleState(), input)
+
+			if diff := cmp.Diff(findPods(input, test.wantPods...), got); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
+func TestHasScorer(t *testing.T) {
+	tp := &testPlugin{typedName: plugins.TypedName{Type: "test-scorer", Name: "test"}}
+	profile := NewSchedulerProfile().WithFilters(&testPlugin{typedName: plugins.TypedName{Type: "test-filter", Name: "filter"}}).
+		WithScorers(NewWeightedScorer(tp, 1))
+
+	if !profile.HasScorer("test-scorer") {
+		t.Error("Expected the profile to have a 'test-scorer' scorer")
+	}
+	if profile.HasScorer("test-filter") {
+		t.Error("Expected the profile to have no 'test-filter' scorer")
+	}
+}
+
 // compile-time type assertion
 var _ Filter = &testPlugin{}
 var _ Scorer = &testPlugin{}
@@ -251,3 +345,16 @@ func findPods(pods []types.Pod, names ...k8stypes.NamespacedName) []types.Pod {
 	}
 	return res
 }
+
+// compile-time type assertion
+var _ RelaxableFilter = &relaxableTestPlugin{}
+
+// relaxableTestPlugin is a filter implementation that supports relaxed filtering, useful in unit tests.
+type relaxableTestPlugin struct {
+	testPlugin
+	RelaxedFilterRes []k8stypes.NamespacedName
+}
+
+func (tp *relaxableTestPlugin) RelaxedFilter(_ context.Context, _ *types.CycleState, _ *types.LLMRequest, pods []types.Pod) []types.Pod {
+	return findPods(pods, tp.RelaxedFilterRes...)
+}
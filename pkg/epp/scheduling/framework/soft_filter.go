@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/plugins"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+// FallbackPolicy defines what a SchedulerProfile does when a soft filter filters out all candidate pods.
+type FallbackPolicy string
+
+const (
+	// FallbackSkip ignores the filter result and continues with the pods the filter received.
+	FallbackSkip FallbackPolicy = "Skip"
+	// FallbackRelax runs the relaxed variant of the filter. The filter must implement RelaxableFilter.
+	FallbackRelax FallbackPolicy = "Relax"
+	// FallbackSecondary runs a secondary chain of filters on the pods the filter received.
+	FallbackSecondary FallbackPolicy = "Secondary"
+)
+
+// RelaxableFilter is a Filter that can provide a less strict filtering variant.
+// The relaxed variant is used by a SchedulerProfile when the strict variant filtered out all pods
+// and the filter was configured as soft with the FallbackRelax policy.
+type RelaxableFilter interface {
+	Filter
+	RelaxedFilter(ctx context.Context, cycleState *types.CycleState, request *types.LLMRequest, pods []types.Pod) []types.Pod
+}
+
+// NewSoftFilter initializes a new SoftFilter and returns its pointer.
+// The secondary filters are used only with the FallbackSecondary policy.
+func NewSoftFilter(filter Filter, policy FallbackPolicy, secondary ...Filter) *SoftFilter {
+	return &SoftFilter{
+		filter:    filter,
+		policy:    policy,
+		secondary: secondary,
+	}
+}
+
+// SoftFilter is a struct that encapsulates a filter with the fallback behavior to apply
+// when the filter filters out all candidate pods.
+type SoftFilter struct {
+	filter    Filter
+	policy    FallbackPolicy
+	secondary []Filter
+}
+
+// TypedName returns the type and name tuple of the encapsulated filter.
+func (f *SoftFilter) TypedName() plugins.TypedName {
+	return f.filter.TypedName()
+}
+
+// Filter runs the encapsulated filter.
+func (f *SoftFilter) Filter(ctx context.Context, cycleState *types.CycleState, request *types.LLMRequest, pods []types.Pod) []types.Pod {
+	return f.filter.Filter(ctx, cycleState, request, pods)
+}
+
+// Unwrap returns the encapsulated filter.
+func (f *SoftFilter) Unwrap() Filter {
+	return f.filter
+}
+
+// Policy returns the fallback policy of the filter.
+func (f *SoftFilter) Policy() FallbackPolicy {
+	return f.policy
+}
+
+// Secondary returns the secondary filters that are used with the FallbackSecondary policy.
+func (f *SoftFilter) Secondary() []Filter {
+	return f.secondary
+}
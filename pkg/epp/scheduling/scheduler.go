@@ -25,7 +25,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/multicluster"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/scorer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
 )
@@ -69,7 +71,7 @@ func (s *Scheduler) Schedule(ctx context.Context, request *types.LLMRequest, can
 		for name, profile := range profiles {
 			loggerDebug.Info("Running scheduler profile", "name", name)
 			// run the selected profiles and collect results (current code runs all profiles)
-			profileRunResult, err := profile.Run(ctx, request, cycleState, candidatePods)
+			profileRunResult, err := profile.Run(ctx, request, cycleState, profileCandidatePods(profile, candidatePods))
 			if err != nil {
 				loggerDebug.Info("failed to run scheduler profile", "profile", name, "error", err.Error())
 			} else {
@@ -92,3 +94,18 @@ func (s *Scheduler) Schedule(ctx context.Context, request *types.LLMRequest, can
 
 	return result, err
 }
+
+// profileCandidatePods returns the candidate pods of the given profile. The remote endpoint groups of the pool in
+// other clusters are only candidates of the profiles preferring the local endpoints with the cluster locality scorer.
+func profileCandidatePods(profile *framework.SchedulerProfile, candidatePods []types.Pod) []types.Pod {
+	if profile.HasScorer(scorer.ClusterLocalityScorerType) {
+		return candidatePods
+	}
+	localPods := make([]types.Pod, 0, len(candidatePods))
+	for _, pod := range candidatePods {
+		if _, remote := multicluster.ClusterOf(pod); !remote {
+			localPods = append(localPods, pod)
+		}
+	}
+	return localPods
+}
@@ -36,6 +36,16 @@ type SchedulerConfig struct {
 	profiles       map[string]*framework.SchedulerProfile
 }
 
+// HasScorer returns true if any profile of the SchedulerConfig has a scorer of the given type.
+func (c *SchedulerConfig) HasScorer(scorerType string) bool {
+	for _, profile := range c.profiles {
+		if profile.HasScorer(scorerType) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *SchedulerConfig) String() string {
 	return fmt.Sprintf(
 		"{ProfileHandler: %s, Profiles: %v}",
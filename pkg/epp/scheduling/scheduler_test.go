@@ -26,6 +26,8 @@ import (
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics" // Import config for thresholds
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/multicluster"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/multi/prefix"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/framework/plugins/picker"
@@ -156,3 +158,52 @@ func TestSchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleRemoteEndpoints(t *testing.T) {
+	attributes := datalayer.NewAttributes()
+	attributes.Put(multicluster.ClusterAttributeKey, multicluster.Cluster("cluster-b"))
+	remote := &types.PodMetrics{
+		Pod:          &backend.Pod{NamespacedName: k8stypes.NamespacedName{Name: "pool@cluster-b"}},
+		MetricsState: &backendmetrics.MetricsState{},
+		Attributes:   attributes,
+	}
+
+	tests := []struct {
+		name    string
+		scorer  framework.Scorer
+		wantPod string
+		err     bool
+	}{
+		{
+			name:    "profile with the cluster locality scorer",
+			scorer:  scorer.NewClusterLocalityScorer(scorer.ClusterLocalityParameters{QueueDepthThreshold: 5, KVCacheUtilThreshold: 0.8}),
+			wantPod: "pool@cluster-b",
+		},
+		{
+			name:   "profile without the cluster locality scorer",
+			scorer: scorer.NewQueueScorer(),
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defaultProfile := framework.NewSchedulerProfile().
+				WithScorers(framework.NewWeightedScorer(test.scorer, 1)).
+				WithPicker(picker.NewMaxScorePicker(picker.DefaultMaxNumOfEndpoints))
+			scheduler := NewSchedulerWithConfig(NewSchedulerConfig(profile.NewSingleProfileHandler(),
+				map[string]*framework.SchedulerProfile{"default": defaultProfile}))
+
+			got, err := scheduler.Schedule(context.Background(), &types.LLMRequest{RequestId: uuid.NewString()}, []types.Pod{remote})
+			if test.err != (err != nil) {
+				t.Fatalf("Unexpected error, got %v, want %v", err, test.err)
+			}
+			if test.err {
+				return
+			}
+			if diff := cmp.Diff(test.wantPod, got.ProfileResults["default"].TargetPods[0].GetPod().NamespacedName.Name); diff != "" {
+				t.Errorf("Unexpected target pod (-want +got): %v", diff)
+			}
+		})
+	}
+}
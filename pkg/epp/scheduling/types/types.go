@@ -17,6 +17,8 @@ limitations under the License.
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,6 +26,7 @@ import (
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend"
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 )
 
 const nilString = "<nil>"
@@ -71,6 +74,35 @@ func (r *LLMRequestBody) CacheSalt() string {
 	return r.Completions.CacheSalt
 }
 
+// MaxTokens returns the maximum number of output tokens requested, or 0 if the request didn't specify a limit.
+func (r *LLMRequestBody) MaxTokens() int {
+	if r.ChatCompletions != nil {
+		if r.ChatCompletions.MaxCompletionTokens > 0 {
+			return r.ChatCompletions.MaxCompletionTokens
+		}
+		return r.ChatCompletions.MaxTokens
+	}
+	if r.Completions != nil {
+		return r.Completions.MaxTokens
+	}
+	return 0
+}
+
+// PromptLength returns the length in characters of the user input of the request.
+func (r *LLMRequestBody) PromptLength() int {
+	if r.Completions != nil {
+		return len(r.Completions.Prompt)
+	}
+	if r.ChatCompletions != nil {
+		length := 0
+		for _, msg := range r.ChatCompletions.Messages {
+			length += len(msg.Content.PlainText())
+		}
+		return length
+	}
+	return 0
+}
+
 // CompletionsRequest is a structured representation of the fields we parse out of the /v1/completions request
 // body. For detailed body fields, please refer to https://platform.openai.com/docs/api-reference/completions.
 // This struct includes fields usable for plugins and scheduling decisions - and not the entire
@@ -78,6 +110,8 @@ func (r *LLMRequestBody) CacheSalt() string {
 type CompletionsRequest struct {
 	// Prompt is the prompt that was sent in the request body.
 	Prompt string `json:"prompt,omitempty"`
+	// MaxTokens is the maximum number of tokens that can be generated in the completion.
+	MaxTokens int `json:"max_tokens,omitempty"`
 	// CacheSalt is an optional request parameter to isolate prefix caches for security reasons.
 	CacheSalt string `json:"cache_salt,omitempty"`
 }
@@ -96,8 +130,10 @@ func (r *CompletionsRequest) String() string {
 // API spec.
 type ChatCompletionsRequest struct {
 	/* parameters from the official OpenAI chat-completions API */
-	Messages []Message     `json:"messages,omitempty"`
-	Tools    []interface{} `json:"tools,omitempty"`
+	Messages            []Message     `json:"messages,omitempty"`
+	Tools               []interface{} `json:"tools,omitempty"`
+	MaxTokens           int           `json:"max_tokens,omitempty"`
+	MaxCompletionTokens int           `json:"max_completion_tokens,omitempty"`
 	/* parameters from the HuggingFace transformers chat-templates API */
 	Documents                 []interface{}          `json:"documents,omitempty"`
 	ChatTemplate              string                 `json:"chat_template,omitempty"`
@@ -135,15 +171,71 @@ type Content struct {
 }
 
 type ContentBlock struct {
-	Type     string     `json:"type"`
-	Text     string     `json:"text,omitempty"`
-	ImageURL ImageBlock `json:"image_url,omitempty"`
+	Type       string      `json:"type"`
+	Text       string      `json:"text,omitempty"`
+	ImageURL   ImageBlock  `json:"image_url,omitempty"`
+	InputAudio *AudioBlock `json:"input_audio,omitempty"`
+	VideoURL   *VideoBlock `json:"video_url,omitempty"`
 }
 
 type ImageBlock struct {
 	Url string `json:"url,omitempty"`
 }
 
+type AudioBlock struct {
+	// Data is the base64 encoded audio data.
+	Data   string `json:"data,omitempty"`
+	Format string `json:"format,omitempty"`
+}
+
+type VideoBlock struct {
+	Url string `json:"url,omitempty"`
+}
+
+// MediaDigest returns a stable identifier of the media in the block, or an empty string if the block has no media.
+// Inline media, i.e., data URLs and input audio, is identified by the SHA-256 digest of its data, and remote media
+// is identified by its URL.
+func (b ContentBlock) MediaDigest() string {
+	switch {
+	case b.ImageURL.Url != "":
+		return urlDigest(b.ImageURL.Url)
+	case b.InputAudio != nil && b.InputAudio.Data != "":
+		return dataDigest(b.InputAudio.Data)
+	case b.VideoURL != nil && b.VideoURL.Url != "":
+		return urlDigest(b.VideoURL.Url)
+	}
+	return ""
+}
+
+// WithMediaDigest returns a copy of the block where the media is replaced by its digest.
+func (b ContentBlock) WithMediaDigest() ContentBlock {
+	digest := b.MediaDigest()
+	if digest == "" {
+		return b
+	}
+	switch {
+	case b.ImageURL.Url != "":
+		b.ImageURL = ImageBlock{Url: digest}
+	case b.InputAudio != nil && b.InputAudio.Data != "":
+		b.InputAudio = &AudioBlock{Data: digest, Format: b.InputAudio.Format}
+	case b.VideoURL != nil && b.VideoURL.Url != "":
+		b.VideoURL = &VideoBlock{Url: digest}
+	}
+	return b
+}
+
+func urlDigest(url string) string {
+	if strings.HasPrefix(url, "data:") {
+		return dataDigest(url)
+	}
+	return url
+}
+
+func dataDigest(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
 // UnmarshalJSON allow use both format
 func (mc *Content) UnmarshalJSON(data []byte) error {
 	// Raw format
@@ -173,6 +265,30 @@ func (mc Content) MarshalJSON() ([]byte, error) {
 	return json.Marshal("")
 }
 
+// MediaDigests returns the digests of the media blocks of the content, in order.
+func (mc Content) MediaDigests() []string {
+	var digests []string
+	for _, block := range mc.Structured {
+		if digest := block.MediaDigest(); digest != "" {
+			digests = append(digests, digest)
+		}
+	}
+	return digests
+}
+
+// WithMediaDigests returns a copy of the content where the media of every block is replaced by its digest.
+// The position of the media relative to the text is kept.
+func (mc Content) WithMediaDigests() Content {
+	if mc.Structured == nil {
+		return mc
+	}
+	blocks := make([]ContentBlock, len(mc.Structured))
+	for i, block := range mc.Structured {
+		blocks[i] = block.WithMediaDigest()
+	}
+	return Content{Raw: mc.Raw, Structured: blocks}
+}
+
 func (mc Content) PlainText() string {
 	if mc.Raw != "" {
 		return mc.Raw
@@ -190,6 +306,10 @@ func (mc Content) PlainText() string {
 type Pod interface {
 	GetPod() *backend.Pod
 	GetMetrics() *backendmetrics.MetricsState
+	// GetHealth returns the collection health of the pod, or nil if it is unknown.
+	GetHealth() *datalayer.Health
+	// Get returns a copy of the pod's data layer attribute with the given key.
+	Get(key string) (datalayer.Cloneable, bool)
 	String() string
 }
 
@@ -214,9 +334,22 @@ func (pm *PodMetrics) GetMetrics() *backendmetrics.MetricsState {
 	return pm.MetricsState
 }
 
+func (pm *PodMetrics) GetHealth() *datalayer.Health {
+	return pm.Health
+}
+
+func (pm *PodMetrics) Get(key string) (datalayer.Cloneable, bool) {
+	if pm.Attributes == nil {
+		return nil, false
+	}
+	return pm.Attributes.Get(key)
+}
+
 type PodMetrics struct {
 	*backend.Pod
 	*backendmetrics.MetricsState
+	Health     *datalayer.Health
+	Attributes *datalayer.Attributes
 }
 
 // ProfileRunResult captures the profile run result.
@@ -20,7 +20,9 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -32,6 +34,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha1"
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
 )
@@ -40,12 +43,21 @@ var scheme = runtime.NewScheme()
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.Install(scheme))
 	utilruntime.Must(v1alpha2.Install(scheme))
 	utilruntime.Must(v1.Install(scheme))
 }
 
 // defaultManagerOptions returns the default options used to create the manager.
 func defaultManagerOptions(gknn common.GKNN, metricsServerOptions metricsserver.Options) (ctrl.Options, error) {
+	return managerOptions(gknn, cache.Config{FieldSelector: fields.SelectorFromSet(fields.Set{
+		"metadata.name": gknn.Name,
+	})}, metricsServerOptions)
+}
+
+// managerOptions returns the options used to create the manager, caching the InferencePools of the given group
+// and namespace with the given cache configuration.
+func managerOptions(gknn common.GKNN, poolCache cache.Config, metricsServerOptions metricsserver.Options) (ctrl.Options, error) {
 	opt := ctrl.Options{
 		Scheme: scheme,
 		Cache: cache.Options{
@@ -60,6 +72,16 @@ func defaultManagerOptions(gknn common.GKNN, metricsServerOptions metricsserver.
 						gknn.Namespace: {},
 					},
 				},
+				&discoveryv1.EndpointSlice{}: {
+					Namespaces: map[string]cache.Config{
+						gknn.Namespace: {},
+					},
+				},
+				&v1alpha1.InferencePoolImport{}: {
+					Namespaces: map[string]cache.Config{
+						gknn.Namespace: {},
+					},
+				},
 			},
 		},
 		Metrics: metricsServerOptions,
@@ -67,15 +89,11 @@ func defaultManagerOptions(gknn common.GKNN, metricsServerOptions metricsserver.
 	switch gknn.Group {
 	case v1alpha2.GroupName:
 		opt.Cache.ByObject[&v1alpha2.InferencePool{}] = cache.ByObject{
-			Namespaces: map[string]cache.Config{gknn.Namespace: {FieldSelector: fields.SelectorFromSet(fields.Set{
-				"metadata.name": gknn.Name,
-			})}},
+			Namespaces: map[string]cache.Config{gknn.Namespace: poolCache},
 		}
 	case v1.GroupName:
 		opt.Cache.ByObject[&v1.InferencePool{}] = cache.ByObject{
-			Namespaces: map[string]cache.Config{gknn.Namespace: {FieldSelector: fields.SelectorFromSet(fields.Set{
-				"metadata.name": gknn.Name,
-			})}},
+			Namespaces: map[string]cache.Config{gknn.Namespace: poolCache},
 		}
 	default:
 		return ctrl.Options{}, fmt.Errorf("unknown group: %s", gknn.Group)
@@ -90,13 +108,28 @@ func NewDefaultManager(gknn common.GKNN, restConfig *rest.Config, metricsServerO
 	if err != nil {
 		return nil, fmt.Errorf("failed to create controller manager options: %v", err)
 	}
+	return newManager(restConfig, opt, gknn.Namespace, gknn.Name, leaderElectionEnabled)
+}
+
+// NewMultiPoolManager creates a new controller manager for an Endpoint Picker serving several InferencePools of the
+// group and namespace of gknn, whose name is ignored. The InferencePools cache holds the pools matching poolLabels,
+// or all the pools of the namespace when poolLabels is nil. The poolSetID must be unique per EPP deployment, it
+// names the leader election lease.
+func NewMultiPoolManager(gknn common.GKNN, poolLabels labels.Selector, poolSetID string, restConfig *rest.Config, metricsServerOptions metricsserver.Options, leaderElectionEnabled bool) (ctrl.Manager, error) {
+	opt, err := managerOptions(gknn, cache.Config{LabelSelector: poolLabels}, metricsServerOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller manager options: %v", err)
+	}
+	return newManager(restConfig, opt, gknn.Namespace, poolSetID, leaderElectionEnabled)
+}
 
+func newManager(restConfig *rest.Config, opt ctrl.Options, namespace, name string, leaderElectionEnabled bool) (ctrl.Manager, error) {
 	if leaderElectionEnabled {
 		opt.LeaderElection = true
 		opt.LeaderElectionResourceLock = "leases"
 		// The lease name needs to be unique per EPP deployment.
-		opt.LeaderElectionID = fmt.Sprintf("epp-%s-%s.gateway-api-inference-extension.sigs.k8s.io", gknn.Namespace, gknn.Name)
-		opt.LeaderElectionNamespace = gknn.Namespace
+		opt.LeaderElectionID = fmt.Sprintf("epp-%s-%s.gateway-api-inference-extension.sigs.k8s.io", namespace, name)
+		opt.LeaderElectionNamespace = namespace
 		opt.LeaderElectionReleaseOnCancel = true
 	}
 
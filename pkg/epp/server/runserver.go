@@ -41,6 +41,8 @@ import (
 	dlmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/multicluster"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/poolset"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector"
 )
@@ -58,7 +60,24 @@ type ExtProcServerRunner struct {
 	MetricsStalenessThreshold        time.Duration
 	Director                         *requestcontrol.Director
 	SaturationDetector               *saturationdetector.Detector
-	UseExperimentalDatalayerV2       bool // Pluggable data layer feature flag
+	// PriorityBands is synchronized with the priorities of the InferenceObjectives when set.
+	PriorityBands              controller.PriorityBandSyncer
+	UseExperimentalDatalayerV2 bool // Pluggable data layer feature flag
+	// UseEndpointSlices discovers the endpoints of the pools from EndpointSlices instead of pods. The datastores
+	// must be created with the datastore.WithEndpointSlices option.
+	UseEndpointSlices bool
+	// StatusUpdateInterval is the interval between two writes of the status of the served InferencePools and
+	// InferenceObjectives by the leader. Status is not written when it is 0.
+	StatusUpdateInterval time.Duration
+	// RemoteStatusSource is set to route requests to the clusters exporting the served pools, as listed by their
+	// InferencePoolImports. The remote endpoints are synced every RemoteStatusInterval, skipping the ClusterName
+	// cluster.
+	RemoteStatusSource   multicluster.StatusSource
+	RemoteStatusInterval time.Duration
+	ClusterName          string
+	// Pools is set when the Endpoint Picker serves several InferencePools. Each pool then has its own datastore
+	// and director, and the Datastore, Director, SaturationDetector and PriorityBands fields are unused.
+	Pools *poolset.Registry
 
 	// This should only be used in tests. We won't need this once we do not inject metrics in the tests.
 	// TODO:(https://github.com/kubernetes-sigs/gateway-api-inference-extension/issues/432) Cleanup
@@ -86,6 +105,10 @@ const (
 	DefaultConfigText                       = ""                            // default for --config-text
 	DefaultPoolGroup                        = "inference.networking.k8s.io" // default for --pool-group
 	DefaultMetricsStalenessThreshold        = 2 * time.Second
+	DefaultStandaloneRefreshInterval        = 5 * time.Second  // default for --standalone-refresh-interval
+	DefaultEndpointDrainTimeout             = 30 * time.Second // default for --endpoint-drain-timeout
+	DefaultStatusUpdateInterval             = 10 * time.Second // default for --status-update-interval
+	DefaultRemoteStatusInterval             = time.Second      // default for --remote-status-interval
 )
 
 // NewDefaultExtProcServerRunner creates a runner with default values.
@@ -112,6 +135,10 @@ func NewDefaultExtProcServerRunner() *ExtProcServerRunner {
 
 // SetupWithManager sets up the runner with the given manager.
 func (r *ExtProcServerRunner) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if r.Pools != nil {
+		return r.setupPoolSetWithManager(mgr)
+	}
+
 	// Create the controllers and register them with the manager
 	if err := (&controller.InferencePoolReconciler{
 		Datastore: r.Datastore,
@@ -122,13 +149,27 @@ func (r *ExtProcServerRunner) SetupWithManager(ctx context.Context, mgr ctrl.Man
 	}
 
 	if err := (&controller.InferenceObjectiveReconciler{
-		Datastore: r.Datastore,
-		Reader:    mgr.GetClient(),
-		PoolGKNN:  r.PoolGKNN,
+		Datastore:     r.Datastore,
+		Reader:        mgr.GetClient(),
+		PoolGKNN:      r.PoolGKNN,
+		PriorityBands: r.PriorityBands,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed setting up InferenceObjectiveReconciler: %w", err)
 	}
 
+	datastores := func() []datastore.Datastore {
+		return []datastore.Datastore{r.Datastore}
+	}
+	if err := r.setupStatusWriter(mgr, datastores); err != nil {
+		return err
+	}
+	if err := r.setupRemoteEndpointSyncer(mgr, datastores); err != nil {
+		return err
+	}
+
+	if r.UseEndpointSlices {
+		return r.setupEndpointSliceReconciler(mgr, datastores)
+	}
 	if err := (&controller.PodReconciler{
 		Datastore: r.Datastore,
 		Reader:    mgr.GetClient(),
@@ -138,14 +179,100 @@ func (r *ExtProcServerRunner) SetupWithManager(ctx context.Context, mgr ctrl.Man
 	return nil
 }
 
+func (r *ExtProcServerRunner) setupEndpointSliceReconciler(mgr ctrl.Manager, datastores func() []datastore.Datastore) error {
+	if err := (&controller.EndpointSliceReconciler{
+		Datastores: datastores,
+		Reader:     mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed setting up EndpointSliceReconciler: %w", err)
+	}
+	return nil
+}
+
+func (r *ExtProcServerRunner) setupStatusWriter(mgr ctrl.Manager, datastores func() []datastore.Datastore) error {
+	if r.StatusUpdateInterval <= 0 {
+		return nil
+	}
+	if err := (&controller.StatusWriter{
+		Client:     mgr.GetClient(),
+		Datastores: datastores,
+		PoolGKNN:   r.PoolGKNN,
+		Interval:   r.StatusUpdateInterval,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed setting up StatusWriter: %w", err)
+	}
+	return nil
+}
+
+func (r *ExtProcServerRunner) setupRemoteEndpointSyncer(mgr ctrl.Manager, datastores func() []datastore.Datastore) error {
+	if r.RemoteStatusSource == nil {
+		return nil
+	}
+	if err := (&multicluster.Syncer{
+		Reader:       mgr.GetClient(),
+		Datastores:   datastores,
+		Source:       r.RemoteStatusSource,
+		LocalCluster: r.ClusterName,
+		Interval:     r.RemoteStatusInterval,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed setting up remote endpoint Syncer: %w", err)
+	}
+	return nil
+}
+
+func (r *ExtProcServerRunner) setupPoolSetWithManager(mgr ctrl.Manager) error {
+	if err := (&controller.InferencePoolSetReconciler{
+		Pools:    r.Pools,
+		Reader:   mgr.GetClient(),
+		PoolGKNN: r.PoolGKNN,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed setting up InferencePoolSetReconciler: %w", err)
+	}
+
+	if err := (&controller.InferenceObjectiveSetReconciler{
+		Pools:    r.Pools,
+		Reader:   mgr.GetClient(),
+		PoolGKNN: r.PoolGKNN,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed setting up InferenceObjectiveSetReconciler: %w", err)
+	}
+
+	if err := r.setupStatusWriter(mgr, r.Pools.Datastores); err != nil {
+		return err
+	}
+	if err := r.setupRemoteEndpointSyncer(mgr, r.Pools.Datastores); err != nil {
+		return err
+	}
+
+	if r.UseEndpointSlices {
+		return r.setupEndpointSliceReconciler(mgr, r.Pools.Datastores)
+	}
+	if err := (&controller.PodSetReconciler{
+		Pools:  r.Pools,
+		Reader: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed setting up PodSetReconciler: %w", err)
+	}
+	return nil
+}
+
+// StartMetricsLogger periodically logs the metrics of the datastore's pool and flushes them to Prometheus,
+// until ctx is done.
+func (r *ExtProcServerRunner) StartMetricsLogger(ctx context.Context, ds datastore.Datastore) {
+	if r.UseExperimentalDatalayerV2 {
+		dlmetrics.StartMetricsLogger(ctx, ds, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
+	} else {
+		backendmetrics.StartMetricsLogger(ctx, ds, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
+	}
+}
+
 // AsRunnable returns a Runnable that can be used to start the ext-proc gRPC server.
 // The runnable implements LeaderElectionRunnable with leader election disabled.
 func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 	return runnable.NoLeaderElection(manager.RunnableFunc(func(ctx context.Context) error {
-		if r.UseExperimentalDatalayerV2 {
-			dlmetrics.StartMetricsLogger(ctx, r.Datastore, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
-		} else {
-			backendmetrics.StartMetricsLogger(ctx, r.Datastore, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
+		// The metrics of a pool set are logged by the stack of each pool.
+		if r.Pools == nil {
+			r.StartMetricsLogger(ctx, r.Datastore)
 		}
 
 		var srv *grpc.Server
@@ -172,6 +299,9 @@ func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 		}
 
 		extProcServer := handlers.NewStreamingServer(r.Datastore, r.Director)
+		if r.Pools != nil {
+			extProcServer = handlers.NewMultiPoolStreamingServer(r.Pools)
+		}
 		extProcPb.RegisterExternalProcessorServer(srv, extProcServer)
 
 		if r.HealthChecking {
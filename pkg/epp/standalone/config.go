@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package standalone runs the Endpoint Picker outside Kubernetes. The pool, its endpoints and its objectives are read
+// from a YAML or JSON file, and the endpoints may be resolved from DNS SRV records instead, in lieu of the
+// InferencePool, InferenceObjective and Pod resources.
+package standalone
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Config is the content of the file defining the pool served by a standalone Endpoint Picker.
+type Config struct {
+	// Pool defines the served pool.
+	Pool PoolConfig `json:"pool"`
+	// Endpoints lists the endpoints of the pool. They must not be set when the endpoints are resolved from DNS.
+	Endpoints []EndpointConfig `json:"endpoints,omitempty"`
+	// Objectives lists the objectives of the requests served by the pool.
+	Objectives []ObjectiveConfig `json:"objectives,omitempty"`
+}
+
+// PoolConfig defines the served pool.
+type PoolConfig struct {
+	// Name is the name of the pool. It defaults to the --pool-name flag.
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the pool. It defaults to the --pool-namespace flag.
+	Namespace string `json:"namespace,omitempty"`
+	// TargetPorts are the ports of the model servers of each endpoint. When the endpoints are resolved from DNS,
+	// they default to the ports of the SRV records.
+	TargetPorts []int32 `json:"targetPorts,omitempty"`
+	// Selector selects the endpoints of the pool by their labels. All the endpoints are selected when it is empty.
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// EndpointConfig defines an endpoint of the pool.
+type EndpointConfig struct {
+	// Name identifies the endpoint. It defaults to its address.
+	Name string `json:"name,omitempty"`
+	// Address is the IP address of the endpoint.
+	Address string `json:"address"`
+	// Labels are the labels of the endpoint, available to the scheduling plugins.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ObjectiveConfig defines an objective of the requests served by the pool.
+type ObjectiveConfig struct {
+	// Name is the name of the objective, referenced by the requests.
+	Name string `json:"name"`
+	// Priority is the priority of the requests of the objective. Unset stands for 0.
+	Priority *int `json:"priority,omitempty"`
+}
+
+// LoadConfig parses and validates the given YAML or JSON configuration.
+func LoadConfig(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse standalone configuration - %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid standalone configuration - %w", err)
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	for _, port := range c.Pool.TargetPorts {
+		if port <= 0 || port > 65535 {
+			return fmt.Errorf("invalid target port %d", port)
+		}
+	}
+	names := make(map[string]bool, len(c.Endpoints))
+	for _, endpoint := range c.Endpoints {
+		if net.ParseIP(endpoint.Address) == nil {
+			return fmt.Errorf("invalid address %q of endpoint %q", endpoint.Address, endpoint.Name)
+		}
+		name := endpointName(endpoint)
+		if names[name] {
+			return fmt.Errorf("duplicate endpoint %q", name)
+		}
+		names[name] = true
+	}
+	objectives := make(map[string]bool, len(c.Objectives))
+	for _, objective := range c.Objectives {
+		if objective.Name == "" {
+			return errors.New("objective without name")
+		}
+		if objectives[objective.Name] {
+			return fmt.Errorf("duplicate objective %q", objective.Name)
+		}
+		objectives[objective.Name] = true
+	}
+	return nil
+}
+
+// endpointName returns the name of the endpoint, which defaults to its address.
+func endpointName(endpoint EndpointConfig) string {
+	if endpoint.Name != "" {
+		return endpoint.Name
+	}
+	return endpoint.Address
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			data: `
+pool:
+  name: pool
+  targetPorts: [8000, 8001]
+  selector:
+    app: vllm
+endpoints:
+- name: vllm-0
+  address: 10.0.0.1
+  labels:
+    app: vllm
+- address: 10.0.0.2
+objectives:
+- name: chat
+  priority: 10
+- name: batch
+`,
+			want: &Config{
+				Pool: PoolConfig{Name: "pool", TargetPorts: []int32{8000, 8001}, Selector: map[string]string{"app": "vllm"}},
+				Endpoints: []EndpointConfig{
+					{Name: "vllm-0", Address: "10.0.0.1", Labels: map[string]string{"app": "vllm"}},
+					{Address: "10.0.0.2"},
+				},
+				Objectives: []ObjectiveConfig{{Name: "chat", Priority: ptr.To(10)}, {Name: "batch"}},
+			},
+		},
+		{
+			name: "json",
+			data: `{"pool": {"targetPorts": [8000]}, "endpoints": [{"address": "fd00::1"}]}`,
+			want: &Config{
+				Pool:      PoolConfig{TargetPorts: []int32{8000}},
+				Endpoints: []EndpointConfig{{Address: "fd00::1"}},
+			},
+		},
+		{
+			name:    "unknown field",
+			data:    `{"pool": {"ports": [8000]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			data:    `{"pool": {"targetPorts": [0]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			data:    `{"endpoints": [{"name": "vllm-0", "address": "vllm-0.local"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate endpoint",
+			data:    `{"endpoints": [{"address": "10.0.0.1"}, {"name": "10.0.0.1", "address": "10.0.0.2"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "objective without name",
+			data:    `{"objectives": [{"priority": 1}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate objective",
+			data:    `{"objectives": [{"name": "chat"}, {"name": "chat"}]}`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := LoadConfig([]byte(test.data))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected config (-want +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/controller"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/logging"
+)
+
+// Resolver resolves DNS SRV records and the addresses of their targets. It is implemented by net.Resolver.
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Source keeps the datastore in sync with the configuration file and the DNS SRV records defining the pool.
+// It implements manager.Runnable, and is started in lieu of the reconcilers.
+type Source struct {
+	Datastore datastore.Datastore
+	// Pool holds the default name and namespace of the pool, overridden by the configuration file.
+	Pool types.NamespacedName
+	// ConfigFile is the path of the YAML or JSON configuration file. It is reloaded when its content changes.
+	ConfigFile string
+	// SRVName is the name of the DNS SRV records listing the endpoints of the pool, in lieu of the endpoints of the
+	// configuration file.
+	SRVName string
+	// RefreshInterval is the interval between two reloads of the configuration file and resolutions of the SRV records.
+	RefreshInterval time.Duration
+	// Resolver resolves the SRV records. It defaults to net.DefaultResolver.
+	Resolver Resolver
+	// PriorityBands is synchronized with the priorities of the objectives when set.
+	PriorityBands controller.PriorityBandSyncer
+
+	fileContent []byte
+	config      *Config
+	applied     *state
+}
+
+// state is the state of the pool applied to the datastore.
+type state struct {
+	pool       *v1.InferencePool
+	endpoints  []EndpointConfig
+	objectives []ObjectiveConfig
+}
+
+// Start syncs the datastore, then resyncs it at every refresh interval until ctx is done. The initial sync must
+// succeed, later failures are logged and keep the datastore in its last state.
+func (s *Source) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("standalone")
+	ctx = log.IntoContext(ctx, logger)
+	if err := s.Sync(ctx); err != nil {
+		return err
+	}
+	logger.Info("Standalone pool synced", "configFile", s.ConfigFile, "srvName", s.SRVName)
+
+	ticker := time.NewTicker(s.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.Sync(ctx); err != nil {
+				logger.Error(err, "Failed to sync standalone pool, keeping its last state")
+			}
+		}
+	}
+}
+
+// Sync reloads the configuration file and resolves the SRV records, then applies the resulting pool, endpoints and
+// objectives to the datastore if they changed.
+func (s *Source) Sync(ctx context.Context) error {
+	config, err := s.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	endpoints := config.Endpoints
+	targetPorts := config.Pool.TargetPorts
+	if s.SRVName != "" {
+		var srvPorts []int32
+		endpoints, srvPorts, err = s.resolve(ctx)
+		if err != nil {
+			return err
+		}
+		if len(targetPorts) == 0 {
+			targetPorts = srvPorts
+		}
+	}
+	if len(targetPorts) == 0 && s.SRVName != "" && len(endpoints) == 0 {
+		// Without SRV records, the ports are those of the records last resolved, or unknown until records are listed.
+		if s.applied == nil {
+			return nil
+		}
+		for _, port := range s.applied.pool.Spec.TargetPorts {
+			targetPorts = append(targetPorts, int32(port.Number))
+		}
+	}
+	if len(targetPorts) == 0 {
+		return errors.New("no target port defined for the pool")
+	}
+
+	pool := &v1.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.Pool.Name,
+			Namespace: s.Pool.Namespace,
+		},
+		Spec: v1.InferencePoolSpec{
+			Selector: v1.LabelSelector{MatchLabels: make(map[v1.LabelKey]v1.LabelValue, len(config.Pool.Selector))},
+		},
+	}
+	if config.Pool.Name != "" {
+		pool.Name = config.Pool.Name
+	}
+	if config.Pool.Namespace != "" {
+		pool.Namespace = config.Pool.Namespace
+	}
+	if pool.Name == "" {
+		return errors.New("no name defined for the pool")
+	}
+	for key, value := range config.Pool.Selector {
+		pool.Spec.Selector.MatchLabels[v1.LabelKey(key)] = v1.LabelValue(value)
+	}
+	for _, port := range targetPorts {
+		pool.Spec.TargetPorts = append(pool.Spec.TargetPorts, v1.Port{Number: v1.PortNumber(port)})
+	}
+
+	desired := &state{pool: pool, endpoints: endpoints, objectives: config.Objectives}
+	if reflect.DeepEqual(desired, s.applied) {
+		return nil
+	}
+	if err := s.apply(ctx, desired); err != nil {
+		return err
+	}
+	s.applied = desired
+	return nil
+}
+
+// loadConfig returns the configuration, reloaded if the content of the configuration file changed.
+func (s *Source) loadConfig() (*Config, error) {
+	if s.ConfigFile == "" {
+		return &Config{}, nil
+	}
+	content, err := os.ReadFile(s.ConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read standalone configuration file - %w", err)
+	}
+	if s.config != nil && bytes.Equal(content, s.fileContent) {
+		return s.config, nil
+	}
+	config, err := LoadConfig(content)
+	if err != nil {
+		return nil, err
+	}
+	if s.SRVName != "" && len(config.Endpoints) > 0 {
+		return nil, errors.New("endpoints can not be listed in the standalone configuration file when they are resolved from DNS")
+	}
+	s.fileContent = content
+	s.config = config
+	return config, nil
+}
+
+// resolve returns the endpoints listed by the SRV records, and the distinct ports of the records. An endpoint is
+// added for each address of each target, named after the target.
+func (s *Source) resolve(ctx context.Context) ([]EndpointConfig, []int32, error) {
+	resolver := s.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	_, records, err := resolver.LookupSRV(ctx, "", "", s.SRVName)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to resolve SRV records %q - %w", s.SRVName, err)
+	}
+
+	var endpoints []EndpointConfig
+	ports := make(map[int32]bool)
+	seen := make(map[string]bool)
+	for _, record := range records {
+		ports[int32(record.Port)] = true
+		target := strings.TrimSuffix(record.Target, ".")
+		if seen[target] {
+			continue
+		}
+		seen[target] = true
+		addresses, err := resolver.LookupHost(ctx, target)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve SRV target %q - %w", target, err)
+		}
+		sort.Strings(addresses)
+		for i, address := range addresses {
+			name := target
+			if len(addresses) > 1 {
+				name = fmt.Sprintf("%s-%d", target, i)
+			}
+			endpoints = append(endpoints, EndpointConfig{Name: name, Address: address})
+		}
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Name < endpoints[j].Name })
+
+	var targetPorts []int32
+	for port := range ports {
+		targetPorts = append(targetPorts, port)
+	}
+	sort.Slice(targetPorts, func(i, j int) bool { return targetPorts[i] < targetPorts[j] })
+	return endpoints, targetPorts, nil
+}
+
+// apply sets the pool, its endpoints and its objectives in the datastore.
+func (s *Source) apply(ctx context.Context, desired *state) error {
+	logger := log.FromContext(ctx)
+	reader := newEndpointReader(desired.pool.Namespace, desired.endpoints)
+	if err := s.Datastore.PoolSet(ctx, reader, desired.pool); err != nil {
+		return fmt.Errorf("failed to set pool - %w", err)
+	}
+	// The pods are only resynced by PoolSet when the pool's selector changes.
+	if err := s.Datastore.PodResyncAll(ctx, reader); err != nil {
+		return fmt.Errorf("failed to resync endpoints - %w", err)
+	}
+
+	objectives := make(map[string]bool, len(desired.objectives))
+	for _, objective := range desired.objectives {
+		objectives[objective.Name] = true
+		s.Datastore.ObjectiveSet(&v1alpha2.InferenceObjective{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      objective.Name,
+				Namespace: desired.pool.Namespace,
+			},
+			Spec: v1alpha2.InferenceObjectiveSpec{
+				Priority: objective.Priority,
+				PoolRef:  v1alpha2.PoolObjectReference{Name: v1alpha2.ObjectName(desired.pool.Name)},
+			},
+		})
+	}
+	for _, objective := range s.Datastore.ObjectiveGetAll() {
+		if !objectives[objective.Name] {
+			s.Datastore.ObjectiveDelete(types.NamespacedName{Name: objective.Name, Namespace: objective.Namespace})
+		}
+	}
+	if s.PriorityBands != nil {
+		if err := controller.SyncPriorityBands(s.Datastore, s.PriorityBands); err != nil {
+			return err
+		}
+	}
+	logger.V(logutil.DEFAULT).Info("Standalone pool updated", "pool", desired.pool.Name,
+		"endpoints", len(desired.endpoints), "objectives", len(desired.objectives))
+	return nil
+}
+
+// endpointReader lists the endpoints of the pool as ready pods, for the datastore to resync its pods.
+type endpointReader struct {
+	pods []corev1.Pod
+}
+
+var _ client.Reader = &endpointReader{}
+
+func newEndpointReader(namespace string, endpoints []EndpointConfig) *endpointReader {
+	reader := &endpointReader{}
+	for _, endpoint := range endpoints {
+		reader.pods = append(reader.pods, corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      strings.NewReplacer(".", "-", ":", "-").Replace(endpointName(endpoint)),
+				Namespace: namespace,
+				Labels:    endpoint.Labels,
+			},
+			Status: corev1.PodStatus{
+				PodIP:      endpoint.Address,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		})
+	}
+	return reader
+}
+
+func (r *endpointReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("unsupported object type %T", obj)
+	}
+	for _, candidate := range r.pods {
+		if candidate.Name == key.Name && candidate.Namespace == key.Namespace {
+			candidate.DeepCopyInto(pod)
+			return nil
+		}
+	}
+	return apierrors.NewNotFound(corev1.Resource("pods"), key.Name)
+}
+
+func (r *endpointReader) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	podList, ok := list.(*corev1.PodList)
+	if !ok {
+		return fmt.Errorf("unsupported list type %T", list)
+	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	podList.Items = nil
+	for _, pod := range r.pods {
+		if listOpts.Namespace != "" && pod.Namespace != listOpts.Namespace {
+			continue
+		}
+		if listOpts.LabelSelector != nil && !listOpts.LabelSelector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		podList.Items = append(podList.Items, *pod.DeepCopy())
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+// fakeResolver resolves SRV records and hosts from static maps.
+type fakeResolver struct {
+	records map[string][]*net.SRV
+	hosts   map[string][]string
+}
+
+func (r *fakeResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	records, ok := r.records[name]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return name, records, nil
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	addresses, ok := r.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addresses, nil
+}
+
+// endpoints returns the address of each endpoint of the datastore, by endpoint name.
+func endpoints(ds datastore.Datastore) map[string]string {
+	res := make(map[string]string)
+	for _, pm := range ds.PodList(backendmetrics.AllPodsPredicate) {
+		res[pm.GetPod().NamespacedName.Name] = pm.GetPod().GetIPAddress() + ":" + pm.GetPod().GetPort()
+	}
+	return res
+}
+
+// objectives returns the priority of each objective of the datastore, by objective name.
+func objectives(ds datastore.Datastore) map[string]int {
+	res := make(map[string]int)
+	for _, objective := range ds.ObjectiveGetAll() {
+		res[objective.Name] = ptr.Deref(objective.Spec.Priority, 0)
+	}
+	return res
+}
+
+// priorityBands records the last priorities synchronized.
+type priorityBands struct {
+	priorities []int
+}
+
+func (b *priorityBands) SyncPriorityBands(priorities []int) error {
+	b.priorities = append([]int(nil), priorities...)
+	sort.Ints(b.priorities)
+	return nil
+}
+
+func newDatastore(t *testing.T) datastore.Datastore {
+	pmf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	return datastore.NewDatastore(t.Context(), pmf, 0)
+}
+
+func TestSourceConfigFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "pool.yaml")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ds := newDatastore(t)
+	bands := &priorityBands{}
+	source := &Source{
+		Datastore:     ds,
+		Pool:          types.NamespacedName{Name: "default-pool", Namespace: "default"},
+		ConfigFile:    path,
+		PriorityBands: bands,
+	}
+
+	write(`
+pool:
+  targetPorts: [8000]
+  selector:
+    app: vllm
+endpoints:
+- name: vllm-0
+  address: 10.0.0.1
+  labels:
+    app: vllm
+- name: other
+  address: 10.0.0.2
+- address: 10.0.0.3
+  labels:
+    app: vllm
+objectives:
+- name: chat
+  priority: 10
+`)
+	if err := source.Sync(ctx); err != nil {
+		t.Fatalf("Unexpected error syncing: %v", err)
+	}
+	pool, err := ds.PoolGet()
+	if err != nil {
+		t.Fatalf("Expected pool to be synced: %v", err)
+	}
+	if pool.Name != "default-pool" || pool.Namespace != "default" {
+		t.Errorf("Unexpected pool %s/%s", pool.Namespace, pool.Name)
+	}
+	if diff := cmp.Diff(map[string]string{
+		"vllm-0-rank-0":   "10.0.0.1:8000",
+		"10-0-0-3-rank-0": "10.0.0.3:8000",
+	}, endpoints(ds)); diff != "" {
+		t.Errorf("Unexpected endpoints (-want +got): %s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"chat": 10}, objectives(ds)); diff != "" {
+		t.Errorf("Unexpected objectives (-want +got): %s", diff)
+	}
+	if diff := cmp.Diff([]int{0, 10}, bands.priorities); diff != "" {
+		t.Errorf("Unexpected priority bands (-want +got): %s", diff)
+	}
+
+	// The file is hot-reloaded.
+	write(`
+pool:
+  name: pool
+  targetPorts: [8000]
+endpoints:
+- name: vllm-1
+  address: 10.0.0.4
+objectives:
+- name: batch
+  priority: -1
+`)
+	if err := source.Sync(ctx); err != nil {
+		t.Fatalf("Unexpected error syncing: %v", err)
+	}
+	if pool, _ := ds.PoolGet(); pool.Name != "pool" {
+		t.Errorf("Expected pool to be renamed, got %q", pool.Name)
+	}
+	if diff := cmp.Diff(map[string]string{"vllm-1-rank-0": "10.0.0.4:8000"}, endpoints(ds)); diff != "" {
+		t.Errorf("Unexpected endpoints (-want +got): %s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"batch": -1}, objectives(ds)); diff != "" {
+		t.Errorf("Unexpected objectives (-want +got): %s", diff)
+	}
+	if diff := cmp.Diff([]int{-1, 0}, bands.priorities); diff != "" {
+		t.Errorf("Unexpected priority bands (-want +got): %s", diff)
+	}
+
+	// An invalid file keeps the last state.
+	write(`{"pool": {"targetPorts": [0]}}`)
+	if err := source.Sync(ctx); err == nil {
+		t.Error("Expected an error syncing an invalid file")
+	}
+	if diff := cmp.Diff(map[string]string{"vllm-1-rank-0": "10.0.0.4:8000"}, endpoints(ds)); diff != "" {
+		t.Errorf("Unexpected endpoints (-want +got): %s", diff)
+	}
+}
+
+func TestSourceSRV(t *testing.T) {
+	ctx := context.Background()
+	resolver := &fakeResolver{
+		records: map[string][]*net.SRV{
+			"_http._tcp.vllm.local": {
+				{Target: "vllm-0.vllm.local.", Port: 8000},
+				{Target: "vllm-1.vllm.local.", Port: 8000},
+			},
+		},
+		hosts: map[string][]string{
+			"vllm-0.vllm.local": {"10.0.0.1"},
+			"vllm-1.vllm.local": {"10.0.0.3", "10.0.0.2"},
+		},
+	}
+	ds := newDatastore(t)
+	source := &Source{
+		Datastore: ds,
+		Pool:      types.NamespacedName{Name: "pool", Namespace: "default"},
+		SRVName:   "_http._tcp.vllm.local",
+		Resolver:  resolver,
+	}
+
+	if err := source.Sync(ctx); err != nil {
+		t.Fatalf("Unexpected error syncing: %v", err)
+	}
+	pool, err := ds.PoolGet()
+	if err != nil {
+		t.Fatalf("Expected pool to be synced: %v", err)
+	}
+	if diff := cmp.Diff([]v1.Port{{Number: 8000}}, pool.Spec.TargetPorts); diff != "" {
+		t.Errorf("Unexpected target ports (-want +got): %s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{
+		"vllm-0-vllm-local-rank-0":   "10.0.0.1:8000",
+		"vllm-1-vllm-local-0-rank-0": "10.0.0.2:8000",
+		"vllm-1-vllm-local-1-rank-0": "10.0.0.3:8000",
+	}, endpoints(ds)); diff != "" {
+		t.Errorf("Unexpected endpoints (-want +got): %s", diff)
+	}
+
+	// Records removed from DNS remove their endpoints, and the pool stays synced without records.
+	resolver.records["_http._tcp.vllm.local"] = resolver.records["_http._tcp.vllm.local"][:1]
+	if err := source.Sync(ctx); err != nil {
+		t.Fatalf("Unexpected error syncing: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"vllm-0-vllm-local-rank-0": "10.0.0.1:8000"}, endpoints(ds)); diff != "" {
+		t.Errorf("Unexpected endpoints (-want +got): %s", diff)
+	}
+	delete(resolver.records, "_http._tcp.vllm.local")
+	if err := source.Sync(ctx); err != nil {
+		t.Fatalf("Unexpected error syncing: %v", err)
+	}
+	if got := endpoints(ds); len(got) != 0 {
+		t.Errorf("Expected no endpoints, got %v", got)
+	}
+	if !ds.PoolHasSynced() {
+		t.Error("Expected pool to stay synced")
+	}
+}
+
+func TestSourceSRVWithConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pool.json")
+	if err := os.WriteFile(path, []byte(`{"pool": {"targetPorts": [8000, 8001]}, "endpoints": [{"address": "10.0.0.1"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	source := &Source{
+		Datastore:  newDatastore(t),
+		Pool:       types.NamespacedName{Name: "pool", Namespace: "default"},
+		ConfigFile: path,
+		SRVName:    "_http._tcp.vllm.local",
+		Resolver:   &fakeResolver{},
+	}
+	if err := source.Sync(context.Background()); err == nil {
+		t.Error("Expected an error with endpoints listed both in the file and DNS")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"pool": {"targetPorts": [8000, 8001]}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	source.Resolver = &fakeResolver{
+		records: map[string][]*net.SRV{"_http._tcp.vllm.local": {{Target: "vllm-0.", Port: 9000}}},
+		hosts:   map[string][]string{"vllm-0": {"10.0.0.1"}},
+	}
+	if err := source.Sync(context.Background()); err != nil {
+		t.Fatalf("Unexpected error syncing: %v", err)
+	}
+	got := []string{}
+	for _, address := range endpoints(source.Datastore) {
+		got = append(got, address)
+	}
+	sort.Strings(got)
+	if diff := cmp.Diff([]string{"10.0.0.1:8000", "10.0.0.1:8001"}, got); diff != "" {
+		t.Errorf("Expected the target ports of the file to override those of the records (-want +got): %s", diff)
+	}
+}
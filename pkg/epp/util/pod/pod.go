@@ -18,6 +18,7 @@ package pod
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 )
 
 func IsPodReady(pod *corev1.Pod) bool {
@@ -34,3 +35,17 @@ func IsPodReady(pod *corev1.Pod) bool {
 	}
 	return false
 }
+
+// IsEndpointReady returns whether an EndpointSlice endpoint can receive new requests: it must be ready and serving,
+// and not terminating. Unknown ready and serving conditions are interpreted as true, as recommended by the
+// EndpointSlice API. Terminating endpoints may still be serving their in-flight requests, but are not ready.
+func IsEndpointReady(endpoint discoveryv1.Endpoint) bool {
+	conditions := endpoint.Conditions
+	if conditions.Ready != nil && !*conditions.Ready {
+		return false
+	}
+	if conditions.Serving != nil && !*conditions.Serving {
+		return false
+	}
+	return conditions.Terminating == nil || !*conditions.Terminating
+}
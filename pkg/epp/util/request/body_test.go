@@ -42,6 +42,20 @@ func TestExtractRequestData(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "completions request body with max tokens",
+			body: map[string]any{
+				"model":      "test",
+				"prompt":     "test prompt",
+				"max_tokens": 128,
+			},
+			want: &types.LLMRequestBody{
+				Completions: &types.CompletionsRequest{
+					Prompt:    "test prompt",
+					MaxTokens: 128,
+				},
+			},
+		},
 		{
 			name: "chat completions request body",
 			body: map[string]any{
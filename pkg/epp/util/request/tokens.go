@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package request
+
+import (
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+const (
+	// DefaultCharactersPerToken is the average number of characters per token used to estimate
+	// the number of prompt tokens without tokenizing the prompt.
+	DefaultCharactersPerToken = 4
+)
+
+// EstimateRequestTokens estimates the number of tokens the request occupies in the KV cache by the end of
+// its generation. This is the estimated number of prompt tokens, plus the maximum number of output tokens
+// the request specified, or defaultOutputTokens if the request didn't specify a limit.
+func EstimateRequestTokens(body *types.LLMRequestBody, charactersPerToken int, defaultOutputTokens int) int {
+	if body == nil {
+		return defaultOutputTokens
+	}
+	if charactersPerToken <= 0 {
+		charactersPerToken = DefaultCharactersPerToken
+	}
+
+	promptTokens := (body.PromptLength() + charactersPerToken - 1) / charactersPerToken
+	outputTokens := body.MaxTokens()
+	if outputTokens <= 0 {
+		outputTokens = defaultOutputTokens
+	}
+	return promptTokens + outputTokens
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package request
+
+import (
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling/types"
+)
+
+func TestEstimateRequestTokens(t *testing.T) {
+	tests := []struct {
+		name                string
+		body                *types.LLMRequestBody
+		charactersPerToken  int
+		defaultOutputTokens int
+		want                int
+	}{
+		{
+			name:                "nil body",
+			defaultOutputTokens: 100,
+			want:                100,
+		},
+		{
+			name: "completions with max tokens",
+			body: &types.LLMRequestBody{
+				Completions: &types.CompletionsRequest{Prompt: "0123456789", MaxTokens: 50},
+			},
+			charactersPerToken:  4,
+			defaultOutputTokens: 100,
+			want:                53,
+		},
+		{
+			name: "completions without max tokens",
+			body: &types.LLMRequestBody{
+				Completions: &types.CompletionsRequest{Prompt: "01234567"},
+			},
+			charactersPerToken:  4,
+			defaultOutputTokens: 100,
+			want:                102,
+		},
+		{
+			name: "chat completions prefers max completion tokens",
+			body: &types.LLMRequestBody{
+				ChatCompletions: &types.ChatCompletionsRequest{
+					Messages:            []types.Message{{Role: "user", Content: types.Content{Raw: "0123456789ab"}}},
+					MaxTokens:           10,
+					MaxCompletionTokens: 20,
+				},
+			},
+			charactersPerToken:  2,
+			defaultOutputTokens: 100,
+			want:                26,
+		},
+		{
+			name: "invalid characters per token uses default",
+			body: &types.LLMRequestBody{
+				Completions: &types.CompletionsRequest{Prompt: "01234567", MaxTokens: 1},
+			},
+			want: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := EstimateRequestTokens(test.body, test.charactersPerToken, test.defaultOutputTokens)
+			if got != test.want {
+				t.Errorf("EstimateRequestTokens() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -163,6 +163,14 @@ func (m *InferenceObjectiveWrapper) CreationTimestamp(t metav1.Time) *InferenceO
 	return m
 }
 
+func (m *InferenceObjectiveWrapper) Annotation(key, value string) *InferenceObjectiveWrapper {
+	if m.ObjectMeta.Annotations == nil {
+		m.ObjectMeta.Annotations = map[string]string{}
+	}
+	m.ObjectMeta.Annotations[key] = value
+	return m
+}
+
 // InferencePoolWrapper wraps an group "inference.networking.k8s.io" InferencePool.
 type InferencePoolWrapper struct {
 	v1.InferencePool
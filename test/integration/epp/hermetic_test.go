@@ -170,6 +170,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelMyModel},
+					{"pool_name", ""},
 					{"target_model_name", modelMyModelTarget},
 				}),
 				"inference_pool_ready_pods": inferencePoolReadyPods(3, []label{
@@ -242,6 +243,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelSQLLora},
+					{"pool_name", ""},
 					{"target_model_name", modelSQLLoraTarget},
 				}),
 			},
@@ -277,6 +279,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelSQLLora},
+					{"pool_name", ""},
 					{"target_model_name", modelSQLLoraTarget},
 				}),
 			},
@@ -312,6 +315,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelSQLLora},
+					{"pool_name", ""},
 					{"target_model_name", modelSQLLoraTarget},
 				}),
 			},
@@ -381,6 +385,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelSheddable},
+					{"pool_name", ""},
 					{"target_model_name", modelSheddableTarget},
 				}),
 			},
@@ -455,6 +460,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelDirect},
+					{"pool_name", ""},
 					{"target_model_name", modelDirect},
 				}),
 			},
@@ -705,48 +711,48 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{`inference_objective_input_tokens`: `
 					# HELP inference_objective_input_tokens [ALPHA] Inference objective input token count distribution for requests in each model.
 					# TYPE inference_objective_input_tokens histogram
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="1"} 0
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="8"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="16"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="32"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="64"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="128"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="256"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="512"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="1024"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="2048"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="4096"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="8192"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="16384"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="32778"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="65536"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="131072"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="262144"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="524288"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="1.048576e+06"} 1
-		            inference_objective_input_tokens_bucket{model_name="",target_model_name="",le="+Inf"} 1
-		            inference_objective_input_tokens_sum{model_name="",target_model_name=""} 7
-		            inference_objective_input_tokens_count{model_name="",target_model_name=""} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="1"} 0
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="8"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="16"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="32"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="64"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="128"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="256"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="512"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="1024"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="2048"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="4096"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="8192"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="16384"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="32778"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="65536"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="131072"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="262144"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="524288"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="1.048576e+06"} 1
+		            inference_objective_input_tokens_bucket{model_name="",pool_name="",target_model_name="",le="+Inf"} 1
+		            inference_objective_input_tokens_sum{model_name="",pool_name="",target_model_name=""} 7
+		            inference_objective_input_tokens_count{model_name="",pool_name="",target_model_name=""} 1
 					`,
 				`inference_objective_normalized_time_per_output_token_seconds`: `
 					# HELP inference_objective_normalized_time_per_output_token_seconds [ALPHA] Inference objective latency divided by number of output tokens in seconds for each model and target model.
 					# TYPE inference_objective_normalized_time_per_output_token_seconds histogram
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.001"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.002"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.005"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.01"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.02"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.05"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.1"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.2"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="0.5"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="1"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="2"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="5"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="10"} 0
-					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",target_model_name="",le="+Inf"} 1
-					inference_objective_normalized_time_per_output_token_seconds_sum{model_name="",target_model_name=""} 9.223372036854776e+08
-					inference_objective_normalized_time_per_output_token_seconds_count{model_name="",target_model_name=""} 1
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.001"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.002"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.005"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.01"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.02"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.05"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.1"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.2"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="0.5"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="1"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="2"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="5"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="10"} 0
+					inference_objective_normalized_time_per_output_token_seconds_bucket{model_name="",pool_name="",target_model_name="",le="+Inf"} 1
+					inference_objective_normalized_time_per_output_token_seconds_sum{model_name="",pool_name="",target_model_name=""} 9.223372036854776e+08
+					inference_objective_normalized_time_per_output_token_seconds_count{model_name="",pool_name="",target_model_name=""} 1
 			`},
 			wantResponses: []*extProcPb.ProcessingResponse{
 				integrationutils.NewResponseHeaders(
@@ -826,6 +832,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelSQLLora},
+					{"pool_name", ""},
 					{"target_model_name", modelSQLLoraTarget},
 				}),
 			},
@@ -865,6 +872,7 @@ func TestFullDuplexStreamed_KubeInferenceObjectiveRequest(t *testing.T) {
 			wantMetrics: map[string]string{
 				"inference_objective_request_total": inferenceObjectiveRequestTotal([]label{
 					{"model_name", modelSQLLora},
+					{"pool_name", ""},
 					{"target_model_name", modelSQLLoraTarget},
 				}),
 			},